@@ -0,0 +1,206 @@
+// Package configurator provides the functions to reconfigure the I/O ports of a running actor node
+package configurator
+
+import (
+	"errors"
+	"github.com/sirupsen/logrus"
+	"github.com/tombenke/axon-go-common/actor/status"
+	"github.com/tombenke/axon-go-common/config"
+	"github.com/tombenke/axon-go-common/log"
+	"github.com/tombenke/axon-go-common/messenger"
+	"github.com/tombenke/axon-go-common/msgs"
+	"github.com/tombenke/axon-go-common/msgs/orchestra"
+	"sync"
+)
+
+// ReloadRequest holds a new configuration of the node to apply during operation,
+// and the channel through which the configurator sends back the result.
+type ReloadRequest struct {
+	// Config is the new configuration of the node
+	Config config.Node
+
+	// Load makes the new configuration from the `current` configuration of the node, if it is defined.
+	// It is used instead of `Config`, so the new configuration is based on the port changes applied during operation.
+	Load func(current config.Node) (config.Node, error)
+
+	// ResultCh is the channel to send the result of reloading through
+	ResultCh chan ReloadResult
+}
+
+// ReloadResult holds the results of applying a new configuration to the running node.
+type ReloadResult struct {
+	// RestartRequired holds the descriptions of the changes that can only be applied by restarting the node
+	RestartRequired []string
+
+	// Err holds the error if the new configuration could not be applied
+	Err error
+}
+
+// Configurator receives `configure-ports` requests through the control channel of the node.
+// It validates the requested changes against the `Ports.Configure` properties of the node, and validates the resulting configuration,
+// then forwards the changed and added ports to the inputs receiver via the `inputsCfgCh`,
+// to the processor via the `outputsCfgCh`, and the new node configuration to the status via the `statusCfgCh` channel.
+// Configurator responds to the requests with the new status report of the node, or with the error message
+// if the request could not be fulfilled.
+// Configurator also applies the reloaded configurations of the node received via the `reloadCh` channel,
+// including the changes of the log level and format.
+// This function runs as a standalone process, so it should be started as a go function.
+func Configurator(nodeConfig config.Node, reloadCh chan ReloadRequest, inputsCfgCh chan config.Inputs, outputsCfgCh chan config.Outputs, statusCfgCh chan config.Node, doneCh chan interface{}, wg *sync.WaitGroup, m messenger.Messenger, logger *logrus.Logger) (chan interface{}, chan interface{}) {
+	configuratorStoppedCh := make(chan interface{})
+	configuratorStartedCh := make(chan interface{})
+
+	// The requests arrive in the go routines of the messenger, so the access to the configuration must be serialized
+	var mu sync.Mutex
+
+	// apply forwards the changes to the components, then makes the `newConfig` to the current one
+	apply := func(newConfig config.Node, changedInputs config.Inputs, changedOutputs config.Outputs) error {
+		if len(changedInputs) > 0 {
+			select {
+			case inputsCfgCh <- changedInputs:
+			case <-doneCh:
+				return errors.New("node is shutting down")
+			}
+		}
+
+		if len(changedOutputs) > 0 {
+			select {
+			case outputsCfgCh <- changedOutputs:
+			case <-doneCh:
+				return errors.New("node is shutting down")
+			}
+		}
+
+		select {
+		case statusCfgCh <- newConfig:
+		case <-doneCh:
+			return errors.New("node is shutting down")
+		}
+
+		nodeConfig = newConfig
+		return nil
+	}
+
+	configurePorts := func(requestBytes []byte) ([]byte, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		logger.Debugf("Configurator received configure-ports message")
+		newConfig, changedInputs, changedOutputs, err := applyRequest(nodeConfig, requestBytes)
+		if err != nil {
+			logger.Errorf("Configurator rejected configure-ports request: %s", err)
+			return nil, err
+		}
+
+		if err := apply(newConfig, changedInputs, changedOutputs); err != nil {
+			return nil, err
+		}
+
+		logger.Debugf("Configurator sends status-report message")
+		return status.MakeStatusReportMsg(nodeConfig).Encode(msgs.JSONRepresentation), nil
+	}
+
+	reload := func(request ReloadRequest) ReloadResult {
+		mu.Lock()
+		defer mu.Unlock()
+
+		logger.Debugf("Configurator received reloaded configuration")
+		newConfig := request.Config
+		if request.Load != nil {
+			var err error
+			if newConfig, err = request.Load(nodeConfig); err != nil {
+				logger.Errorf("Configurator could not load the configuration: %s", err)
+				return ReloadResult{Err: err}
+			}
+		}
+		reloadedConfig, changedInputs, changedOutputs, restartRequired, err := nodeConfig.ReloadWith(newConfig)
+		if err == nil {
+			err = reloadedConfig.Validate()
+		}
+		if err != nil {
+			logger.Errorf("Configurator rejected reloaded configuration: %s", err)
+			return ReloadResult{Err: err}
+		}
+
+		previousConfig := nodeConfig
+		if err := apply(reloadedConfig, changedInputs, changedOutputs); err != nil {
+			return ReloadResult{Err: err}
+		}
+
+		// The logging is changed only after the configuration is applied, so a rejected reload leaves it intact
+		if reloadedConfig.LogLevel != previousConfig.LogLevel {
+			log.SetLevelStr(reloadedConfig.LogLevel)
+		}
+		if reloadedConfig.LogFormat != previousConfig.LogFormat {
+			log.SetFormatterStr(reloadedConfig.LogFormat)
+		}
+
+		for _, change := range restartRequired {
+			logger.Warnf("Configurator can not apply change without restart: %s", change)
+		}
+		return ReloadResult{RestartRequired: restartRequired}
+	}
+
+	logger.Debugf("Configurator subscribes to '%s' channel", nodeConfig.GetConfigurePortsChannel())
+	configurePortsSubs := m.Response(nodeConfig.GetConfigurePortsChannel(), configurePorts)
+
+	wg.Add(1)
+	go func() {
+		close(configuratorStartedCh)
+		defer func() {
+			if err := configurePortsSubs.Unsubscribe(); err != nil {
+				panic(err)
+			}
+			wg.Done()
+
+			logger.Debugf("Configurator stopped.")
+			close(configuratorStoppedCh)
+		}()
+
+		for {
+			select {
+			case <-doneCh:
+				logger.Debugf("Configurator shuts down.")
+				return
+
+			case request := <-reloadCh:
+				request.ResultCh <- reload(request)
+			}
+		}
+	}()
+	logger.Debugf("Configurator started")
+	return configuratorStartedCh, configuratorStoppedCh
+}
+
+// applyRequest decodes the `configure-ports` request, then applies the requested changes to the `nodeConfig`.
+// It returns with the new node configuration, and the descriptors of the ports changed or added.
+func applyRequest(nodeConfig config.Node, requestBytes []byte) (config.Node, config.Inputs, config.Outputs, error) {
+	var request orchestra.ConfigurePorts
+	if err := request.Decode(msgs.JSONRepresentation, requestBytes); err != nil {
+		return nodeConfig, nil, nil, err
+	}
+
+	inputs := config.Inputs{}
+	for _, in := range request.Body.Inputs {
+		inputs = append(inputs, config.In{
+			IO:      config.IO{Name: in.Name, Type: in.Type, Representation: in.Representation, Channel: in.Channel},
+			Default: in.Default,
+		})
+	}
+
+	outputs := config.Outputs{}
+	for _, out := range request.Body.Outputs {
+		outputs = append(outputs, config.Out{
+			IO: config.IO{Name: out.Name, Type: out.Type, Representation: out.Representation, Channel: out.Channel},
+		})
+	}
+
+	newConfig, changedInputs, changedOutputs, err := nodeConfig.ReconfigurePorts(inputs, outputs)
+	if err == nil {
+		err = newConfig.Validate()
+	}
+	if err != nil {
+		return nodeConfig, nil, nil, err
+	}
+
+	return newConfig, changedInputs, changedOutputs, nil
+}
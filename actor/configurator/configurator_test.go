@@ -0,0 +1,130 @@
+package configurator
+
+import (
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/tombenke/axon-go-common/config"
+	"github.com/tombenke/axon-go-common/messenger"
+	messengerImpl "github.com/tombenke/axon-go-common/messenger/nats"
+	"github.com/tombenke/axon-go-common/msgs"
+	_ "github.com/tombenke/axon-go-common/msgs/base"
+	"github.com/tombenke/axon-go-common/msgs/orchestra"
+	"sync"
+	"testing"
+	"time"
+)
+
+var logger = logrus.New()
+
+var messengerCfg = messenger.Config{
+	Urls:       "localhost:4222",
+	UserCreds:  "",
+	ClientName: "configurator-test-client",
+	ClusterID:  "test-cluster",
+	ClientID:   "configurator-test-client",
+	Logger:     logger,
+}
+
+func createTestNode(extend bool, modify bool) config.Node {
+	node := config.NewNode("test-node", "test-node-type", extend, modify, true, false)
+
+	node.AddInputPort("input", "base/Float64", "application/json", "test-node.input", "")
+	node.AddOutputPort("output", "base/Float64", "application/json", "test-node.output")
+
+	return node
+}
+
+// startConfigurator starts the configurator with mock components that collect the configuration changes
+func startConfigurator(nodeConfig config.Node, m messenger.Messenger, wg *sync.WaitGroup) (chan interface{}, chan interface{}, chan config.Inputs, chan config.Outputs, chan config.Node) {
+	inputsCfgCh := make(chan config.Inputs, 1)
+	outputsCfgCh := make(chan config.Outputs, 1)
+	statusCfgCh := make(chan config.Node, 1)
+	doneCh := make(chan interface{})
+
+	startedCh, stoppedCh := Configurator(nodeConfig, nil, inputsCfgCh, outputsCfgCh, statusCfgCh, doneCh, wg, m, logger)
+	<-startedCh
+
+	return doneCh, stoppedCh, inputsCfgCh, outputsCfgCh, statusCfgCh
+}
+
+func TestConfigurator(t *testing.T) {
+	m := messengerImpl.NewMessenger(messengerCfg)
+	defer m.Close()
+	wg := sync.WaitGroup{}
+
+	nodeConfig := createTestNode(true, true)
+	doneCh, stoppedCh, inputsCfgCh, outputsCfgCh, statusCfgCh := startConfigurator(nodeConfig, m, &wg)
+
+	request := orchestra.NewConfigurePortsMessage(orchestra.ConfigurePortsBody{
+		Inputs: []orchestra.PortConfig{
+			orchestra.PortConfig{Name: "input", Channel: "test-node.new-input", Default: `{"Body": {"Data": 42}}`},
+		},
+		Outputs: []orchestra.PortConfig{
+			orchestra.PortConfig{Name: "new-output", Type: "base/Bool", Channel: "test-node.new-output"},
+		},
+	})
+	response, err := m.Request(nodeConfig.GetConfigurePortsChannel(), request.Encode(msgs.JSONRepresentation), 500*time.Millisecond)
+	assert.Nil(t, err)
+
+	var statusReport orchestra.StatusReport
+	err = statusReport.Decode(msgs.JSONRepresentation, response)
+	assert.Nil(t, err, string(response))
+	assert.Equal(t, "test-node.new-input", statusReport.Body.Ports.Inputs[0].Channel.Name)
+	assert.Equal(t, 2, len(statusReport.Body.Ports.Outputs))
+	assert.Equal(t, "test-node.new-output", statusReport.Body.Ports.Outputs[1].Channel.Name)
+
+	changedInputs := <-inputsCfgCh
+	assert.Equal(t, config.Inputs{config.In{IO: config.IO{
+		Name:           "input",
+		Type:           "base/Float64",
+		Representation: "application/json",
+		Channel:        "test-node.new-input",
+	}, Default: `{"Body": {"Data": 42}}`}}, changedInputs)
+
+	changedOutputs := <-outputsCfgCh
+	assert.Equal(t, config.Outputs{config.Out{IO: config.IO{
+		Name:           "new-output",
+		Type:           "base/Bool",
+		Representation: "application/json",
+		Channel:        "test-node.new-output",
+	}}}, changedOutputs)
+
+	newConfig := <-statusCfgCh
+	assert.Equal(t, 2, len(newConfig.Ports.Outputs))
+
+	close(doneCh)
+	<-stoppedCh
+	wg.Wait()
+}
+
+func TestConfiguratorRejects(t *testing.T) {
+	m := messengerImpl.NewMessenger(messengerCfg)
+	defer m.Close()
+	wg := sync.WaitGroup{}
+
+	nodeConfig := createTestNode(false, true)
+	doneCh, stoppedCh, _, _, _ := startConfigurator(nodeConfig, m, &wg)
+
+	wrongRequests := map[string]orchestra.ConfigurePortsBody{
+		"port extension is disabled": orchestra.ConfigurePortsBody{
+			Inputs: []orchestra.PortConfig{orchestra.PortConfig{Name: "new-input", Channel: "test-node.new-input"}},
+		},
+		"invalid configuration:\n  'base/Float64' message-type of the 'output' output port does not implement codec for 'wrong/representation' representation format": orchestra.ConfigurePortsBody{
+			Outputs: []orchestra.PortConfig{orchestra.PortConfig{Name: "output", Representation: "wrong/representation"}},
+		},
+		"invalid configuration:\n  wrong default value of the 'input' input port: invalid character 'w' looking for beginning of value": orchestra.ConfigurePortsBody{
+			Inputs: []orchestra.PortConfig{orchestra.PortConfig{Name: "input", Default: "wrong default"}},
+		},
+	}
+
+	for expectedError, body := range wrongRequests {
+		request := orchestra.NewConfigurePortsMessage(body)
+		response, err := m.Request(nodeConfig.GetConfigurePortsChannel(), request.Encode(msgs.JSONRepresentation), 500*time.Millisecond)
+		assert.Nil(t, err)
+		assert.Equal(t, expectedError, string(response))
+	}
+
+	close(doneCh)
+	<-stoppedCh
+	wg.Wait()
+}
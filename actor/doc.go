@@ -50,6 +50,36 @@ then send the results through the output ports as soon as they can.
 In theory any node can work both in synchronous or asynchronous mode, but only in one of the mode at a given time.
 To change between the modes, the node needs to be restarted. in most of the cases only one mode makes sense to a specific node type.
 
+In asynchronous mode the `trigger` section of the node configuration may restrict when the incoming messages fire the processing:
+
+    trigger:
+      ports: [tick]          # only the messages of these ports fire the processing
+      join: [level, flow]    # all of these ports have to receive new values since the last processing
+      throttle: 1s           # fire at most once per interval, the messages arrived meanwhile are processed at its end
+      debounce: 200ms        # fire only after this quiet period following the last firing message
+      deadband:
+        level: 0.05          # the changes of the numeric port within this band do not fire the processing
+
+The rules are combined, and the messages that do not fire the processing still update the inputs.
+
+Reconfiguration of the Ports
+
+The I/O ports of a running node can be reconfigured through the control channel of the node,
+that is named as `<configurePorts>.<node-name>`, e.g. `configure-ports.well-pump-controller`.
+The request is an `orchestra/ConfigurePorts` message, that may change the channel, the representation
+and the default value of the existing ports, or may add new ports to the node.
+The changes are validated against the `ports.configure.modify` and `ports.configure.extend` properties
+of the node configuration, so the node rejects the changes that are not allowed.
+The node responds with its new status report, or with the error message if the request was rejected.
+
+Reloading the Configuration
+
+A running node can also reload its configuration file, either on receiving a `SIGHUP` signal,
+or when the config file changes, if `Node.WatchConfig()` is called with file watching enabled.
+The log level and format, the status channels and the changeable properties of the ports are applied immediately.
+The rest of the changes, e.g. the messenger or the synchronization settings, are only logged as warnings,
+because they can only be applied by restarting the node.
+
 Processing
 
 All kind of nodes has a processing function.
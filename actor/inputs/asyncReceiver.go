@@ -7,6 +7,7 @@ import (
 	"github.com/tombenke/axon-go-common/io"
 	"github.com/tombenke/axon-go-common/messenger"
 	"sync"
+	"time"
 )
 
 // AsyncReceiver receives inputs from the connecting actors processor function via the `outputsCh`
@@ -15,7 +16,10 @@ import (
 // and the subject to receive from.
 // This function starts the receiver routine as a standalone process,
 // and returns a channel that the process uses to forward the incoming inputs.
-func AsyncReceiver(inputsCfg config.Inputs, resetCh chan interface{}, doneCh chan interface{}, appWg *sync.WaitGroup, m messenger.Messenger, logger *logrus.Logger) (chan interface{}, chan *io.Inputs, chan interface{}) {
+// The input ports can be changed or added during operation via the `configCh` channel.
+// The `triggerCfg` firing rules decide which messages forward the inputs to the processor, and when.
+// The inputs are forwarded on every message if there are no rules defined.
+func AsyncReceiver(inputsCfg config.Inputs, triggerCfg config.Trigger, resetCh chan interface{}, configCh chan config.Inputs, doneCh chan interface{}, appWg *sync.WaitGroup, m messenger.Messenger, logger *logrus.Logger) (chan interface{}, chan *io.Inputs, chan interface{}) {
 	receiverStoppedCh := make(chan interface{})
 	startedCh := make(chan interface{})
 
@@ -33,7 +37,6 @@ func AsyncReceiver(inputsCfg config.Inputs, resetCh chan interface{}, doneCh cha
 
 		// Create wait-group for the channel observer sub-processes
 		obsWg := sync.WaitGroup{}
-		obsDoneCh := make(chan interface{})
 
 		// Create Input ports, and initialize with default messages
 		inputs := asyncSetupInputPorts(inputsCfg, logger)
@@ -43,14 +46,47 @@ func AsyncReceiver(inputsCfg config.Inputs, resetCh chan interface{}, doneCh cha
 		defer close(inputsMuxCh)
 
 		// Starts the input port observers
-		startInPortsObservers(inputs, inputsMuxCh, obsDoneCh, &obsWg, m, logger)
+		observers := startInPortsObservers(inputs, inputsMuxCh, &obsWg, m, logger)
+
+		// Create the trigger, and the timer that delays the processing according to the firing rules
+		trigger := newTrigger(triggerCfg)
+		var timer *time.Timer
+		var timerCh <-chan time.Time
+		stopTimer := func() {
+			if timer != nil {
+				timer.Stop()
+				timer, timerCh = nil, nil
+			}
+		}
+		forward := func(now time.Time) {
+			stopTimer()
+			inputs.Deliver(now)
+			inputsCh <- inputs
+			trigger.fired(inputs, now)
+			logger.Debugf("Receiver sent 'inputs' to 'inputsCh'")
+		}
+		fire := func(now time.Time) {
+			wait, due := trigger.delay(now)
+			switch {
+			case !due:
+				return
+			case wait <= 0:
+				forward(now)
+			default:
+				stopTimer()
+				timer = time.NewTimer(wait)
+				timerCh = timer.C
+				logger.Debugf("Receiver delays forwarding the inputs by %s", wait)
+			}
+		}
+		defer stopTimer()
 
 		for {
 			select {
 			case <-doneCh:
 				logger.Debugf("Receiver shuts down.")
-				close(obsDoneCh)
-				logger.Debugf("Receiver closed the 'obsDoneCh'.")
+				observers.stopAll()
+				logger.Debugf("Receiver stopped the observers.")
 				logger.Debugf("Receiver starts waiting for observers to stop")
 				obsWg.Wait()
 				logger.Debugf("Receiver's observers stopped")
@@ -58,15 +94,23 @@ func AsyncReceiver(inputsCfg config.Inputs, resetCh chan interface{}, doneCh cha
 
 			case <-resetCh:
 				logger.Debugf("Receiver got RESET signal")
-				inputsCh <- inputs
-				logger.Debugf("Receiver sent 'inputs' to 'inputsCh'")
+				forward(time.Now())
+
+			case inputsCfg := <-configCh:
+				logger.Debugf("Receiver got ports configuration")
+				configureInPorts(inputs, inputsCfg, observers, inputsMuxCh, &obsWg, m, logger)
 
 			case input := <-inputsMuxCh:
 				logger.Debugf("Receiver got message to '%s' port", input.Name)
-				(*inputs).SetMessage(input.Name, input.Message)
-				// Immediately forward to the processor if not in synchronized mode
-				inputsCh <- inputs
-				logger.Debugf("Receiver sent 'inputs' to 'inputsCh'")
+				now := time.Now()
+				inputs.Receive(input.Name, input.Message, now)
+				trigger.received(input.Name, input.Message, now)
+				// Forward to the processor if the message fires the processing according to the trigger rules
+				fire(now)
+
+			case <-timerCh:
+				timer, timerCh = nil, nil
+				fire(time.Now())
 			}
 		}
 	}()
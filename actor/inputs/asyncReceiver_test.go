@@ -1,7 +1,11 @@
 package inputs
 
 import (
+	"github.com/stretchr/testify/assert"
+	"github.com/tombenke/axon-go-common/config"
 	messengerImpl "github.com/tombenke/axon-go-common/messenger/nats"
+	"github.com/tombenke/axon-go-common/msgs"
+	"github.com/tombenke/axon-go-common/msgs/base"
 	at "github.com/tombenke/axon-go-common/testing"
 	"sync"
 	"testing"
@@ -24,7 +28,7 @@ func TestAsyncReceiverStartStop(t *testing.T) {
 	doneCh := make(chan interface{})
 
 	// Start the receiver process
-	startedCh, _, _ := AsyncReceiver(asyncInputsCfg, resetCh, doneCh, &wg, m, logger)
+	startedCh, _, _ := AsyncReceiver(asyncInputsCfg, config.Trigger{}, resetCh, nil, doneCh, &wg, m, logger)
 	<-startedCh
 
 	// Wait until test is completed, then stop the processes
@@ -54,7 +58,7 @@ func TestAsyncReceiverInputs(t *testing.T) {
 
 	// Start the receiver process
 	doneRcvCh := make(chan interface{})
-	startedCh, inputsCh, rcvStoppedCh := AsyncReceiver(asyncInputsCfg, resetCh, doneRcvCh, &wg, m, logger)
+	startedCh, inputsCh, rcvStoppedCh := AsyncReceiver(asyncInputsCfg, config.Trigger{}, resetCh, nil, doneRcvCh, &wg, m, logger)
 	<-startedCh
 
 	doneProcCh := make(chan interface{})
@@ -91,3 +95,47 @@ func TestAsyncReceiverInputs(t *testing.T) {
 	// Wait for the message to come in
 	wg.Wait()
 }
+
+// TestAsyncReceiverConfigurePorts sets up the input ports, then moves a port to a new channel,
+// and checks if the port receives the messages from the new channel.
+func TestAsyncReceiverConfigurePorts(t *testing.T) {
+	// Connect to messaging
+	m := messengerImpl.NewMessenger(messengerCfg)
+	defer m.Close()
+
+	// Use a WaitGroup to wait for the processes of the testbed to complete their mission
+	wg := sync.WaitGroup{}
+
+	// Create a channel for the RESET, and another one for the configuration changes
+	resetCh := make(chan interface{})
+	configCh := make(chan config.Inputs)
+
+	// Start the receiver process
+	doneRcvCh := make(chan interface{})
+	startedCh, inputsCh, rcvStoppedCh := AsyncReceiver(asyncInputsCfg, config.Trigger{}, resetCh, configCh, doneRcvCh, &wg, m, logger)
+	<-startedCh
+
+	// Move the port to a new channel
+	newChannel := "well-pump-controller-state.new"
+	inCfg := asyncInputsCfg[0]
+	inCfg.Channel = newChannel
+	configCh <- config.Inputs{inCfg}
+
+	// Give chance for the new observer to subscribe before send messages through external messaging mw.
+	time.Sleep(100 * time.Millisecond)
+
+	// Send message to the new channel
+	if err := m.Publish(newChannel, base.NewStringMessage("NEW-CHANNEL").Encode(msgs.JSONRepresentation)); err != nil {
+		panic(err)
+	}
+
+	inputs := <-inputsCh
+	assert.Equal(t, newChannel, inputs.Map[inCfg.Name].Channel)
+	assert.Equal(t, "NEW-CHANNEL", inputs.GetMessage(inCfg.Name).(*base.String).Body.Data)
+
+	close(doneRcvCh)
+	<-rcvStoppedCh
+	close(resetCh)
+
+	wg.Wait()
+}
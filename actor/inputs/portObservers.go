@@ -2,27 +2,73 @@ package inputs
 
 import (
 	"github.com/sirupsen/logrus"
+	"github.com/tombenke/axon-go-common/config"
 	"github.com/tombenke/axon-go-common/io"
 	"github.com/tombenke/axon-go-common/messenger"
 	"github.com/tombenke/axon-go-common/msgs"
 	"sync"
 )
 
-// startInPortsObservers starts one message observer for every port,
-// and returns with the number of observers started.
-func startInPortsObservers(inputs *io.Inputs, inputsMuxCh chan io.Input, doneCh chan interface{}, wg *sync.WaitGroup, m messenger.Messenger, logger *logrus.Logger) {
+// portObservers holds the done channels of the running port observers. The key is the name of the port.
+type portObservers map[string]chan interface{}
+
+// startInPortsObservers starts one message observer for every port that has a channel,
+// and returns with the done channels of the observers started.
+func startInPortsObservers(inputs *io.Inputs, inputsMuxCh chan io.Input, wg *sync.WaitGroup, m messenger.Messenger, logger *logrus.Logger) portObservers {
+	observers := make(portObservers)
 	for p := range (*inputs).Map {
-		if (*inputs).Map[p].Channel != "" {
-			startedCh := newPortObserver((*inputs).Map[p], inputsMuxCh, doneCh, wg, m, logger)
-			<-startedCh
-		}
+		observers.start(inputs, (*inputs).Map[p], inputsMuxCh, wg, m, logger)
+	}
+	return observers
+}
+
+// start starts a new observer for the `input` port if it has a channel to subscribe to
+func (observers portObservers) start(inputs *io.Inputs, input io.Input, inputsMuxCh chan io.Input, wg *sync.WaitGroup, m messenger.Messenger, logger *logrus.Logger) {
+	if input.Channel != "" {
+		doneCh := make(chan interface{})
+		startedCh := newPortObserver(inputs, input, inputsMuxCh, doneCh, wg, m, logger)
+		<-startedCh
+		observers[input.Name] = doneCh
+	}
+}
+
+// stop shuts down the observer of the port selected by its `name`, if it is running
+func (observers portObservers) stop(name string) {
+	if doneCh, ok := observers[name]; ok {
+		close(doneCh)
+		delete(observers, name)
+	}
+}
+
+// stopAll shuts down all the observers
+func (observers portObservers) stopAll() {
+	for name := range observers {
+		observers.stop(name)
+	}
+}
+
+// configureInPorts changes or adds the input ports according to the `inputsCfg` port descriptors,
+// and restarts the observers of the affected ports, so they subscribe to their new channels.
+func configureInPorts(inputs *io.Inputs, inputsCfg config.Inputs, observers portObservers, inputsMuxCh chan io.Input, wg *sync.WaitGroup, m messenger.Messenger, logger *logrus.Logger) {
+	for _, inCfg := range inputsCfg {
+		logger.Debugf("Receiver configures '%s' port to '%s' channel", inCfg.Name, inCfg.Channel)
+		observers.stop(inCfg.Name)
+		inputs.ConfigurePort(inCfg)
+
+		(*inputs).RW.RLock()
+		input := (*inputs).Map[inCfg.Name]
+		(*inputs).RW.RUnlock()
+
+		observers.start(inputs, input, inputsMuxCh, wg, m, logger)
 	}
 }
 
 // newPortObserver subscribes to an input channel with a go routine that observes the incoming messages.
 // When a message arrives through the channel, the go routine forwards that through the `inCh` towards the aggregator.
 // The newPortObserver creates and returns with the `inCh` channel that the aggregator can consume.
-func newPortObserver(input io.Input, inputsMuxCh chan io.Input, doneCh chan interface{}, wg *sync.WaitGroup, m messenger.Messenger, logger *logrus.Logger) chan interface{} {
+// The messages that can not be decoded are logged, counted in `inputs`, and forwarded as they are
+// to the dead-letter channel of the port if it has any, then the observer continues with the next message.
+func newPortObserver(inputs *io.Inputs, input io.Input, inputsMuxCh chan io.Input, doneCh chan interface{}, wg *sync.WaitGroup, m messenger.Messenger, logger *logrus.Logger) chan interface{} {
 	inMsgCh := make(chan []byte)
 	logger.Debugf("Receiver's '%s' port observer subscribe to '%s' channel", input.Name, input.Channel)
 	inMsgSubs := m.ChanSubscribe(input.Channel, inMsgCh)
@@ -51,11 +97,23 @@ func newPortObserver(input io.Input, inputsMuxCh chan io.Input, doneCh chan inte
 				logger.Debugf("Receiver's '%s' port observer received message", input.Name)
 				newInput := io.NewInput(input.Name, input.Type, input.Representation, input.Channel, input.DefaultMessage)
 				newInput.Message = msgs.GetDefaultMessageByType(input.Type)
-				if err := newInput.Message.Decode(input.Representation, inputMsg); err != nil {
-					panic(err)
+				if err := msgs.DecodeE(newInput.Message, input.Representation, inputMsg); err != nil {
+					inputs.CountUndecodable(input.Name)
+					logger.Errorf("Receiver's '%s' port observer could not decode message: %s", input.Name, err)
+					if input.DeadLetterChannel != "" {
+						if err := m.Publish(input.DeadLetterChannel, inputMsg); err != nil {
+							logger.Errorf("Receiver's '%s' port observer could not forward message to '%s' dead-letter channel: %s", input.Name, input.DeadLetterChannel, err)
+						}
+					}
+					continue
+				}
+				select {
+				case inputsMuxCh <- newInput:
+					logger.Debugf("Receiver's '%s' port observer sent message to inputMuxCh channel", input.Name)
+				case <-doneCh:
+					logger.Debugf("Receiver's '%s' port observer shut down", input.Name)
+					return
 				}
-				inputsMuxCh <- newInput
-				logger.Debugf("Receiver's '%s' port observer sent message to inputMuxCh channel", input.Name)
 			}
 		}
 	}()
@@ -0,0 +1,62 @@
+package inputs
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/tombenke/axon-go-common/config"
+	"github.com/tombenke/axon-go-common/io"
+	messengerImpl "github.com/tombenke/axon-go-common/messenger/nats"
+	"github.com/tombenke/axon-go-common/msgs"
+	"github.com/tombenke/axon-go-common/msgs/base"
+	"sync"
+	"testing"
+	"time"
+)
+
+// TestPortObserverUndecodable sends an undecodable message to an observed port, then a valid one.
+// The undecodable message should be counted and forwarded to the dead-letter channel,
+// and the observer should keep forwarding the valid messages.
+func TestPortObserverUndecodable(t *testing.T) {
+	m := messengerImpl.NewMessenger(messengerCfg)
+	defer m.Close()
+
+	inputs := io.NewInputs(config.Inputs{
+		config.In{IO: config.IO{
+			Name:           "level",
+			Type:           "base/Float64",
+			Representation: "application/json",
+			Channel:        "port-observer-test-level",
+		}, DeadLetter: "port-observer-test-dead-letter"},
+	})
+
+	deadLetterCh := make(chan []byte)
+	deadLetterSubs := m.ChanSubscribe("port-observer-test-dead-letter", deadLetterCh)
+	defer func() { assert.Nil(t, deadLetterSubs.Unsubscribe()) }()
+
+	wg := sync.WaitGroup{}
+	inputsMuxCh := make(chan io.Input)
+	observers := startInPortsObservers(inputs, inputsMuxCh, &wg, m, logger)
+	defer func() {
+		observers.stopAll()
+		wg.Wait()
+	}()
+
+	undecodable := []byte("{not-a-json")
+	assert.Nil(t, m.Publish("port-observer-test-level", undecodable))
+	select {
+	case content := <-deadLetterCh:
+		assert.Equal(t, undecodable, content)
+	case <-time.After(5 * time.Second):
+		t.Fatal("undecodable message was not forwarded to the dead-letter channel")
+	}
+	assert.Equal(t, uint64(1), inputs.Undecodable("level"))
+
+	assert.Nil(t, m.Publish("port-observer-test-level", base.NewFloat64Message(42).Encode(msgs.JSONRepresentation)))
+	select {
+	case input := <-inputsMuxCh:
+		assert.Equal(t, "level", input.Name)
+		assert.Equal(t, 42., input.Message.(*base.Float64).Body.Data)
+	case <-time.After(5 * time.Second):
+		t.Fatal("valid message was not forwarded after the undecodable one")
+	}
+	assert.Equal(t, uint64(1), inputs.Undecodable("level"))
+}
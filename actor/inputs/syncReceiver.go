@@ -9,6 +9,7 @@ import (
 	"github.com/tombenke/axon-go-common/msgs"
 	"github.com/tombenke/axon-go-common/msgs/orchestra"
 	"sync"
+	"time"
 )
 
 // SyncReceiver receives inputs from the connecting actors processor function via the `outputsCh`
@@ -17,7 +18,8 @@ import (
 // and the subject to receive from.
 // This function starts the receiver routine as a standalone process,
 // and returns a channel that the process uses to forward the incoming inputs.
-func SyncReceiver(inputsCfg config.Inputs, resetCh chan interface{}, doneCh chan interface{}, appWg *sync.WaitGroup, m messenger.Messenger, logger *logrus.Logger) (chan interface{}, chan *io.Inputs, chan interface{}) {
+// The input ports can be changed or added during operation via the `configCh` channel.
+func SyncReceiver(inputsCfg config.Inputs, resetCh chan interface{}, configCh chan config.Inputs, doneCh chan interface{}, appWg *sync.WaitGroup, m messenger.Messenger, logger *logrus.Logger) (chan interface{}, chan *io.Inputs, chan interface{}) {
 	receiverStoppedCh := make(chan interface{})
 	startedCh := make(chan interface{})
 
@@ -35,7 +37,6 @@ func SyncReceiver(inputsCfg config.Inputs, resetCh chan interface{}, doneCh chan
 
 		// Create wait-group for the channel observer sub-processes
 		obsWg := sync.WaitGroup{}
-		obsDoneCh := make(chan interface{})
 
 		// Setup communication channels with the orchestrator
 		receiveAndProcessCh := make(chan []byte)
@@ -55,14 +56,14 @@ func SyncReceiver(inputsCfg config.Inputs, resetCh chan interface{}, doneCh chan
 		defer close(inputsMuxCh)
 
 		// Starts the input port observers
-		startInPortsObservers(inputs, inputsMuxCh, obsDoneCh, &obsWg, m, logger)
+		observers := startInPortsObservers(inputs, inputsMuxCh, &obsWg, m, logger)
 
 		for {
 			select {
 			case <-doneCh:
 				logger.Debugf("Receiver shuts down.")
-				close(obsDoneCh)
-				logger.Debugf("Receiver closed the 'obsDoneCh'.")
+				observers.stopAll()
+				logger.Debugf("Receiver stopped the observers.")
 				logger.Debugf("Receiver starts waiting for observers to stop")
 				obsWg.Wait()
 				logger.Debugf("Receiver's observers stopped")
@@ -72,12 +73,17 @@ func SyncReceiver(inputsCfg config.Inputs, resetCh chan interface{}, doneCh chan
 				logger.Debugf("Receiver got RESET signal")
 				receiveAndProcessMsg := orchestra.NewReceiveAndProcessMessage(float64(0))
 				inputs.SetMessage("_RAP", receiveAndProcessMsg)
+				inputs.Deliver(time.Now())
 				inputsCh <- inputs
 				logger.Debugf("Receiver sent 'inputs' to 'inputsCh'")
 
+			case inputsCfg := <-configCh:
+				logger.Debugf("Receiver got ports configuration")
+				configureInPorts(inputs, inputsCfg, observers, inputsMuxCh, &obsWg, m, logger)
+
 			case input := <-inputsMuxCh:
 				logger.Debugf("Receiver got message to '%s' port", input.Name)
-				inputs.SetMessage(input.Name, input.Message)
+				inputs.Receive(input.Name, input.Message, time.Now())
 
 			case messageBytes := <-receiveAndProcessCh:
 				logger.Debugf("Receiver received 'receive-and-process' message from orchestrator")
@@ -86,6 +92,7 @@ func SyncReceiver(inputsCfg config.Inputs, resetCh chan interface{}, doneCh chan
 					panic(err)
 				}
 				inputs.SetMessage("_RAP", receiveAndProcessMsg)
+				inputs.Deliver(time.Now())
 				inputsCh <- inputs
 				logger.Debugf("Receiver sent 'inputs' to 'inputsCh'")
 			}
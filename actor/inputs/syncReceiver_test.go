@@ -28,7 +28,7 @@ func TestSyncReceiverStartStop(t *testing.T) {
 	doneCh := make(chan interface{})
 
 	// Start the receiver process
-	startedCh, _, _ := SyncReceiver(syncInputsCfg, resetCh, doneCh, &wg, m, logger)
+	startedCh, _, _ := SyncReceiver(syncInputsCfg, resetCh, nil, doneCh, &wg, m, logger)
 	<-startedCh
 
 	// Wait until test is completed, then stop the processes
@@ -63,7 +63,7 @@ func TestSyncReceiverDefaultsOnly(t *testing.T) {
 
 	// Start the receiver process
 	doneRcvCh := make(chan interface{})
-	startedCh, inputsCh, rcvStoppedCh := SyncReceiver(syncInputsCfg, resetCh, doneRcvCh, &wg, m, logger)
+	startedCh, inputsCh, rcvStoppedCh := SyncReceiver(syncInputsCfg, resetCh, nil, doneRcvCh, &wg, m, logger)
 	<-startedCh
 
 	doneProcCh := make(chan interface{})
@@ -129,7 +129,7 @@ func TestSyncReceiverInputs(t *testing.T) {
 
 	// Start the receiver process
 	doneRcvCh := make(chan interface{})
-	startedCh, inputsCh, rcvStoppedCh := SyncReceiver(syncInputsCfg, resetCh, doneRcvCh, &wg, m, logger)
+	startedCh, inputsCh, rcvStoppedCh := SyncReceiver(syncInputsCfg, resetCh, nil, doneRcvCh, &wg, m, logger)
 	<-startedCh
 
 	doneProcCh := make(chan interface{})
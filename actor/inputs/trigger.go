@@ -0,0 +1,101 @@
+package inputs
+
+import (
+	"github.com/tombenke/axon-go-common/config"
+	"github.com/tombenke/axon-go-common/io"
+	"github.com/tombenke/axon-go-common/msgs"
+	"math"
+	"time"
+)
+
+// trigger decides when the async receiver forwards the inputs to the processor, according to the firing rules
+type trigger struct {
+	rules    config.Trigger
+	throttle time.Duration
+	debounce time.Duration
+
+	// ports holds the names of the trigger ports
+	ports map[string]bool
+	// joined tells which join ports have received new values since the last processing
+	joined map[string]bool
+	// references holds the values of the deadband ports at the last processing
+	references map[string]float64
+
+	// armed is true if a firing message has arrived since the last processing
+	armed     bool
+	lastArmed time.Time
+	lastFired time.Time
+}
+
+// newTrigger creates a new trigger with the `rules` firing rules
+func newTrigger(rules config.Trigger) *trigger {
+	t := trigger{
+		rules:      rules,
+		throttle:   rules.ThrottleDuration(),
+		debounce:   rules.DebounceDuration(),
+		ports:      make(map[string]bool),
+		joined:     make(map[string]bool),
+		references: make(map[string]float64),
+	}
+	for _, name := range rules.Ports {
+		t.ports[name] = true
+	}
+	return &t
+}
+
+// received registers the `msg` message received via the `name` port at `now` time.
+// The message arms the trigger if it passes the deadband of the port, and it arrived via a trigger port,
+// or there are no trigger ports defined.
+func (t *trigger) received(name string, msg msgs.Message, now time.Time) {
+	if band, ok := t.rules.Deadband[name]; ok {
+		value, _ := io.NumericValue(msg)
+		if reference, ok := t.references[name]; ok && math.Abs(value-reference) <= band {
+			return
+		}
+	}
+
+	t.joined[name] = true
+	if len(t.ports) == 0 || t.ports[name] {
+		t.armed = true
+		t.lastArmed = now
+	}
+}
+
+// delay returns with the time remaining from `now` until the processing can fire because of the throttle and debounce rules,
+// and true if the trigger is armed and all the join ports have received new values, so the processing has to fire.
+func (t *trigger) delay(now time.Time) (time.Duration, bool) {
+	if !t.armed {
+		return 0, false
+	}
+	for _, name := range t.rules.Join {
+		if !t.joined[name] {
+			return 0, false
+		}
+	}
+
+	var wait time.Duration
+	if t.throttle > 0 && !t.lastFired.IsZero() {
+		wait = t.lastFired.Add(t.throttle).Sub(now)
+	}
+	if t.debounce > 0 {
+		if quiet := t.lastArmed.Add(t.debounce).Sub(now); quiet > wait {
+			wait = quiet
+		}
+	}
+	return wait, true
+}
+
+// fired resets the trigger after the processing fired at `now` time with the `inputs`,
+// and takes the values of the deadband ports as the references of the next changes
+func (t *trigger) fired(inputs *io.Inputs, now time.Time) {
+	t.armed = false
+	t.joined = make(map[string]bool)
+	t.lastFired = now
+	for name := range t.rules.Deadband {
+		if msg, ok := inputs.LookupMessage(name); ok {
+			if value, ok := io.NumericValue(msg); ok {
+				t.references[name] = value
+			}
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package inputs
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/tombenke/axon-go-common/config"
+	"github.com/tombenke/axon-go-common/io"
+	messengerImpl "github.com/tombenke/axon-go-common/messenger/nats"
+	"github.com/tombenke/axon-go-common/msgs"
+	"github.com/tombenke/axon-go-common/msgs/base"
+	"sync"
+	"testing"
+	"time"
+)
+
+var triggerInputsCfg = config.Inputs{
+	config.In{IO: config.IO{Name: "level", Type: base.Float64TypeName, Representation: "application/json", Channel: "trigger-test.level"}},
+	config.In{IO: config.IO{Name: "flow", Type: base.Float64TypeName, Representation: "application/json", Channel: "trigger-test.flow"}},
+	config.In{IO: config.IO{Name: "tick", Type: base.BoolTypeName, Representation: "application/json", Channel: "trigger-test.tick"}},
+}
+
+func TestTriggerNoRules(t *testing.T) {
+	trigger := newTrigger(config.Trigger{})
+	now := time.Now()
+	_, due := trigger.delay(now)
+	assert.False(t, due)
+
+	trigger.received("level", base.NewFloat64Message(1), now)
+	wait, due := trigger.delay(now)
+	assert.True(t, due)
+	assert.Equal(t, time.Duration(0), wait)
+}
+
+func TestTriggerPorts(t *testing.T) {
+	trigger := newTrigger(config.Trigger{Ports: []string{"tick"}})
+	now := time.Now()
+	trigger.received("level", base.NewFloat64Message(1), now)
+	_, due := trigger.delay(now)
+	assert.False(t, due)
+
+	trigger.received("tick", base.NewBoolMessage(true), now)
+	_, due = trigger.delay(now)
+	assert.True(t, due)
+}
+
+func TestTriggerJoin(t *testing.T) {
+	inputs := io.NewInputs(triggerInputsCfg)
+	trigger := newTrigger(config.Trigger{Join: []string{"level", "flow"}})
+	now := time.Now()
+
+	trigger.received("level", base.NewFloat64Message(1), now)
+	trigger.received("level", base.NewFloat64Message(2), now)
+	_, due := trigger.delay(now)
+	assert.False(t, due)
+
+	trigger.received("flow", base.NewFloat64Message(3), now)
+	_, due = trigger.delay(now)
+	assert.True(t, due)
+
+	trigger.fired(inputs, now)
+	trigger.received("flow", base.NewFloat64Message(4), now)
+	_, due = trigger.delay(now)
+	assert.False(t, due, "the level has no new value since the last processing")
+}
+
+func TestTriggerThrottle(t *testing.T) {
+	inputs := io.NewInputs(triggerInputsCfg)
+	trigger := newTrigger(config.Trigger{Throttle: "1s"})
+	start := time.Now()
+
+	trigger.received("level", base.NewFloat64Message(1), start)
+	wait, due := trigger.delay(start)
+	assert.True(t, due)
+	assert.Equal(t, time.Duration(0), wait, "the first message fires immediately")
+	trigger.fired(inputs, start)
+
+	trigger.received("level", base.NewFloat64Message(2), start.Add(300*time.Millisecond))
+	wait, due = trigger.delay(start.Add(300 * time.Millisecond))
+	assert.True(t, due)
+	assert.Equal(t, 700*time.Millisecond, wait)
+
+	wait, _ = trigger.delay(start.Add(time.Second))
+	assert.Equal(t, time.Duration(0), wait)
+}
+
+func TestTriggerDebounce(t *testing.T) {
+	trigger := newTrigger(config.Trigger{Debounce: "200ms"})
+	start := time.Now()
+
+	trigger.received("level", base.NewFloat64Message(1), start)
+	wait, due := trigger.delay(start)
+	assert.True(t, due)
+	assert.Equal(t, 200*time.Millisecond, wait)
+
+	trigger.received("level", base.NewFloat64Message(2), start.Add(150*time.Millisecond))
+	wait, _ = trigger.delay(start.Add(200 * time.Millisecond))
+	assert.Equal(t, 150*time.Millisecond, wait, "the new message restarts the quiet period")
+
+	wait, _ = trigger.delay(start.Add(350 * time.Millisecond))
+	assert.Equal(t, time.Duration(0), wait)
+}
+
+func TestTriggerDeadband(t *testing.T) {
+	inputs := io.NewInputs(triggerInputsCfg)
+	trigger := newTrigger(config.Trigger{Deadband: map[string]float64{"level": 0.5}})
+	now := time.Now()
+
+	inputs.Receive("level", base.NewFloat64Message(10), now)
+	trigger.received("level", base.NewFloat64Message(10), now)
+	_, due := trigger.delay(now)
+	assert.True(t, due, "there is no reference value before the first processing")
+	trigger.fired(inputs, now)
+
+	for _, value := range []float64{10.2, 9.5, 10.5} {
+		trigger.received("level", base.NewFloat64Message(value), now)
+		_, due = trigger.delay(now)
+		assert.False(t, due, "%g is within the deadband", value)
+	}
+
+	trigger.received("level", base.NewFloat64Message(10.6), now)
+	_, due = trigger.delay(now)
+	assert.True(t, due)
+
+	trigger.fired(inputs, now)
+	trigger.received("flow", base.NewFloat64Message(1), now)
+	_, due = trigger.delay(now)
+	assert.True(t, due, "the ports without deadband fire on any message")
+}
+
+// TestAsyncReceiverTriggerPorts checks that the async receiver forwards the inputs only when the trigger port receives
+func TestAsyncReceiverTriggerPorts(t *testing.T) {
+	m := messengerImpl.NewMessenger(messengerCfg)
+	defer m.Close()
+
+	wg := sync.WaitGroup{}
+	resetCh := make(chan interface{})
+	doneRcvCh := make(chan interface{})
+	startedCh, inputsCh, rcvStoppedCh := AsyncReceiver(triggerInputsCfg, config.Trigger{Ports: []string{"tick"}}, resetCh, nil, doneRcvCh, &wg, m, logger)
+	<-startedCh
+
+	// Give chance for observers to start before send messages through external messaging mw.
+	time.Sleep(100 * time.Millisecond)
+
+	if err := m.Publish("trigger-test.level", base.NewFloat64Message(42).Encode(msgs.JSONRepresentation)); err != nil {
+		panic(err)
+	}
+	select {
+	case <-inputsCh:
+		assert.Fail(t, "the level port must not fire the processing")
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	if err := m.Publish("trigger-test.tick", base.NewBoolMessage(true).Encode(msgs.JSONRepresentation)); err != nil {
+		panic(err)
+	}
+	inputs := <-inputsCh
+	assert.Equal(t, 42.0, inputs.GetMessage("level").(*base.Float64).Body.Data)
+	assert.True(t, inputs.GetMessage("tick").(*base.Bool).Body.Data)
+
+	close(doneRcvCh)
+	<-rcvStoppedCh
+	close(resetCh)
+
+	wg.Wait()
+}
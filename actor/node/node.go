@@ -5,6 +5,7 @@ package node
 import (
 	"sync"
 
+	"github.com/tombenke/axon-go-common/actor/configurator"
 	"github.com/tombenke/axon-go-common/actor/inputs"
 	"github.com/tombenke/axon-go-common/actor/outputs"
 	"github.com/tombenke/axon-go-common/actor/processor"
@@ -25,44 +26,68 @@ type Node struct {
 	doneCh    chan interface{}
 	resetCh   chan interface{}
 
-	doneStatusCh    chan interface{}
-	doneInputsRcvCh chan interface{}
-	doneProcessorCh chan interface{}
-	doneOutputsCh   chan interface{}
+	doneStatusCh       chan interface{}
+	doneConfiguratorCh chan interface{}
+	doneInputsRcvCh    chan interface{}
+	doneProcessorCh    chan interface{}
+	doneOutputsCh      chan interface{}
 
 	// Declare the channels for communication among the componens
 	inputsCh  chan *io.Inputs
 	outputsCh chan io.Outputs
 
+	// Declare the channels through which the configurator forwards the changes of the configuration
+	inputsCfgCh  chan config.Inputs
+	outputsCfgCh chan config.Outputs
+	statusCfgCh  chan config.Node
+	reloadCh     chan configurator.ReloadRequest
+
 	// Declare the channels through which the components notify that they have stopped
-	inputsRcvStoppedCh chan interface{}
-	processorStoppedCh chan interface{}
-	outputsStoppedCh   chan interface{}
-	statusStoppedCh    chan interface{}
-	wg                 *sync.WaitGroup
+	inputsRcvStoppedCh    chan interface{}
+	processorStoppedCh    chan interface{}
+	outputsStoppedCh      chan interface{}
+	statusStoppedCh       chan interface{}
+	configuratorStoppedCh chan interface{}
+	wg                    *sync.WaitGroup
 }
 
 // NewNode creates and returns with a new `Node` object
-// which represents the common core component of an actor-node application
-func NewNode(config config.Node, procFun func(processor.Context) error) Node {
+// which represents the common core component of an actor-node application.
+// It panics with the list of all the problems found, if the `nodeConfig` configuration is invalid,
+// or the optional `ports` declared by the `procFun` processor function do not match to the configured ports.
+func NewNode(nodeConfig config.Node, procFun func(processor.Context) error, ports ...processor.Port) Node {
+	if err := nodeConfig.Validate(); err != nil {
+		panic(err)
+	}
+	if err := processor.ValidatePorts(nodeConfig.Ports, ports...); err != nil {
+		panic(err)
+	}
+
 	node := Node{
-		config:  config,
-		name:    config.Name,
+		config:  nodeConfig,
+		name:    nodeConfig.Name,
 		procFun: procFun,
 		doneCh:  make(chan interface{}),
 		resetCh: make(chan interface{}),
 
 		// Create channels to control the shut down of the components
-		doneStatusCh:    make(chan interface{}),
-		doneInputsRcvCh: make(chan interface{}),
-		doneProcessorCh: make(chan interface{}),
-		doneOutputsCh:   make(chan interface{}),
-		wg:              &sync.WaitGroup{},
+		doneStatusCh:       make(chan interface{}),
+		doneConfiguratorCh: make(chan interface{}),
+		doneInputsRcvCh:    make(chan interface{}),
+		doneProcessorCh:    make(chan interface{}),
+		doneOutputsCh:      make(chan interface{}),
+
+		// Create channels to forward the configuration changes to the components
+		inputsCfgCh:  make(chan config.Inputs),
+		outputsCfgCh: make(chan config.Outputs),
+		statusCfgCh:  make(chan config.Node),
+		reloadCh:     make(chan configurator.ReloadRequest),
+		wg:           &sync.WaitGroup{},
 	}
 
 	// Configure the global logger of the application according to the configuration
-	log.SetLevelStr(config.LogLevel)
-	log.SetFormatterStr(config.LogFormat)
+	log.SetLevelStr(nodeConfig.LogLevel)
+	log.SetFormatterStr(nodeConfig.LogFormat)
 
 	// Connect to messaging
 	node.config.Messenger.Logger = log.Logger
@@ -74,27 +99,32 @@ func NewNode(config config.Node, procFun func(processor.Context) error) Node {
 	log.Logger.Debugf("Start '%s' actor node's internal components", node.config.Name)
 	// Start the status component to communicate with the orchestrator
 	var startedCh chan interface{}
-	startedCh, node.statusStoppedCh = status.Status(node.config, node.doneStatusCh, node.wg, node.messenger, log.Logger)
+	startedCh, node.statusStoppedCh = status.Status(node.config, node.statusCfgCh, node.doneStatusCh, node.wg, node.messenger, log.Logger)
 	<-startedCh
 
 	// Start the core components of the Node
 	if node.config.Orchestration.Synchronization {
 		// Start the core components in synchronous mode
-		startedCh, node.inputsCh, node.inputsRcvStoppedCh = inputs.SyncReceiver(node.config.Ports.Inputs, node.resetCh, node.doneInputsRcvCh, node.wg, node.messenger, log.Logger)
+		startedCh, node.inputsCh, node.inputsRcvStoppedCh = inputs.SyncReceiver(node.config.Ports.Inputs, node.resetCh, node.inputsCfgCh, node.doneInputsRcvCh, node.wg, node.messenger, log.Logger)
 		<-startedCh
-		startedCh, node.outputsCh, node.processorStoppedCh = processor.StartProcessor(node.procFun, node.config.Ports.Outputs, node.doneProcessorCh, node.wg, node.inputsCh, log.Logger)
+		startedCh, node.outputsCh, node.processorStoppedCh = processor.StartProcessor(node.procFun, node.config.Ports.Outputs, node.outputsCfgCh, node.doneProcessorCh, node.wg, node.inputsCh, log.Logger)
 		<-startedCh
 		startedCh, node.outputsStoppedCh = outputs.SyncSender(node.name, node.outputsCh, node.doneOutputsCh, node.wg, node.messenger, log.Logger)
 		<-startedCh
 	} else {
 		// Start the core components in asynchronous mode
-		startedCh, node.inputsCh, node.inputsRcvStoppedCh = inputs.AsyncReceiver(node.config.Ports.Inputs, node.resetCh, node.doneInputsRcvCh, node.wg, node.messenger, log.Logger)
+		startedCh, node.inputsCh, node.inputsRcvStoppedCh = inputs.AsyncReceiver(node.config.Ports.Inputs, node.config.Trigger, node.resetCh, node.inputsCfgCh, node.doneInputsRcvCh, node.wg, node.messenger, log.Logger)
 		<-startedCh
-		startedCh, node.outputsCh, node.processorStoppedCh = processor.StartProcessor(node.procFun, node.config.Ports.Outputs, node.doneProcessorCh, node.wg, node.inputsCh, log.Logger)
+		startedCh, node.outputsCh, node.processorStoppedCh = processor.StartProcessor(node.procFun, node.config.Ports.Outputs, node.outputsCfgCh, node.doneProcessorCh, node.wg, node.inputsCh, log.Logger)
 		<-startedCh
 		startedCh, node.outputsStoppedCh = outputs.AsyncSender(node.name, node.outputsCh, node.doneOutputsCh, node.wg, node.messenger, log.Logger)
 		<-startedCh
 	}
+
+	// Start the configurator to accept the reconfiguration of the I/O ports during operation
+	startedCh, node.configuratorStoppedCh = configurator.Configurator(node.config, node.reloadCh, node.inputsCfgCh, node.outputsCfgCh, node.statusCfgCh, node.doneConfiguratorCh, node.wg, node.messenger, log.Logger)
+	<-startedCh
+
 	return node
 }
 
@@ -115,6 +145,10 @@ func (n Node) Start() chan interface{} {
 		<-n.doneCh
 		log.Logger.Debugf("Node is shutting down")
 
+		// Stop configurator
+		close(n.doneConfiguratorCh)
+		<-n.configuratorStoppedCh
+
 		// Stop status
 		close(n.doneStatusCh)
 		<-n.statusStoppedCh
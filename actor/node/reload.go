@@ -0,0 +1,89 @@
+package node
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	"github.com/tombenke/axon-go-common/actor/configurator"
+	"github.com/tombenke/axon-go-common/config"
+	"github.com/tombenke/axon-go-common/file"
+	"github.com/tombenke/axon-go-common/gsd"
+	"github.com/tombenke/axon-go-common/log"
+)
+
+// ConfigWatchInterval is the time interval the config file watcher uses to check the changes of the file
+var ConfigWatchInterval = time.Second
+
+// Reload applies the `newConfig` configuration to the running node.
+// The log level and format, the status channels, the channels, representations and defaults of the ports,
+// as well as the new ports are applied immediately.
+// It returns with the descriptions of those changes that can only be applied by restarting the node.
+func (n Node) Reload(newConfig config.Node) ([]string, error) {
+	return n.reload(configurator.ReloadRequest{Config: newConfig})
+}
+
+// ReloadConfig re-reads the config file of the node, and merges it with the `hardCoded` configuration
+// through `config.MergeNodeConfigs`, then applies the resulting configuration like `Reload` does.
+// The properties the file does not define are taken from the current configuration of the node,
+// so the port changes applied during operation are kept.
+func (n Node) ReloadConfig(hardCoded config.Node) ([]string, error) {
+	return n.reload(configurator.ReloadRequest{Load: func(current config.Node) (config.Node, error) {
+		return config.ReloadNodeConfig(hardCoded, current)
+	}})
+}
+
+// reload sends the `request` to the configurator, then waits for its result
+func (n Node) reload(request configurator.ReloadRequest) ([]string, error) {
+	resultCh := make(chan configurator.ReloadResult, 1)
+	request.ResultCh = resultCh
+	select {
+	case n.reloadCh <- request:
+	case <-n.doneCh:
+		return nil, errors.New("node is shutting down")
+	}
+
+	result := <-resultCh
+	return result.RestartRequired, result.Err
+}
+
+// WatchConfig makes the node to reload its configuration via `ReloadConfig` every time it receives a SIGHUP signal.
+// If `watchFile` is true, it also reloads the configuration every time the config file changes.
+// The watching stops when the node shuts down.
+func (n Node) WatchConfig(hardCoded config.Node, watchFile bool) {
+	gsd.RegisterHangup(n.doneCh, n.wg, func(s os.Signal) {
+		n.reloadConfig(hardCoded)
+	})
+
+	if watchFile {
+		log.Logger.Debugf("Node watches the '%s' config file", n.config.ConfigFileName)
+		changedCh := file.Watch(n.config.ConfigFileName, ConfigWatchInterval, n.doneCh, n.wg)
+
+		n.wg.Add(1)
+		go func() {
+			defer n.wg.Done()
+			for {
+				select {
+				case <-n.doneCh:
+					return
+
+				case <-changedCh:
+					n.reloadConfig(hardCoded)
+				}
+			}
+		}()
+	}
+}
+
+// reloadConfig reloads the configuration, and logs the results
+func (n Node) reloadConfig(hardCoded config.Node) {
+	log.Logger.Infof("Reload '%s' actor node's configuration", n.config.Name)
+	restartRequired, err := n.ReloadConfig(hardCoded)
+	if err != nil {
+		log.Logger.Errorf("Node could not reload the configuration: %s", err)
+		return
+	}
+	if len(restartRequired) > 0 {
+		log.Logger.Warnf("Node must be restarted to apply %d changes of the configuration", len(restartRequired))
+	}
+}
@@ -0,0 +1,67 @@
+package node_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/tombenke/axon-go-common/actor/node"
+	"github.com/tombenke/axon-go-common/log"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestNodeReload(t *testing.T) {
+	log.Logger.Infof("\nTestNodeReload ==========")
+	n := node.NewNode(makeNodeTestConfig(), ProcessorFun)
+	n.Start()
+
+	newConfig := makeNodeTestConfig()
+	newConfig.Ports.Inputs[1].Channel = "new-well-water-level"
+	newConfig.Ports.Outputs[0].Type = "base/String"
+	restartRequired, err := n.Reload(newConfig)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"ports.outputs.water-level-state.type: 'base/Bool' -> 'base/String'"}, restartRequired)
+
+	n.Shutdown()
+	n.Wait()
+}
+
+func TestNodeReloadConfig(t *testing.T) {
+	log.Logger.Infof("\nTestNodeReloadConfig ==========")
+	dir, err := ioutil.TempDir("", "node-reload-test")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	hardCoded := makeNodeTestConfig()
+	hardCoded.Ports.Configure.Extend = true
+	nodeConfig := hardCoded
+	nodeConfig.ConfigFileName = filepath.Join(dir, "config.yml")
+	n := node.NewNode(nodeConfig, ProcessorFun)
+	n.Start()
+
+	// The port added during operation must be kept by the reload of the config file
+	extendedConfig := nodeConfig
+	extendedConfig.AddOutputPort("water-level-alarm", "base/Bool", "application/json", "well-water-level-alarm")
+	restartRequired, err := n.Reload(extendedConfig)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{}, restartRequired)
+
+	content := `node:
+  name: ` + actorName + `
+  logLevel: debug
+  ports:
+    inputs:
+      - name: water-level
+        type: base/Float64
+        representation: application/json
+        channel: new-well-water-level
+`
+	assert.Nil(t, ioutil.WriteFile(nodeConfig.ConfigFileName, []byte(content), 0644))
+
+	restartRequired, err = n.ReloadConfig(hardCoded)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{}, restartRequired)
+
+	n.Shutdown()
+	n.Wait()
+}
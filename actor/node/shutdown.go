@@ -0,0 +1,27 @@
+package node
+
+import (
+	"context"
+	"github.com/tombenke/axon-go-common/gsd"
+)
+
+// RegisterShutdown registers the shutdown of the node as a hook of the `manager` with the given `priority`.
+// The hook shuts down the node, then waits until its components stop, or the deadline of the shutdown expires.
+func (n Node) RegisterShutdown(manager *gsd.Manager, priority int) {
+	manager.AddHook("node:"+n.name, priority, func(ctx context.Context) error {
+		n.Shutdown()
+
+		stoppedCh := make(chan interface{})
+		go func() {
+			n.Wait()
+			close(stoppedCh)
+		}()
+
+		select {
+		case <-stoppedCh:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
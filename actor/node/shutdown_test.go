@@ -0,0 +1,23 @@
+package node_test
+
+import (
+	"github.com/tombenke/axon-go-common/actor/node"
+	"github.com/tombenke/axon-go-common/gsd"
+	"github.com/tombenke/axon-go-common/log"
+	"testing"
+	"time"
+)
+
+func TestNodeRegisterShutdown(t *testing.T) {
+	log.Logger.Infof("\nTestNodeRegisterShutdown ==========")
+	n := node.NewNode(makeNodeTestConfig(), ProcessorFun)
+	<-n.Start()
+
+	manager := gsd.NewManager(5 * time.Second)
+	n.RegisterShutdown(manager, 0)
+	manager.Start()
+
+	manager.Shutdown()
+	manager.Wait()
+	n.Wait()
+}
@@ -6,14 +6,18 @@ import (
 	"github.com/tombenke/axon-go-common/io"
 	"github.com/tombenke/axon-go-common/messenger"
 	"sync"
+	"time"
 )
 
 // AsyncSender receives outputs from the processor function via the `outputsCh` that it sends to
 // the corresponding topics identified by the port.
 // The outputs structures hold every details about the ports, the message itself, and the subject to send.
+// The messages held back by the rate limits and batches of the ports are published by a timer when they are due,
+// or when the sender shuts down.
 // This function runs as a standalone process, so it should be started as a go function.
 func AsyncSender(actorName string, outputsCh chan io.Outputs, doneCh chan interface{}, wg *sync.WaitGroup, m messenger.Messenger, logger *logrus.Logger) (chan interface{}, chan interface{}) {
 	var outputs io.Outputs
+	emitter := newEmitter()
 	senderStoppedCh := make(chan interface{})
 	startedCh := make(chan interface{})
 
@@ -25,36 +29,44 @@ func AsyncSender(actorName string, outputsCh chan io.Outputs, doneCh chan interf
 		defer close(senderStoppedCh)
 		defer wg.Done()
 
+		// Create the timer that flushes the messages held back by the rate limits and batches of the ports
+		var timer *time.Timer
+		var timerCh <-chan time.Time
+		stopTimer := func() {
+			if timer != nil {
+				timer.Stop()
+				timer, timerCh = nil, nil
+			}
+		}
+		startTimer := func() {
+			stopTimer()
+			if deadline, ok := emitter.deadline(); ok {
+				timer = time.NewTimer(time.Until(deadline))
+				timerCh = timer.C
+			}
+		}
+		defer stopTimer()
+
 		for {
 			select {
 			case <-doneCh:
 				logger.Debugf("Sender shuts down.")
+				emitter.drain(time.Now(), m, logger)
 				return
 
 			case outputs = <-outputsCh:
 				logger.Debugf("Sender received outputs")
 				// In async mode it immediately sends the outputs whet it gets them
-				asyncSendOutputs(actorName, outputs, m, logger)
+				emitter.emit(outputs, time.Now(), m, logger)
+				startTimer()
+
+			case <-timerCh:
+				timer, timerCh = nil, nil
+				emitter.flush(time.Now(), m, logger)
+				startTimer()
 			}
 		}
 	}()
 
 	return startedCh, senderStoppedCh
 }
-
-func asyncSendOutputs(actorName string, outputs io.Outputs, m messenger.Messenger, logger *logrus.Logger) {
-	for o := range outputs {
-		message := outputs[o].Message
-		channel := outputs[o].Channel
-		representation := outputs[o].Representation
-		messageType := outputs[o].Type
-		if message != nil {
-			logger.Debugf("Sender sends '%v' type message of '%s' output port to '%s' channel in '%s' format", messageType, o, channel, representation)
-			if err := m.Publish(channel, message.Encode(representation)); err != nil {
-				panic(err)
-			}
-		} else {
-			logger.Errorf("Sender wants to send '%v' type message of '%s' output port to '%s' channel in '%s' format but message is nil", messageType, o, channel, representation)
-		}
-	}
-}
@@ -1,7 +1,12 @@
 package outputs
 
 import (
+	"github.com/stretchr/testify/assert"
+	"github.com/tombenke/axon-go-common/config"
+	"github.com/tombenke/axon-go-common/io"
 	messengerImpl "github.com/tombenke/axon-go-common/messenger/nats"
+	"github.com/tombenke/axon-go-common/msgs"
+	"github.com/tombenke/axon-go-common/msgs/base"
 	at "github.com/tombenke/axon-go-common/testing"
 	"sync"
 	"testing"
@@ -70,3 +75,77 @@ func TestAsyncSender(t *testing.T) {
 	// Wait for the message to come in
 	wg.Wait()
 }
+
+func TestAsyncSenderRateLimitAndBatch(t *testing.T) {
+	m := messengerImpl.NewMessenger(messengerCfg)
+	defer m.Close()
+
+	samplesCh := make(chan []byte, 10)
+	samplesSubs := m.ChanSubscribe("async-sender-samples", samplesCh)
+	defer samplesSubs.Unsubscribe()
+
+	wg := sync.WaitGroup{}
+	outputsCh := make(chan io.Outputs)
+	doneCh := make(chan interface{})
+	startedCh, senderStoppedCh := AsyncSender(actorName, outputsCh, doneCh, &wg, m, logger)
+	<-startedCh
+
+	outputs := io.NewOutputs(config.Outputs{config.Out{
+		IO:    config.IO{Name: "samples", Type: base.Float64TypeName, Representation: "application/json", Channel: "async-sender-samples"},
+		Rate:  config.RateLimit{Interval: "50ms", Policy: config.RateAverage},
+		Batch: config.Batch{Interval: "200ms"},
+	}})
+	for _, value := range []float64{1, 2, 3} {
+		outputs = outputs.Renew()
+		outputs.SetMessage("samples", base.NewFloat64Message(value))
+		outputsCh <- outputs
+	}
+
+	select {
+	case data := <-samplesCh:
+		batch := base.NewFloat64ArrayMessage([]float64{})
+		assert.Nil(t, batch.Decode(msgs.JSONRepresentation, data))
+		assert.Equal(t, []float64{2}, batch.(*base.Float64Array).Body.Data)
+	case <-time.After(time.Second):
+		t.Error("the batch has not arrived")
+	}
+
+	close(doneCh)
+	<-senderStoppedCh
+	wg.Wait()
+}
+
+func TestAsyncSenderPublishesBatchOnShutdown(t *testing.T) {
+	m := messengerImpl.NewMessenger(messengerCfg)
+	defer m.Close()
+
+	samplesCh := make(chan []byte, 10)
+	samplesSubs := m.ChanSubscribe("async-sender-shutdown", samplesCh)
+	defer samplesSubs.Unsubscribe()
+
+	wg := sync.WaitGroup{}
+	outputsCh := make(chan io.Outputs)
+	doneCh := make(chan interface{})
+	startedCh, senderStoppedCh := AsyncSender(actorName, outputsCh, doneCh, &wg, m, logger)
+	<-startedCh
+
+	outputs := io.NewOutputs(config.Outputs{config.Out{
+		IO:    config.IO{Name: "samples", Type: base.Int64TypeName, Representation: "application/json", Channel: "async-sender-shutdown"},
+		Batch: config.Batch{Interval: "1h"},
+	}})
+	outputs.SetMessage("samples", base.NewInt64Message(42))
+	outputsCh <- outputs
+
+	close(doneCh)
+	<-senderStoppedCh
+	wg.Wait()
+
+	select {
+	case data := <-samplesCh:
+		batch := base.NewInt64ArrayMessage([]int64{})
+		assert.Nil(t, batch.Decode(msgs.JSONRepresentation, data))
+		assert.Equal(t, []int64{42}, batch.(*base.Int64Array).Body.Data)
+	case <-time.After(time.Second):
+		t.Error("the batch has not arrived")
+	}
+}
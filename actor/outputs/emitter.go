@@ -0,0 +1,164 @@
+package outputs
+
+import (
+	"github.com/sirupsen/logrus"
+	"github.com/tombenke/axon-go-common/config"
+	"github.com/tombenke/axon-go-common/io"
+	"github.com/tombenke/axon-go-common/messenger"
+	"github.com/tombenke/axon-go-common/msgs"
+	"math"
+	"reflect"
+	"time"
+)
+
+// emitter publishes the outputs according to the emission modes, the rate limits and the batches of the ports
+type emitter struct {
+	// emitted holds the last message emitted via each port, the changes are compared to
+	emitted map[string]msgs.Message
+	// limiters holds the rate limiting and batching state of the ports
+	limiters map[string]*limiter
+}
+
+// newEmitter creates a new emitter that has not emitted any message yet
+func newEmitter() *emitter {
+	return &emitter{emitted: make(map[string]msgs.Message), limiters: make(map[string]*limiter)}
+}
+
+// shouldEmit returns true if the message of the `output` port has to be published
+func (e *emitter) shouldEmit(output io.Output) bool {
+	if output.Message == nil {
+		return false
+	}
+
+	switch output.Emit {
+	case config.EmitAlways:
+		return true
+	case config.EmitChange:
+		return output.Updated && e.changed(output)
+	default:
+		return output.Updated
+	}
+}
+
+// changed returns true if the message of the `output` port differs from the last one emitted via the port.
+// The numeric messages differ only if their change is beyond the deadband of the port,
+// the other messages differ if their bodies are not equal.
+func (e *emitter) changed(output io.Output) bool {
+	last, ok := e.emitted[output.Name]
+	if !ok {
+		return true
+	}
+
+	if value, ok := io.NumericValue(output.Message); ok {
+		if lastValue, ok := io.NumericValue(last); ok {
+			if output.Deadband > 0 {
+				return math.Abs(value-lastValue) > output.Deadband
+			}
+			return value != lastValue
+		}
+	}
+	return !reflect.DeepEqual(bodyOf(output.Message), bodyOf(last))
+}
+
+// bodyOf returns with the body of the `msg` message, so the messages can be compared without their headers
+func bodyOf(msg msgs.Message) interface{} {
+	v := reflect.Indirect(reflect.ValueOf(msg))
+	if v.Kind() == reflect.Struct {
+		if body := v.FieldByName("Body"); body.IsValid() {
+			return body.Interface()
+		}
+	}
+	return msg
+}
+
+// emit passes the messages of the `outputs` ports that have to be emitted at `now` time through the rate limits and batches of the ports,
+// then publishes the messages that are due
+func (e *emitter) emit(outputs io.Outputs, now time.Time, m messenger.Messenger, logger *logrus.Logger) {
+	for o, output := range outputs {
+		l, ok := e.limiters[o]
+		if !ok {
+			l = &limiter{}
+			e.limiters[o] = l
+		} else if l.output.Type != output.Type || l.output.Rate != output.Rate || l.output.Batch != output.Batch {
+			// The messages held back are published with the former configuration, before the port is reconfigured
+			for _, msg := range l.drain(now) {
+				publish(l.output, msg, m, logger)
+			}
+			*l = limiter{}
+			delete(e.emitted, o)
+		}
+		l.output = output
+
+		if !e.shouldEmit(output) {
+			logger.Debugf("Sender skips the '%s' output port in '%s' emission mode", o, output.Emit)
+			continue
+		}
+
+		msg, accepted := l.limit(output.Message, now)
+		if !accepted {
+			logger.Debugf("Sender drops the message of the '%s' output port because of its rate limit", o)
+			continue
+		}
+		// Only the accepted messages are the references of the changes, so a dropped change is emitted later
+		e.emitted[o] = output.Message
+		if msg != nil {
+			if msg = l.collect(msg, now); msg != nil {
+				publish(output, msg, m, logger)
+			}
+		}
+	}
+	e.flush(now, m, logger)
+}
+
+// flush publishes the messages that are pending because of the rate limits, and the batches that are due at `now` time
+func (e *emitter) flush(now time.Time, m messenger.Messenger, logger *logrus.Logger) {
+	for _, l := range e.limiters {
+		if msg := l.limited(now); msg != nil {
+			if msg = l.collect(msg, now); msg != nil {
+				publish(l.output, msg, m, logger)
+			}
+		}
+		if msg := l.collected(now); msg != nil {
+			publish(l.output, msg, m, logger)
+		}
+	}
+}
+
+// drain publishes every message held back by the rate limits and the batches of the ports at `now` time,
+// regardless of their deadlines, e.g. when the sender shuts down
+func (e *emitter) drain(now time.Time, m messenger.Messenger, logger *logrus.Logger) {
+	for _, l := range e.limiters {
+		for _, msg := range l.drain(now) {
+			publish(l.output, msg, m, logger)
+		}
+	}
+}
+
+// deadline returns with the earliest time the emitter has to be flushed, and false if there is nothing pending
+func (e *emitter) deadline() (time.Time, bool) {
+	var deadline time.Time
+	for _, l := range e.limiters {
+		if d, ok := l.deadline(); ok && (deadline.IsZero() || d.Before(deadline)) {
+			deadline = d
+		}
+	}
+	return deadline, !deadline.IsZero()
+}
+
+// publish publishes the `msg` message of the `output` port to the channel of the port, and to its additional channels.
+// The empty nil-channel is skipped.
+func publish(output io.Output, msg msgs.Message, m messenger.Messenger, logger *logrus.Logger) {
+	send := func(channel string, representation msgs.Representation) {
+		if channel == "" {
+			return
+		}
+		logger.Debugf("Sender sends '%v' type message of '%s' output port to '%s' channel in '%s' format", msg.GetType(), output.Name, channel, representation)
+		if err := m.Publish(channel, msg.Encode(representation)); err != nil {
+			panic(err)
+		}
+	}
+	send(output.Channel, output.Representation)
+	for _, ch := range output.Channels {
+		send(ch.Name, ch.Representation)
+	}
+}
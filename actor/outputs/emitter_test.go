@@ -0,0 +1,163 @@
+package outputs
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/tombenke/axon-go-common/config"
+	"github.com/tombenke/axon-go-common/io"
+	messengerImpl "github.com/tombenke/axon-go-common/messenger/nats"
+	"github.com/tombenke/axon-go-common/msgs"
+	"github.com/tombenke/axon-go-common/msgs/base"
+	"testing"
+	"time"
+)
+
+func TestEmitterShouldEmit(t *testing.T) {
+	e := newEmitter()
+	output := io.Output{IO: io.IO{Name: "state", Type: base.BoolTypeName, Message: base.NewBoolMessage(true)}}
+
+	assert.False(t, e.shouldEmit(output))
+	output.Updated = true
+	assert.True(t, e.shouldEmit(output))
+
+	output.Updated = false
+	output.Emit = config.EmitAlways
+	assert.True(t, e.shouldEmit(output))
+
+	output.Message = nil
+	assert.False(t, e.shouldEmit(output))
+}
+
+func TestEmitterShouldEmitOnChange(t *testing.T) {
+	e := newEmitter()
+	state := io.Output{IO: io.IO{Name: "state", Type: base.StringTypeName, Message: base.NewStringMessage("ON")}, Emit: config.EmitChange, Updated: true}
+	assert.True(t, e.shouldEmit(state))
+	e.emitted["state"] = base.NewStringMessage("ON")
+	assert.False(t, e.shouldEmit(state))
+	state.Message = base.NewStringMessage("OFF")
+	assert.True(t, e.shouldEmit(state))
+
+	level := io.Output{IO: io.IO{Name: "level", Type: base.Float64TypeName, Message: base.NewFloat64Message(1.0)}, Emit: config.EmitChange, Updated: true}
+	e.emitted["level"] = base.NewFloat64Message(1.0)
+	assert.False(t, e.shouldEmit(level))
+	level.Message = base.NewFloat64Message(1.05)
+	assert.True(t, e.shouldEmit(level))
+
+	level.Deadband = 0.1
+	assert.False(t, e.shouldEmit(level))
+	level.Message = base.NewFloat64Message(0.85)
+	assert.True(t, e.shouldEmit(level))
+	level.Updated = false
+	assert.False(t, e.shouldEmit(level))
+}
+
+func TestEmitterEmit(t *testing.T) {
+	m := messengerImpl.NewMessenger(messengerCfg)
+	defer m.Close()
+
+	jsonCh := make(chan []byte, 10)
+	jsonSubs := m.ChanSubscribe("emitter-level", jsonCh)
+	defer jsonSubs.Unsubscribe()
+	yamlCh := make(chan []byte, 10)
+	yamlSubs := m.ChanSubscribe("emitter-level-yaml", yamlCh)
+	defer yamlSubs.Unsubscribe()
+
+	outputs := io.NewOutputs(config.Outputs{config.Out{
+		IO:       config.IO{Name: "level", Type: base.Float64TypeName, Representation: "application/json", Channel: "emitter-level"},
+		Channels: []config.OutChannel{{Channel: "emitter-level-yaml", Representation: "application/yaml"}},
+		Emit:     config.EmitChange,
+		Deadband: 0.5,
+	}})
+	e := newEmitter()
+
+	outputs.SetMessage("level", base.NewFloat64Message(42.))
+	e.emit(outputs, time.Now(), m, logger)
+	outputs = outputs.Renew()
+	e.emit(outputs, time.Now(), m, logger)
+	outputs.SetMessage("level", base.NewFloat64Message(42.2))
+	e.emit(outputs, time.Now(), m, logger)
+
+	received := func(ch chan []byte, representation msgs.Representation) *base.Float64 {
+		select {
+		case data := <-ch:
+			msg := base.NewFloat64Message(0)
+			assert.Nil(t, msg.Decode(representation, data))
+			return msg.(*base.Float64)
+		case <-time.After(time.Second):
+			t.Fatal("the message has not arrived")
+			return nil
+		}
+	}
+	assert.Equal(t, 42., received(jsonCh, msgs.JSONRepresentation).Body.Data)
+	assert.Equal(t, 42., received(yamlCh, msgs.YAMLRepresentation).Body.Data)
+
+	time.Sleep(100 * time.Millisecond)
+	assert.Empty(t, jsonCh)
+	assert.Empty(t, yamlCh)
+}
+
+func TestEmitterChangeWithDroppedMessage(t *testing.T) {
+	m := messengerImpl.NewMessenger(messengerCfg)
+	defer m.Close()
+
+	levelCh := make(chan []byte, 10)
+	levelSubs := m.ChanSubscribe("emitter-change-level", levelCh)
+	defer levelSubs.Unsubscribe()
+
+	outputs := io.NewOutputs(config.Outputs{config.Out{
+		IO:   config.IO{Name: "level", Type: base.Float64TypeName, Representation: "application/json", Channel: "emitter-change-level"},
+		Emit: config.EmitChange,
+		Rate: config.RateLimit{Interval: "100ms", Policy: config.RateDrop},
+	}})
+	e := newEmitter()
+	start := time.Now()
+	for i, value := range []float64{0, 10, 10} {
+		outputs = outputs.Renew()
+		outputs.SetMessage("level", base.NewFloat64Message(value))
+		e.emit(outputs, start.Add(time.Duration(i)*75*time.Millisecond), m, logger)
+	}
+
+	for _, expected := range []float64{0, 10} {
+		select {
+		case data := <-levelCh:
+			msg := base.NewFloat64Message(0)
+			assert.Nil(t, msg.Decode(msgs.JSONRepresentation, data))
+			assert.Equal(t, expected, msg.(*base.Float64).Body.Data)
+		case <-time.After(time.Second):
+			t.Fatalf("the %v message has not arrived", expected)
+		}
+	}
+}
+
+func TestEmitterTypeReconfiguredWithOpenBatch(t *testing.T) {
+	m := messengerImpl.NewMessenger(messengerCfg)
+	defer m.Close()
+
+	portCh := make(chan []byte, 10)
+	portSubs := m.ChanSubscribe("emitter-reconfigured", portCh)
+	defer portSubs.Unsubscribe()
+
+	out := config.Out{
+		IO:    config.IO{Name: "port", Type: base.StringTypeName, Representation: "application/json", Channel: "emitter-reconfigured"},
+		Batch: config.Batch{Size: 10},
+	}
+	outputs := io.NewOutputs(config.Outputs{out})
+	e := newEmitter()
+	outputs.SetMessage("port", base.NewStringMessage("a"))
+	e.emit(outputs, time.Now(), m, logger)
+
+	out.Type, out.Batch = base.Float64TypeName, config.Batch{}
+	outputs = outputs.ConfigurePorts(config.Outputs{out})
+	outputs.SetMessage("port", base.NewFloat64Message(1.5))
+	assert.NotPanics(t, func() { e.emit(outputs, time.Now(), m, logger) })
+
+	for _, expected := range []msgs.Message{base.NewStringArrayMessage([]string{"a"}), base.NewFloat64Message(1.5)} {
+		select {
+		case data := <-portCh:
+			msg := msgs.GetDefaultMessageByType(expected.GetType())
+			assert.Nil(t, msg.Decode(msgs.JSONRepresentation, data))
+			assert.Equal(t, bodyOf(expected), bodyOf(msg))
+		case <-time.After(time.Second):
+			t.Fatalf("the '%s' message has not arrived", expected.GetType())
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package outputs
+
+import (
+	"github.com/tombenke/axon-go-common/config"
+	"github.com/tombenke/axon-go-common/io"
+	"github.com/tombenke/axon-go-common/msgs"
+	"github.com/tombenke/axon-go-common/msgs/base"
+	"time"
+)
+
+// limiter holds the rate limiting and batching state of an output port
+type limiter struct {
+	// output holds the latest configuration of the port
+	output io.Output
+
+	// limitedAt is the start of the actual rate limiting interval
+	limitedAt time.Time
+	// pending holds the messages collected within the actual rate limiting interval by the `latest` and `average` policies
+	pending []msgs.Message
+
+	// batchedAt is the time the first message of the actual batch was collected
+	batchedAt time.Time
+	// batch holds the messages collected into the actual batch
+	batch []msgs.Message
+}
+
+// limit applies the rate limit of the port to the `msg` message emitted at `now` time.
+// It returns with the message to pass on, or nil if the message is dropped, or it is pending until the end of the interval.
+// It also returns true if the message is accepted, i.e. it is passed on or pending, and false if it is dropped.
+func (l *limiter) limit(msg msgs.Message, now time.Time) (msgs.Message, bool) {
+	rate := l.output.Rate
+	if !rate.IsDefined() {
+		return msg, true
+	}
+
+	switch rate.Policy {
+	case config.RateLatest, config.RateAverage:
+		if len(l.pending) == 0 {
+			l.limitedAt = now
+		}
+		l.pending = append(l.pending, msg)
+		return nil, true
+	default:
+		if l.limitedAt.IsZero() || !now.Before(l.limitedAt.Add(rate.IntervalDuration())) {
+			l.limitedAt = now
+			return msg, true
+		}
+		return nil, false
+	}
+}
+
+// limited returns with the latest or the average of the pending messages if their rate limiting interval is over at `now` time,
+// otherwise it returns with nil
+func (l *limiter) limited(now time.Time) msgs.Message {
+	if len(l.pending) == 0 || now.Before(l.limitedAt.Add(l.output.Rate.IntervalDuration())) {
+		return nil
+	}
+	return l.release(now)
+}
+
+// release returns with the latest or the average of the pending messages created at `now` time, and clears them
+func (l *limiter) release(now time.Time) msgs.Message {
+	pending := l.pending
+	l.pending = nil
+	if l.output.Rate.Policy != config.RateAverage {
+		return pending[len(pending)-1]
+	}
+
+	var sum float64
+	for _, msg := range pending {
+		value, _ := io.NumericValue(msg)
+		sum += value
+	}
+	return io.NumericMessageAt(l.output.Type, sum/float64(len(pending)), now)
+}
+
+// collect adds the `msg` message to the actual batch at `now` time.
+// It returns with the batch message if the batch is full, the message itself if the port is not batched, otherwise with nil.
+func (l *limiter) collect(msg msgs.Message, now time.Time) msgs.Message {
+	if !l.output.Batch.IsDefined() {
+		return msg
+	}
+
+	if len(l.batch) == 0 {
+		l.batchedAt = now
+	}
+	l.batch = append(l.batch, msg)
+	if size := l.output.Batch.Size; size > 0 && len(l.batch) >= size {
+		return l.batched(now)
+	}
+	return nil
+}
+
+// collected returns with the batch message if the batching interval of the actual batch is over at `now` time,
+// otherwise it returns with nil
+func (l *limiter) collected(now time.Time) msgs.Message {
+	interval := l.output.Batch.IntervalDuration()
+	if len(l.batch) == 0 || interval == 0 || now.Before(l.batchedAt.Add(interval)) {
+		return nil
+	}
+	return l.batched(now)
+}
+
+// batched returns with the array message created at `now` time from the messages of the actual batch, then starts a new batch
+func (l *limiter) batched(now time.Time) msgs.Message {
+	batch := l.batch
+	l.batch = nil
+
+	at := now.UnixNano()
+	switch l.output.Type {
+	case base.Float64TypeName:
+		return base.NewFloat64ArrayMessageAt(batchData(batch, func(msg *base.Float64) float64 { return msg.Body.Data }), at, "ns")
+	case base.Int64TypeName:
+		return base.NewInt64ArrayMessageAt(batchData(batch, func(msg *base.Int64) int64 { return msg.Body.Data }), at, "ns")
+	case base.BoolTypeName:
+		return base.NewBoolArrayMessageAt(batchData(batch, func(msg *base.Bool) bool { return msg.Body.Data }), at, "ns")
+	default:
+		return base.NewStringArrayMessageAt(batchData(batch, func(msg *base.String) string { return msg.Body.Data }), at, "ns")
+	}
+}
+
+// drain returns with the messages held back by the limiter regardless of their deadlines at `now` time:
+// the latest or the average of the pending messages, and the actual batch
+func (l *limiter) drain(now time.Time) []msgs.Message {
+	var drained []msgs.Message
+	if len(l.pending) > 0 {
+		if msg := l.collect(l.release(now), now); msg != nil {
+			drained = append(drained, msg)
+		}
+	}
+	if len(l.batch) > 0 {
+		drained = append(drained, l.batched(now))
+	}
+	return drained
+}
+
+// batchData returns with the values of the batched `messages` selected by the `data` function
+func batchData[M msgs.Message, T any](messages []msgs.Message, data func(M) T) []T {
+	values := make([]T, len(messages))
+	for i, msg := range messages {
+		values[i] = data(msg.(M))
+	}
+	return values
+}
+
+// deadline returns with the earliest time the pending messages or the actual batch has to be published,
+// and false if there is nothing to wait for
+func (l *limiter) deadline() (time.Time, bool) {
+	var deadline time.Time
+	if len(l.pending) > 0 {
+		deadline = l.limitedAt.Add(l.output.Rate.IntervalDuration())
+	}
+	if interval := l.output.Batch.IntervalDuration(); len(l.batch) > 0 && interval > 0 {
+		if batchDeadline := l.batchedAt.Add(interval); deadline.IsZero() || batchDeadline.Before(deadline) {
+			deadline = batchDeadline
+		}
+	}
+	return deadline, !deadline.IsZero()
+}
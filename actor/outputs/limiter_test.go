@@ -0,0 +1,113 @@
+package outputs
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/tombenke/axon-go-common/config"
+	"github.com/tombenke/axon-go-common/io"
+	"github.com/tombenke/axon-go-common/msgs"
+	"github.com/tombenke/axon-go-common/msgs/base"
+	"testing"
+	"time"
+)
+
+func newTestLimiter(portType string, rate config.RateLimit, batch config.Batch) *limiter {
+	return &limiter{output: io.Output{IO: io.IO{Name: "level", Type: portType}, Rate: rate, Batch: batch}}
+}
+
+func TestLimiterDrop(t *testing.T) {
+	l := newTestLimiter(base.Float64TypeName, config.RateLimit{Interval: "100ms"}, config.Batch{})
+	start := time.Now()
+
+	first := base.NewFloat64Message(1)
+	msg, accepted := l.limit(first, start)
+	assert.Equal(t, first, msg)
+	assert.True(t, accepted)
+	msg, accepted = l.limit(base.NewFloat64Message(2), start.Add(50*time.Millisecond))
+	assert.Nil(t, msg)
+	assert.False(t, accepted)
+	third := base.NewFloat64Message(3)
+	msg, accepted = l.limit(third, start.Add(100*time.Millisecond))
+	assert.Equal(t, third, msg)
+	assert.True(t, accepted)
+	assert.Nil(t, l.limited(start.Add(time.Second)))
+	_, ok := l.deadline()
+	assert.False(t, ok)
+}
+
+func TestLimiterLatestAndAverage(t *testing.T) {
+	start := time.Now()
+	latest := newTestLimiter(base.Float64TypeName, config.RateLimit{Interval: "100ms", Policy: config.RateLatest}, config.Batch{})
+	average := newTestLimiter(base.Int64TypeName, config.RateLimit{Interval: "100ms", Policy: config.RateAverage}, config.Batch{})
+	for i, value := range []float64{1, 2, 4} {
+		at := start.Add(time.Duration(i*10) * time.Millisecond)
+		msg, accepted := latest.limit(base.NewFloat64Message(value), at)
+		assert.Nil(t, msg)
+		assert.True(t, accepted)
+		msg, accepted = average.limit(base.NewInt64Message(int64(value)), at)
+		assert.Nil(t, msg)
+		assert.True(t, accepted)
+	}
+
+	deadline, ok := latest.deadline()
+	assert.True(t, ok)
+	assert.Equal(t, start.Add(100*time.Millisecond), deadline)
+	assert.Nil(t, latest.limited(start.Add(99*time.Millisecond)))
+
+	assert.Equal(t, 4.0, latest.limited(deadline).(*base.Float64).Body.Data)
+	assert.Equal(t, int64(2), average.limited(deadline).(*base.Int64).Body.Data)
+	assert.Nil(t, latest.limited(deadline.Add(time.Second)))
+}
+
+func TestLimiterBatch(t *testing.T) {
+	start := time.Now()
+	l := newTestLimiter(base.StringTypeName, config.RateLimit{}, config.Batch{Size: 3, Interval: "1s"})
+
+	assert.Nil(t, l.collect(base.NewStringMessage("a"), start))
+	assert.Nil(t, l.collect(base.NewStringMessage("b"), start))
+	batch := l.collect(base.NewStringMessage("c"), start)
+	assert.Equal(t, []string{"a", "b", "c"}, batch.(*base.StringArray).Body.Data)
+
+	assert.Nil(t, l.collect(base.NewStringMessage("d"), start.Add(100*time.Millisecond)))
+	deadline, ok := l.deadline()
+	assert.True(t, ok)
+	assert.Equal(t, start.Add(1100*time.Millisecond), deadline)
+	assert.Nil(t, l.collected(start.Add(time.Second)))
+	assert.Equal(t, []string{"d"}, l.collected(deadline).(*base.StringArray).Body.Data)
+	assert.Nil(t, l.collected(deadline.Add(time.Second)))
+
+	unbatched := newTestLimiter(base.StringTypeName, config.RateLimit{}, config.Batch{})
+	msg := base.NewStringMessage("e")
+	assert.Equal(t, msg, unbatched.collect(msg, start))
+}
+
+func TestLimiterBatchTypes(t *testing.T) {
+	now := time.Now()
+	batched := func(portType string, messages ...msgs.Message) msgs.Message {
+		l := newTestLimiter(portType, config.RateLimit{}, config.Batch{Size: len(messages)})
+		var batch msgs.Message
+		for _, msg := range messages {
+			batch = l.collect(msg, now)
+		}
+		assert.Equal(t, config.BatchTypes[portType], batch.GetType())
+		return batch
+	}
+	assert.Equal(t, []float64{1.5, 2.5}, batched(base.Float64TypeName, base.NewFloat64Message(1.5), base.NewFloat64Message(2.5)).(*base.Float64Array).Body.Data)
+	assert.Equal(t, []int64{1, 2}, batched(base.Int64TypeName, base.NewInt64Message(1), base.NewInt64Message(2)).(*base.Int64Array).Body.Data)
+	assert.Equal(t, []bool{true, false}, batched(base.BoolTypeName, base.NewBoolMessage(true), base.NewBoolMessage(false)).(*base.BoolArray).Body.Data)
+}
+
+func TestLimiterDrain(t *testing.T) {
+	start := time.Now()
+	l := newTestLimiter(base.Float64TypeName, config.RateLimit{Interval: "1s", Policy: config.RateLatest}, config.Batch{Size: 10})
+	l.collect(base.NewFloat64Message(1), start)
+	l.limit(base.NewFloat64Message(2), start)
+	l.limit(base.NewFloat64Message(3), start)
+
+	drained := l.drain(start)
+	if assert.Len(t, drained, 1) {
+		assert.Equal(t, []float64{1, 3}, drained[0].(*base.Float64Array).Body.Data)
+	}
+	assert.Empty(t, l.drain(start))
+	_, ok := l.deadline()
+	assert.False(t, ok)
+}
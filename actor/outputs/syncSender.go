@@ -8,14 +8,18 @@ import (
 	"github.com/tombenke/axon-go-common/msgs"
 	"github.com/tombenke/axon-go-common/msgs/orchestra"
 	"sync"
+	"time"
 )
 
 // SyncSender receives outputs from the processor function via the `outputsCh` that it sends to
 // the corresponding topics identified by the port.
 // The outputs structures hold every details about the ports, the message itself, and the subject to send.
+// The messages held back by the rate limits and batches of the ports are published when the orchestrator triggers the sending
+// after they are due, so the ones still held back when the sender shuts down are lost.
 // This function runs as a standalone process, so it should be started as a go function.
 func SyncSender(actorName string, outputsCh chan io.Outputs, doneCh chan interface{}, wg *sync.WaitGroup, m messenger.Messenger, logger *logrus.Logger) (chan interface{}, chan interface{}) {
 	var outputs io.Outputs
+	emitter := newEmitter()
 	senderStoppedCh := make(chan interface{})
 	startedCh := make(chan interface{})
 
@@ -49,7 +53,7 @@ func SyncSender(actorName string, outputsCh chan io.Outputs, doneCh chan interfa
 
 			case <-sendResultsCh:
 				logger.Debugf("Sender received orchestrator trigger to send outputs")
-				syncSendOutputs(actorName, outputs, m)
+				syncSendOutputs(actorName, outputs, emitter, m)
 			}
 		}
 	}()
@@ -67,17 +71,8 @@ func sendProcessingCompleted(actorName string, m messenger.Messenger) {
 	}
 }
 
-func syncSendOutputs(actorName string, outputs io.Outputs, m messenger.Messenger) {
-	for o := range outputs {
-		channel := outputs[o].Channel
-		representation := outputs[o].Representation
-		message := outputs[o].Message
-		messageType := outputs[o].Type
-		logger.Debugf("Sender sends '%v' type message of '%s' output port to '%s' channel in '%s' format\n", messageType, o, channel, representation)
-		if err := m.Publish(channel, message.Encode(representation)); err != nil {
-			panic(err)
-		}
-	}
+func syncSendOutputs(actorName string, outputs io.Outputs, emitter *emitter, m messenger.Messenger) {
+	emitter.emit(outputs, time.Now(), m, logger)
 
 	logger.Debugf("Sender sends 'sending-completed' notification to orchestrator\n")
 	sendingCompletedMsg := orchestra.NewSendingCompletedMessage(actorName)
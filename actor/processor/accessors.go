@@ -0,0 +1,157 @@
+package processor
+
+import (
+	"errors"
+	"fmt"
+	"github.com/tombenke/axon-go-common/io"
+	"github.com/tombenke/axon-go-common/msgs"
+	"github.com/tombenke/axon-go-common/msgs/base"
+	"reflect"
+)
+
+var (
+	// ErrUnknownPort is returned by the accessors, if the port does not exist
+	ErrUnknownPort = errors.New("unknown port")
+
+	// ErrTypeMismatch is returned by the accessors, if the message-type of the port differs from the requested one
+	ErrTypeMismatch = errors.New("message-type mismatch")
+
+	// ErrNoMessage is returned by the accessors, if the port holds no message
+	ErrNoMessage = errors.New("no message")
+)
+
+// TypeNameOf returns with the printable name of the `T` message-type, e.g. `base/Float64` for `*base.Float64`.
+// It returns with an empty string if `T` is an interface type, e.g. `msgs.Message`, that matches any message-type.
+func TypeNameOf[T msgs.Message]() string {
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+	switch typ.Kind() {
+	case reflect.Interface:
+		return ""
+	case reflect.Ptr:
+		return reflect.New(typ.Elem()).Interface().(msgs.Message).GetType()
+	default:
+		return reflect.Zero(typ).Interface().(msgs.Message).GetType()
+	}
+}
+
+// Input returns with the latest message of the `name` input port as a `T` message,
+// e.g. `processor.Input[*base.Float64](ctx, "max-power")`.
+// It returns error instead of panicking, if the port does not exist, it holds no message, or its message is not a `T` message.
+func Input[T msgs.Message](ctx Context, name string) (T, error) {
+	var typed T
+	msg, ok := ctx.Inputs.LookupMessage(name)
+	if !ok {
+		return typed, fmt.Errorf("%w: there is no '%s' input port", ErrUnknownPort, name)
+	}
+	if msg == nil {
+		return typed, fmt.Errorf("%w: the '%s' input port holds no message", ErrNoMessage, name)
+	}
+	typed, ok = msg.(T)
+	if !ok {
+		return typed, fmt.Errorf("%w: the '%s' input port has '%s' message-type instead of '%s'", ErrTypeMismatch, name, msg.GetType(), TypeNameOf[T]())
+	}
+	return typed, nil
+}
+
+// Inputs returns with the buffered messages of the `name` input port as `T` messages, oldest first,
+// e.g. `processor.Inputs[*base.Float64](ctx, "power-need")`.
+// It returns error instead of panicking, if the port does not exist, or its messages are not `T` messages.
+func Inputs[T msgs.Message](ctx Context, name string) ([]T, error) {
+	messages, ok := ctx.Inputs.LookupMessages(name)
+	if !ok {
+		return nil, fmt.Errorf("%w: there is no '%s' input port", ErrUnknownPort, name)
+	}
+	typed := make([]T, len(messages))
+	for i, msg := range messages {
+		if msg == nil {
+			return nil, fmt.Errorf("%w: the '%s' input port holds no message", ErrNoMessage, name)
+		}
+		if typed[i], ok = msg.(T); !ok {
+			return nil, fmt.Errorf("%w: the '%s' input port has '%s' message-type instead of '%s'", ErrTypeMismatch, name, msg.GetType(), TypeNameOf[T]())
+		}
+	}
+	return typed, nil
+}
+
+// InputMetadata returns with the freshness metadata of the latest message of the `name` input port.
+// It returns error instead of panicking, if the port does not exist.
+func InputMetadata(ctx Context, name string) (io.Metadata, error) {
+	m, ok := ctx.Inputs.LookupMetadata(name)
+	if !ok {
+		return m, fmt.Errorf("%w: there is no '%s' input port", ErrUnknownPort, name)
+	}
+	return m, nil
+}
+
+// SetOutput sets the `msg` message to be emitted via the `name` output port.
+// It returns error instead of panicking, if the port does not exist, or the message-type of the port differs.
+func SetOutput(ctx Context, name string, msg msgs.Message) error {
+	portType, ok := ctx.Outputs.LookupType(name)
+	if !ok {
+		return fmt.Errorf("%w: there is no '%s' output port", ErrUnknownPort, name)
+	}
+	if msg == nil {
+		return fmt.Errorf("%w: can not set nil message to the '%s' output port", ErrNoMessage, name)
+	}
+	if portType != msg.GetType() {
+		return fmt.Errorf("%w: the '%s' output port has '%s' message-type instead of '%s'", ErrTypeMismatch, name, portType, msg.GetType())
+	}
+	ctx.SetOutputMessage(name, msg)
+	return nil
+}
+
+// Float64 returns with the value of the `base/Float64` message of the `name` input port
+func Float64(ctx Context, name string) (float64, error) {
+	msg, err := Input[*base.Float64](ctx, name)
+	if err != nil {
+		return 0, err
+	}
+	return msg.Body.Data, nil
+}
+
+// Int64 returns with the value of the `base/Int64` message of the `name` input port
+func Int64(ctx Context, name string) (int64, error) {
+	msg, err := Input[*base.Int64](ctx, name)
+	if err != nil {
+		return 0, err
+	}
+	return msg.Body.Data, nil
+}
+
+// Bool returns with the value of the `base/Bool` message of the `name` input port
+func Bool(ctx Context, name string) (bool, error) {
+	msg, err := Input[*base.Bool](ctx, name)
+	if err != nil {
+		return false, err
+	}
+	return msg.Body.Data, nil
+}
+
+// String returns with the value of the `base/String` message of the `name` input port
+func String(ctx Context, name string) (string, error) {
+	msg, err := Input[*base.String](ctx, name)
+	if err != nil {
+		return "", err
+	}
+	return msg.Body.Data, nil
+}
+
+// SetFloat64 sets a new `base/Float64` message with the `value` to be emitted via the `name` output port
+func SetFloat64(ctx Context, name string, value float64) error {
+	return SetOutput(ctx, name, base.NewFloat64Message(value))
+}
+
+// SetInt64 sets a new `base/Int64` message with the `value` to be emitted via the `name` output port
+func SetInt64(ctx Context, name string, value int64) error {
+	return SetOutput(ctx, name, base.NewInt64Message(value))
+}
+
+// SetBool sets a new `base/Bool` message with the `value` to be emitted via the `name` output port
+func SetBool(ctx Context, name string, value bool) error {
+	return SetOutput(ctx, name, base.NewBoolMessage(value))
+}
+
+// SetString sets a new `base/String` message with the `value` to be emitted via the `name` output port
+func SetString(ctx Context, name string, value string) error {
+	return SetOutput(ctx, name, base.NewStringMessage(value))
+}
@@ -0,0 +1,100 @@
+package processor
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/tombenke/axon-go-common/msgs"
+	"github.com/tombenke/axon-go-common/msgs/base"
+	"testing"
+)
+
+func TestTypeNameOf(t *testing.T) {
+	assert.Equal(t, base.Float64TypeName, TypeNameOf[*base.Float64]())
+	assert.Equal(t, base.StringTypeName, TypeNameOf[*base.String]())
+	assert.Equal(t, "", TypeNameOf[msgs.Message]())
+}
+
+func TestInput(t *testing.T) {
+	ctx := SetupContext(testCase, inputsCfg, outputsCfg)
+
+	msg, err := Input[*base.Float64](ctx, "max-power")
+	assert.Nil(t, err)
+	assert.Equal(t, 2000.0, msg.Body.Data)
+
+	any, err := Input[msgs.Message](ctx, "power-need")
+	assert.Nil(t, err)
+	assert.Equal(t, base.Float64TypeName, any.GetType())
+
+	_, err = Input[*base.Int64](ctx, "max-power")
+	assert.True(t, errors.Is(err, ErrTypeMismatch))
+	assert.EqualError(t, err, "message-type mismatch: the 'max-power' input port has 'base/Float64' message-type instead of 'base/Int64'")
+
+	_, err = Input[*base.Float64](ctx, "min-power")
+	assert.True(t, errors.Is(err, ErrUnknownPort))
+	assert.EqualError(t, err, "unknown port: there is no 'min-power' input port")
+}
+
+func TestAccessorsWithoutMessage(t *testing.T) {
+	ctx := SetupContext(testCase, inputsCfg, outputsCfg)
+	input := ctx.Inputs.Map["max-power"]
+	input.Message = nil
+	ctx.Inputs.Map["max-power"] = input
+
+	_, err := Input[*base.Int64](ctx, "max-power")
+	assert.True(t, errors.Is(err, ErrNoMessage))
+	assert.EqualError(t, err, "no message: the 'max-power' input port holds no message")
+	_, err = Input[msgs.Message](ctx, "max-power")
+	assert.True(t, errors.Is(err, ErrNoMessage))
+	_, err = Inputs[*base.Float64](ctx, "max-power")
+	assert.True(t, errors.Is(err, ErrNoMessage))
+
+	assert.True(t, errors.Is(SetOutput(ctx, "power-output", nil), ErrNoMessage))
+}
+
+func TestScalarAccessors(t *testing.T) {
+	ctx := SetupContext(testCase, inputsCfg, outputsCfg)
+
+	value, err := Float64(ctx, "power-need")
+	assert.Nil(t, err)
+	assert.Equal(t, 4599.0, value)
+
+	_, err = Int64(ctx, "power-need")
+	assert.True(t, errors.Is(err, ErrTypeMismatch))
+	_, err = Bool(ctx, "power-need")
+	assert.True(t, errors.Is(err, ErrTypeMismatch))
+	_, err = String(ctx, "unknown")
+	assert.True(t, errors.Is(err, ErrUnknownPort))
+}
+
+func TestSetOutput(t *testing.T) {
+	ctx := SetupContext(testCase, inputsCfg, outputsCfg)
+
+	assert.Nil(t, SetFloat64(ctx, "power-output", 1234.5))
+	assert.Equal(t, 1234.5, ctx.Outputs.GetMessage("power-output").(*base.Float64).Body.Data)
+
+	err := SetInt64(ctx, "power-output", 1)
+	assert.True(t, errors.Is(err, ErrTypeMismatch))
+	assert.EqualError(t, err, "message-type mismatch: the 'power-output' output port has 'base/Float64' message-type instead of 'base/Int64'")
+	assert.Equal(t, 1234.5, ctx.Outputs.GetMessage("power-output").(*base.Float64).Body.Data)
+
+	assert.True(t, errors.Is(SetBool(ctx, "power-output", true), ErrTypeMismatch))
+	err = SetString(ctx, "unknown", "x")
+	assert.True(t, errors.Is(err, ErrUnknownPort))
+	assert.EqualError(t, err, "unknown port: there is no 'unknown' output port")
+}
+
+func TestInputs(t *testing.T) {
+	ctx := SetupContext(testCase, inputsCfg, outputsCfg)
+
+	messages, err := Inputs[*base.Float64](ctx, "power-need")
+	assert.Nil(t, err)
+	if assert.Len(t, messages, 1) {
+		assert.Equal(t, 4599.0, messages[0].Body.Data)
+	}
+	assert.Equal(t, []msgs.Message{messages[0]}, ctx.GetInputMessages("power-need"))
+
+	_, err = Inputs[*base.Int64](ctx, "power-need")
+	assert.True(t, errors.Is(err, ErrTypeMismatch))
+	_, err = Inputs[*base.Float64](ctx, "unknown")
+	assert.True(t, errors.Is(err, ErrUnknownPort))
+}
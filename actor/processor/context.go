@@ -20,6 +20,19 @@ func (ctx Context) GetInputMessage(name string) msgs.Message {
 	return ctx.Inputs.GetMessage(name)
 }
 
+// GetInputMessages returns the messages buffered by the input port selected by its `name`, oldest first.
+// The ports with `queue` or `window` buffering policy return with all their buffered messages,
+// the other ports return with their latest or aggregated message only.
+func (ctx Context) GetInputMessages(name string) []msgs.Message {
+	return ctx.Inputs.GetMessages(name)
+}
+
+// GetInputMetadata returns the freshness metadata of the latest message of the input port selected by its `name`:
+// when and from where it was received, how many messages the port has received, and if it is the default message or stale.
+func (ctx Context) GetInputMetadata(name string) io.Metadata {
+	return ctx.Inputs.GetMetadata(name)
+}
+
 // SetOutputMessage sets the `outMsg` message to be emitted via the output port selected by its `name`.
 func (ctx Context) SetOutputMessage(name string, outMsg msgs.Message) {
 	ctx.Outputs.SetMessage(name, outMsg)
@@ -0,0 +1,55 @@
+package processor
+
+import (
+	"errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/tombenke/axon-go-common/config"
+	"github.com/tombenke/axon-go-common/io"
+	"github.com/tombenke/axon-go-common/msgs/base"
+	"testing"
+	"time"
+)
+
+func TestInputMetadata(t *testing.T) {
+	ctx := SetupContext(testCase, inputsCfg, outputsCfg)
+	at := time.Now()
+	ctx.Inputs.Receive("power-need", base.NewFloat64Message(10), at)
+
+	m, err := InputMetadata(ctx, "power-need")
+	assert.Nil(t, err)
+	assert.Equal(t, io.Metadata{ReceivedAt: at, Source: "well-pump-relay.electric-power-need", ReceiveCount: 1}, m)
+	assert.Equal(t, m, ctx.GetInputMetadata("power-need"))
+
+	_, err = InputMetadata(ctx, "unknown")
+	assert.True(t, errors.Is(err, ErrUnknownPort))
+}
+
+func TestProcessInputsSuppressedUntilFresh(t *testing.T) {
+	requiredCfg := append(config.Inputs{}, inputsCfg...)
+	requiredCfg[1].Required = true
+	requiredCfg[1].MaxAge = "10s"
+	inputs, outputs := SetupPorts(requiredCfg, outputsCfg)
+	outputsCh := make(chan io.Outputs, 1)
+	calls := 0
+	procFun := func(ctx Context) error {
+		calls++
+		return ProcessorFun(ctx)
+	}
+
+	processInputs(inputs, outputs, procFun, outputsCh, logrus.New())
+	assert.Equal(t, 0, calls)
+	assert.Equal(t, io.Outputs{}, <-outputsCh)
+
+	at := time.Now()
+	inputs.Receive("power-need", base.NewFloat64Message(1500), at)
+	inputs.Deliver(at)
+	processInputs(inputs, outputs, procFun, outputsCh, logrus.New())
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, 1500.0, (<-outputsCh).GetMessage("power-output").(*base.Float64).Body.Data)
+
+	inputs.Deliver(at.Add(time.Minute))
+	processInputs(inputs, outputs, procFun, outputsCh, logrus.New())
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, io.Outputs{}, <-outputsCh)
+}
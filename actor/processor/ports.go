@@ -0,0 +1,65 @@
+package processor
+
+import (
+	"fmt"
+	"github.com/tombenke/axon-go-common/config"
+	"github.com/tombenke/axon-go-common/msgs"
+)
+
+// Port declares a port that the processor function uses, together with the message-type it expects on the port
+type Port struct {
+	// Name is the name of the port
+	Name string
+
+	// Type is the printable name of the expected message-type. Empty string means any message-type.
+	Type string
+
+	// Output is true for output ports, and false for input ports
+	Output bool
+}
+
+// InputPort declares that the processor function reads `T` messages from the `name` input port,
+// e.g. `processor.InputPort[*base.Float64]("max-power")`
+func InputPort[T msgs.Message](name string) Port {
+	return Port{Name: name, Type: TypeNameOf[T]()}
+}
+
+// OutputPort declares that the processor function emits `T` messages via the `name` output port
+func OutputPort[T msgs.Message](name string) Port {
+	return Port{Name: name, Type: TypeNameOf[T](), Output: true}
+}
+
+// ValidatePorts cross-checks the `used` ports declared by the processor function with the configured `ports`.
+// It collects all the ports that are not configured, or configured with a different message-type.
+// It returns `nil` if the ports match, otherwise a `config.ValidationErrors` with the list of the problems.
+func ValidatePorts(ports config.Ports, used ...Port) error {
+	inputTypes := map[string]string{}
+	for _, in := range ports.Inputs {
+		inputTypes[in.Name] = in.Type
+	}
+	outputTypes := map[string]string{}
+	for _, out := range ports.Outputs {
+		outputTypes[out.Name] = out.Type
+	}
+
+	errs := config.ValidationErrors{}
+	for _, port := range used {
+		kind, types := "input", inputTypes
+		if port.Output {
+			kind, types = "output", outputTypes
+		}
+
+		configured, ok := types[port.Name]
+		switch {
+		case !ok:
+			errs = append(errs, fmt.Errorf("the '%s' %s port used by the processor is not configured", port.Name, kind))
+		case port.Type != "" && configured != port.Type:
+			errs = append(errs, fmt.Errorf("the '%s' %s port is configured with '%s' message-type, but the processor uses '%s'", port.Name, kind, configured, port.Type))
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package processor
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/tombenke/axon-go-common/config"
+	"github.com/tombenke/axon-go-common/msgs"
+	"github.com/tombenke/axon-go-common/msgs/base"
+	"testing"
+)
+
+func TestPortDeclarations(t *testing.T) {
+	assert.Equal(t, Port{Name: "max-power", Type: base.Float64TypeName}, InputPort[*base.Float64]("max-power"))
+	assert.Equal(t, Port{Name: "power-output", Type: base.Float64TypeName, Output: true}, OutputPort[*base.Float64]("power-output"))
+	assert.Equal(t, Port{Name: "any"}, InputPort[msgs.Message]("any"))
+}
+
+func TestValidatePorts(t *testing.T) {
+	ports := config.Ports{Inputs: inputsCfg, Outputs: outputsCfg}
+
+	assert.Nil(t, ValidatePorts(ports))
+	assert.Nil(t, ValidatePorts(ports,
+		InputPort[*base.Float64]("max-power"),
+		InputPort[msgs.Message]("power-need"),
+		OutputPort[*base.Float64]("power-output"),
+	))
+
+	err := ValidatePorts(ports,
+		InputPort[*base.Int64]("max-power"),
+		InputPort[*base.Float64]("power-output"),
+		OutputPort[*base.Float64]("power-output"),
+		OutputPort[*base.Bool]("alarm"),
+	)
+	if assert.IsType(t, config.ValidationErrors{}, err) {
+		assert.Equal(t, "invalid configuration:\n"+
+			"  the 'max-power' input port is configured with 'base/Float64' message-type, but the processor uses 'base/Int64'\n"+
+			"  the 'power-output' input port used by the processor is not configured\n"+
+			"  the 'alarm' output port used by the processor is not configured", err.Error())
+	}
+}
@@ -1,10 +1,21 @@
 // Package processor provides the implementation of the `Processor` process, and its helper functions.
+//
+// The processor functions can access to the ports through the type-checked accessors,
+// that return error instead of panicking on unknown port names and on message-type mismatches:
+//
+//	maxPower, err := processor.Float64(ctx, "max-power")
+//	msg, err := processor.Input[*base.Float64](ctx, "power-need")
+//	err = processor.SetFloat64(ctx, "power-output", powerOutput)
+//
+// The ports used by the processor function can be declared to the node with `InputPort` and `OutputPort`,
+// so their message-types are cross-checked with the configured ports at startup.
 package processor
 
 import (
 	"github.com/sirupsen/logrus"
 	"github.com/tombenke/axon-go-common/config"
 	"github.com/tombenke/axon-go-common/io"
+	"strings"
 	"sync"
 )
 
@@ -13,7 +24,8 @@ import (
 // Processor is the implementation of the core process that executes the so called `procFun` function with a context.
 // The context provides an interface to the `procFun` to access to the messages of the input ports,
 // as well as to access to the output ports that will emit the results of the computation.
-func StartProcessor(procFun func(Context) error, outputsCfg config.Outputs, doneCh chan interface{}, appWg *sync.WaitGroup, inputsCh chan *io.Inputs, logger *logrus.Logger) (chan interface{}, chan io.Outputs, chan interface{}) {
+// The output ports can be changed or added during operation via the `configCh` channel.
+func StartProcessor(procFun func(Context) error, outputsCfg config.Outputs, configCh chan config.Outputs, doneCh chan interface{}, appWg *sync.WaitGroup, inputsCh chan *io.Inputs, logger *logrus.Logger) (chan interface{}, chan io.Outputs, chan interface{}) {
 	outputsCh := make(chan io.Outputs)
 	procStoppedCh := make(chan interface{})
 	startedCh := make(chan interface{})
@@ -36,9 +48,13 @@ func StartProcessor(procFun func(Context) error, outputsCfg config.Outputs, done
 				logger.Debugf("Processor shuts down.")
 				return
 
+			case outputsCfg := <-configCh:
+				logger.Debugf("Processor got ports configuration")
+				outputs = outputs.ConfigurePorts(outputsCfg)
+
 			case inputs := <-inputsCh:
 				logger.Debugf("Processor got inputs")
-				processInputs(inputs, outputs, procFun, outputsCh, logger)
+				outputs = processInputs(inputs, outputs, procFun, outputsCh, logger)
 			}
 		}
 	}()
@@ -46,8 +62,19 @@ func StartProcessor(procFun func(Context) error, outputsCfg config.Outputs, done
 	return startedCh, outputsCh, procStoppedCh
 }
 
-func processInputs(inputs *io.Inputs, outputs io.Outputs, procFun func(Context) error, outputsCh chan io.Outputs, logger *logrus.Logger) {
-	context := NewContext(logger, inputs, outputs)
+// processInputs calls the `procFun` processor function with the `inputs`, then forwards the outputs via the `outputsCh`.
+// The processor function gets a renewed copy of the `outputs`, so the sender can tell which ports were set in this invocation.
+// It returns with the outputs holding the last messages of the ports.
+// The processing is suppressed while any of the required input ports is not fresh. In this case empty outputs are forwarded,
+// so the sender completes its cycle without emitting any message.
+func processInputs(inputs *io.Inputs, outputs io.Outputs, procFun func(Context) error, outputsCh chan io.Outputs, logger *logrus.Logger) io.Outputs {
+	if notFresh := inputs.NotFresh(); len(notFresh) > 0 {
+		logger.Debugf("Processor suppresses processing, the required '%s' input ports are not fresh", strings.Join(notFresh, "', '"))
+		outputsCh <- io.Outputs{}
+		return outputs
+	}
+
+	context := NewContext(logger, inputs, outputs.Renew())
 
 	logger.Debugf("Processor calls processor-function")
 	err := procFun(context)
@@ -57,4 +84,5 @@ func processInputs(inputs *io.Inputs, outputs io.Outputs, procFun func(Context)
 
 	logger.Debugf("Processor sends the results")
 	outputsCh <- context.Outputs
+	return context.Outputs
 }
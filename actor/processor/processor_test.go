@@ -1,8 +1,8 @@
 package processor
 
 import (
-	//"github.com/stretchr/testify/assert"
 	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
 	"github.com/tombenke/axon-go-common/config"
 	"github.com/tombenke/axon-go-common/io"
 	"github.com/tombenke/axon-go-common/msgs/base"
@@ -75,7 +75,7 @@ func TestStartProcessor(t *testing.T) {
 	inputsCh, mockRcvStoppedCh := StartMockReceiver(triggerCh, reportCh, doneRcvCh, &wg, logger)
 
 	doneProcCh := make(chan interface{})
-	startedCh, outputsCh, procStoppedCh := StartProcessor(ProcessorFun, outputsCfg, doneProcCh, &wg, inputsCh, logger)
+	startedCh, outputsCh, procStoppedCh := StartProcessor(ProcessorFun, outputsCfg, nil, doneProcCh, &wg, inputsCh, logger)
 	<-startedCh
 
 	doneSndCh := make(chan interface{})
@@ -191,3 +191,25 @@ func StartMockSender(t *testing.T, outputsCh chan io.Outputs, reportCh chan stri
 
 	return mockSndStoppedCh
 }
+
+func TestProcessInputsRenewsOutputs(t *testing.T) {
+	inputs, outputs := SetupPorts(inputsCfg, outputsCfg)
+	outputsCh := make(chan io.Outputs, 1)
+	setOutput := true
+	procFun := func(ctx Context) error {
+		if setOutput {
+			return ProcessorFun(ctx)
+		}
+		return nil
+	}
+
+	outputs = processInputs(inputs, outputs, procFun, outputsCh, logrus.New())
+	sent := <-outputsCh
+	assert.True(t, sent["power-output"].Updated)
+
+	setOutput = false
+	outputs = processInputs(inputs, outputs, procFun, outputsCh, logrus.New())
+	assert.False(t, (<-outputsCh)["power-output"].Updated)
+	assert.Equal(t, sent["power-output"].Message, outputs["power-output"].Message)
+	assert.True(t, sent["power-output"].Updated)
+}
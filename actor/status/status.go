@@ -12,8 +12,9 @@ import (
 
 // Status receives status request messages from the orchestrator application,
 // sends responses to these requests, forwarding the actual status of the actor.
+// The status report always reflects the latest node configuration received via the `configCh` channel.
 // This function runs as a standalone process, so it should be started as a go function.
-func Status(nodeConfig config.Node, doneCh chan interface{}, wg *sync.WaitGroup, m messenger.Messenger, logger *logrus.Logger) (chan interface{}, chan interface{}) {
+func Status(nodeConfig config.Node, configCh chan config.Node, doneCh chan interface{}, wg *sync.WaitGroup, m messenger.Messenger, logger *logrus.Logger) (chan interface{}, chan interface{}) {
 	statusRequestCh := make(chan []byte)
 	statusRequestSubs := m.ChanSubscribe(nodeConfig.Orchestration.Channels.StatusRequest, statusRequestCh)
 	statusStoppedCh := make(chan interface{})
@@ -39,10 +40,21 @@ func Status(nodeConfig config.Node, doneCh chan interface{}, wg *sync.WaitGroup,
 				logger.Debugf("Status shuts down.")
 				return
 
+			case newConfig := <-configCh:
+				logger.Debugf("Status received new node configuration")
+				if newConfig.Orchestration.Channels.StatusRequest != nodeConfig.Orchestration.Channels.StatusRequest {
+					logger.Debugf("Status subscribes to '%s' channel", newConfig.Orchestration.Channels.StatusRequest)
+					if err := statusRequestSubs.Unsubscribe(); err != nil {
+						panic(err)
+					}
+					statusRequestSubs = m.ChanSubscribe(newConfig.Orchestration.Channels.StatusRequest, statusRequestCh)
+				}
+				nodeConfig = newConfig
+
 			case <-statusRequestCh:
 				logger.Debugf("Status received status-request message")
 				logger.Debugf("Status sends status-report message")
-				statusReportMsg := makeStatusReportMsg(nodeConfig)
+				statusReportMsg := MakeStatusReportMsg(nodeConfig)
 				if err := m.Publish(nodeConfig.Orchestration.Channels.StatusReport, statusReportMsg.Encode(msgs.JSONRepresentation)); err != nil {
 					panic(err)
 				}
@@ -54,7 +66,9 @@ func Status(nodeConfig config.Node, doneCh chan interface{}, wg *sync.WaitGroup,
 	return statusStartedCh, statusStoppedCh
 }
 
-func makeStatusReportMsg(nodeConfig config.Node) msgs.Message {
+// MakeStatusReportMsg creates a new `status-report` message that describes the node
+// according to its `nodeConfig` configuration.
+func MakeStatusReportMsg(nodeConfig config.Node) msgs.Message {
 	srBody := orchestra.StatusReportBody{
 
 		Name:            nodeConfig.Name,
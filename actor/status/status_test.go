@@ -107,7 +107,7 @@ func TestStatus(t *testing.T) {
 
 	// Start the status process
 	doneStatusCh := make(chan interface{})
-	statusStartedCh, statusStoppedCh := Status(testNode, doneStatusCh, &wg, m, logger)
+	statusStartedCh, statusStoppedCh := Status(testNode, nil, doneStatusCh, &wg, m, logger)
 
 	// Wait until all components have been successfully started
 	<-statusStartedCh
@@ -0,0 +1,196 @@
+package main
+
+import (
+	"fmt"
+	"go/token"
+	"gopkg.in/yaml.v2"
+	"io/ioutil"
+	"path/filepath"
+	"text/template"
+)
+
+// Definition is the content of a message-type definition file
+type Definition struct {
+	// Package is the name of the Go package of the generated files
+	Package string `yaml:"package"`
+
+	// Imports holds the additional import paths the bodies and the arguments of the constructors refer to
+	Imports []string `yaml:"imports"`
+
+	// Messages holds the definitions of the message-types
+	Messages []MessageDefinition `yaml:"messages"`
+
+	// BodyMethods is the name of a Go template file, relative to the definition file, that generates additional methods
+	// of the generated bodies. It is executed with the data of every message-type that has body fields.
+	BodyMethods string `yaml:"bodyMethods"`
+
+	// bodyMethods is the parsed `BodyMethods` template
+	bodyMethods *template.Template
+}
+
+// MessageDefinition describes one message-type
+type MessageDefinition struct {
+	// Name is the name of the Go type of the message, e.g. `Pressure`
+	Name string `yaml:"name"`
+
+	// TypeName is the printable name of the message-type. It defaults to `<package>/<name>`.
+	TypeName string `yaml:"typeName"`
+
+	// Description is the doc comment of the Go type, that follows its name, as well as the description of the message-type in the registry
+	Description string `yaml:"description"`
+
+	// Body is the Go type of an existing body, e.g. `common.Float64VarBody`.
+	// It must implement the protobuf, and the optionally selected CSV and text methods.
+	Body string `yaml:"body"`
+
+	// BodyFields are the fields of the `<name>Body` type generated for the message, if `Body` is not defined.
+	// The generated body implements all the representations.
+	BodyFields []FieldDefinition `yaml:"bodyFields"`
+
+	// Args are the arguments of the constructors, that are assigned to the fields of the body.
+	// If there are no arguments, then the constructors get the whole body.
+	Args []ArgDefinition `yaml:"args"`
+
+	// Representations are the optional representations of the message-type: `csv` and `text`.
+	// The representations required by the `msgs.Message` interface are always generated.
+	Representations []string `yaml:"representations"`
+
+	// TestArgs are the Go expressions of the arguments of the constructor, that makes the message of the tests
+	TestArgs []string `yaml:"testArgs"`
+}
+
+// FieldDefinition describes a field of a generated body
+type FieldDefinition struct {
+	// Name is the name of the field, e.g. `Data`
+	Name string `yaml:"name"`
+
+	// Type is the Go type of the field: `float64`, `int64`, `bool` or `string`
+	Type string `yaml:"type"`
+
+	// Description is the doc comment of the field
+	Description string `yaml:"description"`
+}
+
+// ArgDefinition describes an argument of the constructors
+type ArgDefinition struct {
+	// Name is the name of the argument, e.g. `data`
+	Name string `yaml:"name"`
+
+	// Type is the Go type of the argument
+	Type string `yaml:"type"`
+
+	// Field is the name of the body field the argument is assigned to
+	Field string `yaml:"field"`
+}
+
+// The representations of the messages, in the order they appear in the generated code
+var (
+	requiredRepresentations = []string{"json", "yaml", "gob", "protobuf", "xml", "avro", "msgpack", "cbor"}
+	optionalRepresentations = []string{"csv", "text"}
+)
+
+// fieldTypes are the supported field types of the generated bodies
+var fieldTypes = map[string]bool{"float64": true, "int64": true, "bool": true, "string": true}
+
+// ReadDefinition reads and validates the definition from the `fileName` YAML file
+func ReadDefinition(fileName string) (Definition, error) {
+	var def Definition
+	content, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return def, err
+	}
+	if err := yaml.UnmarshalStrict(content, &def); err != nil {
+		return def, fmt.Errorf("%s: %w", fileName, err)
+	}
+	if err := def.Validate(); err != nil {
+		return def, fmt.Errorf("%s: %w", fileName, err)
+	}
+	if def.BodyMethods != "" {
+		tmplFileName := filepath.Join(filepath.Dir(fileName), def.BodyMethods)
+		content, err := ioutil.ReadFile(tmplFileName)
+		if err != nil {
+			return def, err
+		}
+		def.bodyMethods, err = template.New(def.BodyMethods).Funcs(templateFuncs).Parse(string(content))
+		if err != nil {
+			return def, fmt.Errorf("%s: %w", tmplFileName, err)
+		}
+	}
+	return def, nil
+}
+
+// Validate checks the definition, and fills the default values
+func (def *Definition) Validate() error {
+	if !token.IsIdentifier(def.Package) {
+		return fmt.Errorf("invalid package name '%s'", def.Package)
+	}
+	if len(def.Messages) == 0 {
+		return fmt.Errorf("no messages are defined")
+	}
+
+	for i := range def.Messages {
+		msg := &def.Messages[i]
+		if err := msg.validate(def.Package); err != nil {
+			return fmt.Errorf("message '%s': %w", msg.Name, err)
+		}
+	}
+	return nil
+}
+
+// validate checks the definition of the message, and fills the default values
+func (msg *MessageDefinition) validate(pkg string) error {
+	if !token.IsIdentifier(msg.Name) || !token.IsExported(msg.Name) {
+		return fmt.Errorf("invalid name")
+	}
+	if msg.TypeName == "" {
+		msg.TypeName = pkg + "/" + msg.Name
+	}
+
+	switch {
+	case msg.Body != "" && len(msg.BodyFields) > 0:
+		return fmt.Errorf("either the body or the body fields have to be defined, not both")
+	case msg.Body == "" && len(msg.BodyFields) == 0:
+		return fmt.Errorf("neither the body nor the body fields are defined")
+	}
+
+	fields := map[string]bool{}
+	for _, field := range msg.BodyFields {
+		if !token.IsIdentifier(field.Name) || !token.IsExported(field.Name) {
+			return fmt.Errorf("invalid body field name '%s'", field.Name)
+		}
+		if !fieldTypes[field.Type] {
+			return fmt.Errorf("unsupported type '%s' of the '%s' body field, it must be float64, int64, bool or string", field.Type, field.Name)
+		}
+		fields[field.Name] = true
+	}
+
+	for _, arg := range msg.Args {
+		if !token.IsIdentifier(arg.Name) || arg.Type == "" || arg.Field == "" {
+			return fmt.Errorf("the name, the type and the field of the '%s' argument have to be defined", arg.Name)
+		}
+		if len(msg.BodyFields) > 0 && !fields[arg.Field] {
+			return fmt.Errorf("the '%s' argument refers to the unknown '%s' body field", arg.Name, arg.Field)
+		}
+	}
+
+	for _, r := range msg.Representations {
+		if !contains(optionalRepresentations, r) && !contains(requiredRepresentations, r) {
+			return fmt.Errorf("unknown representation '%s'", r)
+		}
+	}
+
+	if len(msg.TestArgs) == 0 && len(msg.Args) > 0 {
+		return fmt.Errorf("the test arguments are not defined")
+	}
+	return nil
+}
+
+// contains returns true if `list` contains `item`
+func contains(list []string, item string) bool {
+	for _, i := range list {
+		if i == item {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,231 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+// messageData is the data of the templates, derived from the definition of a message-type
+type messageData struct {
+	MessageDefinition
+
+	// Package is the name of the Go package
+	Package string
+
+	// Source is the name of the definition file
+	Source string
+
+	// BodyType is the Go type of the body
+	BodyType string
+
+	// Has tells the representations of the message-type
+	Has map[string]bool
+
+	// Representations are the names of the `msgs.Representation` constants of the message-type
+	Representations []string
+
+	// CodecRepresentations are the names of the `msgs.Representation` constants that round-trip the whole message.
+	// The plain text representation holds no header, so it is not among them.
+	CodecRepresentations []string
+
+	// ParsedFields is true if the generated body has fields, that are parsed from text by `strconv`
+	ParsedFields bool
+
+	// Imports are the import paths of the message file
+	Imports []string
+
+	// TestImports are the import paths of the test file
+	TestImports []string
+}
+
+// Generate generates the files of the message-types defined by `def` into the `dir` directory.
+// It returns with the names of the generated files.
+func Generate(def Definition, source string, dir string) ([]string, error) {
+	var fileNames []string
+	for _, msg := range def.Messages {
+		data := newMessageData(def, msg, source)
+		baseName := filepath.Join(dir, lowerFirst(msg.Name))
+		messageTemplates := []*template.Template{messageTemplate}
+		if def.bodyMethods != nil && len(msg.BodyFields) > 0 {
+			messageTemplates = append(messageTemplates, def.bodyMethods)
+		}
+		for fileName, tmpls := range map[string][]*template.Template{baseName + ".go": messageTemplates, baseName + "_test.go": {testTemplate}} {
+			content, err := render(data, tmpls...)
+			if err != nil {
+				return nil, fmt.Errorf("message '%s': %w", msg.Name, err)
+			}
+			if err := ioutil.WriteFile(fileName, content, 0644); err != nil {
+				return nil, err
+			}
+			fileNames = append(fileNames, fileName)
+		}
+	}
+	sort.Strings(fileNames)
+	return fileNames, nil
+}
+
+// HasField returns true if the generated body has all the fields named by `names`
+func (data messageData) HasField(names ...string) bool {
+	for _, name := range names {
+		found := false
+		for _, field := range data.BodyFields {
+			found = found || field.Name == name
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// newMessageData returns with the template data of the `msg` message-type
+func newMessageData(def Definition, msg MessageDefinition, source string) messageData {
+	data := messageData{
+		MessageDefinition: msg,
+		Package:           def.Package,
+		Source:            source,
+		BodyType:          msg.Body,
+		Has:               map[string]bool{},
+	}
+	if data.BodyType == "" {
+		data.BodyType = msg.Name + "Body"
+		// The generated bodies implement all the representations
+		for _, r := range optionalRepresentations {
+			data.Has[r] = true
+		}
+	}
+	for _, r := range append(requiredRepresentations, msg.Representations...) {
+		data.Has[r] = true
+	}
+
+	for _, r := range append(requiredRepresentations, optionalRepresentations...) {
+		if data.Has[r] {
+			data.Representations = append(data.Representations, representationConstants[r]...)
+			if r != "text" {
+				data.CodecRepresentations = append(data.CodecRepresentations, representationConstants[r]...)
+			}
+		}
+	}
+
+	imports := map[string]bool{
+		"encoding/json": true,
+		"fmt":           true,
+		"time":          true,
+		"github.com/tombenke/axon-go-common/msgs":        true,
+		"github.com/tombenke/axon-go-common/msgs/common": true,
+	}
+	for _, r := range requiredRepresentations {
+		for _, i := range representationImports[r] {
+			imports[i] = true
+		}
+	}
+	if len(msg.BodyFields) > 0 {
+		imports["strings"] = true
+		for _, field := range msg.BodyFields {
+			if field.Type != "string" {
+				imports["strconv"] = true
+				data.ParsedFields = true
+			}
+		}
+	}
+	for _, i := range def.Imports {
+		imports[i] = true
+	}
+	data.Imports = sortedKeys(imports)
+
+	testImports := map[string]bool{
+		"errors":                             true,
+		"testing":                            true,
+		"github.com/stretchr/testify/assert": true,
+		"github.com/tombenke/axon-go-common/msgs":        true,
+		"github.com/tombenke/axon-go-common/msgs/common": true,
+	}
+	for _, i := range def.Imports {
+		testImports[i] = true
+	}
+	data.TestImports = sortedKeys(testImports)
+	return data
+}
+
+// The names of the `msgs.Representation` constants of the representations
+var representationConstants = map[string][]string{
+	"json":     {"JSONRepresentation"},
+	"yaml":     {"YAMLRepresentation"},
+	"gob":      {"GobRepresentation"},
+	"protobuf": {"ProtobufRepresentation"},
+	"xml":      {"XMLRepresentation"},
+	"avro":     {"AvroRepresentation"},
+	"msgpack":  {"MsgpackRepresentation"},
+	"cbor":     {"CBORRepresentation"},
+	"csv":      {"CSVRepresentation", "CSVHeaderRepresentation"},
+	"text":     {"TextRepresentation"},
+}
+
+// The import paths the implementation of the representations need
+var representationImports = map[string][]string{
+	"yaml":    {"gopkg.in/yaml.v2"},
+	"gob":     {"bytes", "encoding/gob"},
+	"xml":     {"encoding/xml"},
+	"avro":    {"github.com/tombenke/axon-go-common/msgs/avro"},
+	"msgpack": {"github.com/vmihailenco/msgpack/v5"},
+	"cbor":    {"github.com/fxamacker/cbor/v2"},
+}
+
+// render executes the `tmpls` templates with `data` one after the other, then formats the result as Go source
+func render(data messageData, tmpls ...*template.Template) ([]byte, error) {
+	var buf bytes.Buffer
+	for _, tmpl := range tmpls {
+		if err := tmpl.Execute(&buf, data); err != nil {
+			return nil, err
+		}
+	}
+	content, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("%w\n%s", err, buf.String())
+	}
+	return content, nil
+}
+
+// sortedKeys returns with the keys of `m` in alphabetical order
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// lowerFirst returns with `s` starting with lower case letter, e.g. `ConfigurePorts` becomes `configurePorts`.
+// A leading acronym is lowered as a whole, e.g. `GPS` becomes `gps`, and `HTTPServer` becomes `httpServer`.
+func lowerFirst(s string) string {
+	r := []rune(s)
+	for i := range r {
+		if !unicode.IsUpper(r[i]) || (i > 0 && i+1 < len(r) && unicode.IsLower(r[i+1])) {
+			break
+		}
+		r[i] = unicode.ToLower(r[i])
+	}
+	return string(r)
+}
+
+// zeroValue returns with the Go expression of the zero value of the `typ` type
+func zeroValue(typ string) string {
+	switch {
+	case typ == "string":
+		return `""`
+	case typ == "bool":
+		return "false"
+	case strings.HasPrefix(typ, "int") || strings.HasPrefix(typ, "uint") || strings.HasPrefix(typ, "float"):
+		return typ + "(0)"
+	default:
+		return "*new(" + typ + ")"
+	}
+}
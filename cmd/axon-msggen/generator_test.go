@@ -0,0 +1,103 @@
+package main
+
+import (
+	"flag"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+// update regenerates the golden files: `go test ./cmd/axon-msggen -update`
+var update = flag.Bool("update", false, "Update the golden files")
+
+func TestGenerate(t *testing.T) {
+	def, err := ReadDefinition("testdata/messages.yml")
+	assert.Nil(t, err)
+
+	dir := t.TempDir()
+	fileNames, err := Generate(def, "messages.yml", dir)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{
+		filepath.Join(dir, "pressure.go"),
+		filepath.Join(dir, "pressure_test.go"),
+		filepath.Join(dir, "wind.go"),
+		filepath.Join(dir, "wind_test.go"),
+	}, fileNames)
+
+	for _, fileName := range fileNames {
+		content, err := ioutil.ReadFile(fileName)
+		assert.Nil(t, err)
+		golden := filepath.Join("testdata", filepath.Base(fileName)+".golden")
+		if *update {
+			assert.Nil(t, ioutil.WriteFile(golden, content, 0644))
+		}
+		expected, err := ioutil.ReadFile(golden)
+		assert.Nil(t, err)
+		assert.Equal(t, string(expected), string(content), golden)
+	}
+}
+
+func TestDefinitionValidate(t *testing.T) {
+	def, err := ReadDefinition("testdata/messages.yml")
+	assert.Nil(t, err)
+	assert.Equal(t, "sensors/Pressure", def.Messages[0].TypeName)
+
+	type testCase struct {
+		def      Definition
+		expected string
+	}
+	cases := []testCase{
+		{Definition{Package: "sensors"}, "no messages are defined"},
+		{Definition{Package: "sensors/x", Messages: []MessageDefinition{{Name: "X", Body: "XBody"}}}, "invalid package name 'sensors/x'"},
+		{Definition{Package: "sensors", Messages: []MessageDefinition{{Name: "x", Body: "XBody"}}}, "message 'x': invalid name"},
+		{Definition{Package: "sensors", Messages: []MessageDefinition{{Name: "X"}}}, "message 'X': neither the body nor the body fields are defined"},
+		{Definition{Package: "sensors", Messages: []MessageDefinition{{Name: "X", Body: "XBody", BodyFields: []FieldDefinition{{Name: "Data", Type: "float64"}}}}}, "message 'X': either the body or the body fields have to be defined, not both"},
+		{Definition{Package: "sensors", Messages: []MessageDefinition{{Name: "X", BodyFields: []FieldDefinition{{Name: "Data", Type: "[]float64"}}}}}, "message 'X': unsupported type '[]float64' of the 'Data' body field, it must be float64, int64, bool or string"},
+		{Definition{Package: "sensors", Messages: []MessageDefinition{{Name: "X", BodyFields: []FieldDefinition{{Name: "Data", Type: "float64"}}, Args: []ArgDefinition{{Name: "value", Type: "float64", Field: "Value"}}}}}, "message 'X': the 'value' argument refers to the unknown 'Value' body field"},
+		{Definition{Package: "sensors", Messages: []MessageDefinition{{Name: "X", Body: "XBody", Representations: []string{"ros"}}}}, "message 'X': unknown representation 'ros'"},
+		{Definition{Package: "sensors", Messages: []MessageDefinition{{Name: "X", Body: "XBody", Args: []ArgDefinition{{Name: "data", Type: "float64", Field: "Data"}}}}}, "message 'X': the test arguments are not defined"},
+	}
+	for _, c := range cases {
+		err := c.def.Validate()
+		if assert.NotNil(t, err) {
+			assert.Equal(t, c.expected, err.Error())
+		}
+	}
+}
+
+func TestReadDefinitionBodyMethods(t *testing.T) {
+	dir := t.TempDir()
+	fileName := filepath.Join(dir, "messages.yml")
+	definition := "package: sensors\nbodyMethods: missing.tmpl\nmessages:\n  - { name: X, body: XBody }\n"
+	assert.Nil(t, ioutil.WriteFile(fileName, []byte(definition), 0644))
+	_, err := ReadDefinition(fileName)
+	assert.NotNil(t, err)
+
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "missing.tmpl"), []byte("{{if}}"), 0644))
+	_, err = ReadDefinition(fileName)
+	assert.NotNil(t, err)
+}
+
+func TestHasField(t *testing.T) {
+	data := messageData{MessageDefinition: MessageDefinition{BodyFields: []FieldDefinition{{Name: "Value"}, {Name: "Unit"}}}}
+	assert.True(t, data.HasField("Value"))
+	assert.True(t, data.HasField("Unit", "Value"))
+	assert.False(t, data.HasField("Value", "Quality"))
+	assert.True(t, data.HasField())
+}
+
+func TestLowerFirst(t *testing.T) {
+	for s, expected := range map[string]string{
+		"":               "",
+		"Pressure":       "pressure",
+		"FlowRate":       "flowRate",
+		"GPS":            "gps",
+		"HTTPServer":     "httpServer",
+		"SensorID":       "sensorID",
+		"X":              "x",
+		"alreadyLowered": "alreadyLowered",
+	} {
+		assert.Equal(t, expected, lowerFirst(s))
+	}
+}
@@ -0,0 +1,63 @@
+// Command axon-msggen generates message-types from their YAML definitions.
+//
+// It generates the message-type with its registration, all the supported codecs and the constructors,
+// as well as the table-driven tests of the message-type, into the `<name>.go` and `<name>_test.go` files
+// next to the definition file. It is used by `go generate`:
+//
+//	//go:generate go run github.com/tombenke/axon-go-common/cmd/axon-msggen -f messages.yml
+//
+// The definition file holds the package name and the list of the message-types:
+//
+//	package: sensors
+//	messages:
+//	  - name: Pressure
+//	    description: message structure represents the air pressure in Pa
+//	    body: common.Float64VarBody       # an existing body type,
+//	    args:                             # and the arguments of the constructors assigned to its fields
+//	      - { name: data, type: float64, field: Data }
+//	    representations: [csv, text]      # the optional representations the body supports
+//	    testArgs: ["101325."]             # the constructor arguments of the tests
+//	  - name: Wind
+//	    bodyFields:                       # the fields of the `WindBody` type generated for the message
+//	      - { name: Speed, type: float64, description: is the speed of the wind in m/s }
+//	      - { name: Direction, type: float64 }
+//	    testArgs: ["WindBody{Speed: 2.5, Direction: 270}"]
+//
+// The optional `bodyMethods` property names a Go template file next to the definition file,
+// that generates additional methods of the generated bodies, e.g. for the unit conversion of the sensor bodies.
+// The template gets the data of the message-type, and its `.HasField "<name>"...` method tells which fields the body has.
+//
+// If no `args` are defined, then the constructors get the whole body.
+// The generated bodies may have `float64`, `int64`, `bool` and `string` fields, and they implement all the representations.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+func main() {
+	fileName := flag.String("f", "messages.yml", "The name of the message-types definition file")
+	flag.Parse()
+
+	def, err := ReadDefinition(*fileName)
+	if err != nil {
+		fail(err)
+	}
+
+	fileNames, err := Generate(def, filepath.Base(*fileName), filepath.Dir(*fileName))
+	if err != nil {
+		fail(err)
+	}
+	for _, fileName := range fileNames {
+		fmt.Println(fileName)
+	}
+}
+
+// fail prints the `err` error to the standard error, then exits
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, err)
+	os.Exit(1)
+}
@@ -0,0 +1,546 @@
+package main
+
+import (
+	"strings"
+	"text/template"
+)
+
+// templateFuncs are the helper functions of the templates
+var templateFuncs = template.FuncMap{
+	"lowerFirst": lowerFirst,
+	"zeroValue":  zeroValue,
+	"join":       strings.Join,
+	"add":        func(a, b int) int { return a + b },
+}
+
+// messageTemplate generates the message-type and its codecs
+var messageTemplate = template.Must(template.New("message").Funcs(templateFuncs).Parse(`// Code generated by axon-msggen from {{.Source}}. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+{{- range .Imports}}
+	"{{.}}"
+{{- end}}
+)
+
+const (
+	// {{.Name}}TypeName is the printable name of the ` + "`{{.Name}}`" + ` message-type
+	{{.Name}}TypeName = "{{.TypeName}}"
+)
+
+func init() {
+	msgs.RegisterMessageType({{.Name}}TypeName, []msgs.Representation{ {{- range $i, $r := .Representations}}{{if $i}}, {{end}}msgs.{{$r}}{{end -}} }, func() msgs.Message {
+		return New{{.Name}}Message({{template "zeroArgs" .}})
+	})
+{{- if .Description}}
+	msgs.SetMessageTypeDescription({{.Name}}TypeName, {{printf "%q" .Description}})
+{{- end}}
+}
+
+// {{.Name}} {{if .Description}}{{.Description}}{{else}}message structure{{end}}
+type {{.Name}} struct {
+	Header common.Header
+	Body   {{.BodyType}}
+}
+{{- if .BodyFields}}
+
+// {{.BodyType}} holds the body part of the ` + "`{{.Name}}`" + ` message
+type {{.BodyType}} struct {
+{{- range .BodyFields}}
+{{- if .Description}}
+	// {{.Name}} {{.Description}}
+{{- end}}
+	{{.Name}} {{.Type}}
+{{- end}}
+}
+{{- end}}
+
+// GetType returns with the printable name of the ` + "`{{.Name}}`" + ` message-type
+func (msg *{{.Name}}) GetType() string {
+	return {{.Name}}TypeName
+}
+
+// Encode returns with the ` + "`{{.Name}}`" + ` message content in a representation format selected by ` + "`representation`" + `
+func (msg *{{.Name}}) Encode(representation msgs.Representation) (results []byte) {
+	switch representation {
+	case msgs.JSONRepresentation:
+		results = msg.JSON()
+	case msgs.YAMLRepresentation:
+		results = msg.YAML()
+	case msgs.GobRepresentation:
+		results = msg.EncodeGob()
+	case msgs.ProtobufRepresentation:
+		results = msg.Protobuf()
+{{- if .Has.csv}}
+	case msgs.CSVRepresentation, msgs.CSVHeaderRepresentation:
+		results = msg.CSV(representation == msgs.CSVHeaderRepresentation)
+{{- end}}
+{{- if .Has.text}}
+	case msgs.TextRepresentation:
+		results = msg.Text()
+{{- end}}
+	case msgs.XMLRepresentation:
+		results = msg.XML()
+	case msgs.AvroRepresentation:
+		results = msg.Avro()
+	case msgs.MsgpackRepresentation:
+		results = msg.Msgpack()
+	case msgs.CBORRepresentation:
+		results = msg.CBOR()
+	default:
+		panic(fmt.Errorf("Encode error: unknown representational format '%s'", representation))
+	}
+	return results
+}
+
+// Decode parses the ` + "`content`" + ` using the selected ` + "`representation`" + ` format.
+// The messages of older versions are upgraded to the current version of the message-type, if the ` + "`representation`" + ` is upgradable.
+func (msg *{{.Name}}) Decode(representation msgs.Representation, content []byte) error {
+	content, err := msgs.Upgrade({{.Name}}TypeName, representation, content)
+	if err != nil {
+		return err
+	}
+
+	switch representation {
+	case msgs.JSONRepresentation:
+		return msg.ParseJSON(content)
+	case msgs.YAMLRepresentation:
+		return msg.ParseYAML(content)
+	case msgs.GobRepresentation:
+		return msg.DecodeGob(content)
+	case msgs.ProtobufRepresentation:
+		return msg.ParseProtobuf(content)
+{{- if .Has.csv}}
+	case msgs.CSVRepresentation, msgs.CSVHeaderRepresentation:
+		return msg.ParseCSV(content)
+{{- end}}
+{{- if .Has.text}}
+	case msgs.TextRepresentation:
+		return msg.ParseText(content)
+{{- end}}
+	case msgs.XMLRepresentation:
+		return msg.ParseXML(content)
+	case msgs.AvroRepresentation:
+		return msg.ParseAvro(content)
+	case msgs.MsgpackRepresentation:
+		return msg.ParseMsgpack(content)
+	case msgs.CBORRepresentation:
+		return msg.ParseCBOR(content)
+	default:
+		panic(fmt.Errorf("Decode error: unknown representational format '%s'", representation))
+	}
+}
+
+// JSON returns with the ` + "`{{.Name}}`" + ` message content in JSON representation format
+func (msg *{{.Name}}) JSON() []byte {
+	jsonBytes, err := json.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return jsonBytes
+}
+
+// String returns with the ` + "`{{.Name}}`" + ` message content in JSON format string
+func (msg *{{.Name}}) String() string {
+	return string(msg.JSON())
+}
+
+// ParseJSON parses the JSON representation of a ` + "`{{.Name}}`" + ` messages from the ` + "`jsonBytes`" + ` argument.
+func (msg *{{.Name}}) ParseJSON(jsonBytes []byte) error {
+	return json.Unmarshal(jsonBytes, msg)
+}
+
+// YAML returns with the ` + "`{{.Name}}`" + ` message content in YAML representation format
+func (msg *{{.Name}}) YAML() []byte {
+	yamlBytes, err := yaml.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return yamlBytes
+}
+
+// ParseYAML parses the YAML representation of a ` + "`{{.Name}}`" + ` messages from the ` + "`yamlBytes`" + ` argument.
+func (msg *{{.Name}}) ParseYAML(yamlBytes []byte) error {
+	return yaml.Unmarshal(yamlBytes, msg)
+}
+
+// EncodeGob returns with the ` + "`{{.Name}}`" + ` message content in Gob representation format
+func (msg *{{.Name}}) EncodeGob() []byte {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(*msg); err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
+// DecodeGob parses the Gob representation of a ` + "`{{.Name}}`" + ` messages from the ` + "`gobBytes`" + ` argument.
+// Gob omits the zero values, so the message is replaced as a whole by the decoded one.
+func (msg *{{.Name}}) DecodeGob(gobBytes []byte) error {
+	var decoded {{.Name}}
+	if err := gob.NewDecoder(bytes.NewReader(gobBytes)).Decode(&decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// Protobuf returns with the ` + "`{{.Name}}`" + ` message content in protobuf representation format
+func (msg *{{.Name}}) Protobuf() []byte {
+	return common.MarshalProtobufMessage(msg.Header, msg.Body)
+}
+
+// ParseProtobuf parses the protobuf representation of a ` + "`{{.Name}}`" + ` messages from the ` + "`protobufBytes`" + ` argument.
+// Protobuf omits the zero values, so the message is replaced as a whole by the decoded one.
+func (msg *{{.Name}}) ParseProtobuf(protobufBytes []byte) error {
+	var decoded {{.Name}}
+	if err := common.UnmarshalProtobufMessage(protobufBytes, &decoded.Header, &decoded.Body); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+{{- if .Has.csv}}
+
+// CSV returns with the ` + "`{{.Name}}`" + ` message content in CSV representation format, as a ` + "`timestamp,values...`" + ` record.
+// The record is preceded by a header row, if ` + "`withHeaderRow`" + ` is true.
+func (msg *{{.Name}}) CSV(withHeaderRow bool) []byte {
+	return common.MarshalCSVMessage(msg.Header, msg.Body, withHeaderRow)
+}
+
+// ParseCSV parses the CSV representation of a ` + "`{{.Name}}`" + ` messages from the ` + "`csvBytes`" + ` argument.
+// If the content has no header row that tells the time precision, the current precision of the message is kept.
+func (msg *{{.Name}}) ParseCSV(csvBytes []byte) error {
+	decoded := {{.Name}}{Header: msg.Header}
+	if err := common.UnmarshalCSVMessage(csvBytes, &decoded.Header, &decoded.Body); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+{{- end}}
+{{- if .Has.text}}
+
+// Text returns with the body of the ` + "`{{.Name}}`" + ` message in plain text representation format
+func (msg *{{.Name}}) Text() []byte {
+	return []byte(msg.Body.Text())
+}
+
+// ParseText parses the plain text representation of a ` + "`{{.Name}}`" + ` messages from the ` + "`textBytes`" + ` argument.
+// The plain text holds no timestamp, so the header gets the current time in the current precision of the message,
+// or in the default precision if the message has no precision.
+func (msg *{{.Name}}) ParseText(textBytes []byte) error {
+	var decoded {{.Name}}
+	if err := decoded.Body.ParseText(string(textBytes)); err != nil {
+		return err
+	}
+	precision := msg.Header.TimePrecision
+	if precision == "" {
+		precision = common.DefaultTimePrecision
+	}
+	decoded.Header = common.NewHeaderAt(common.NowAsUnixWithPrecision(precision), precision)
+	*msg = decoded
+	return nil
+}
+{{- end}}
+
+// XML returns with the ` + "`{{.Name}}`" + ` message content in XML representation format
+func (msg *{{.Name}}) XML() []byte {
+	xmlBytes, err := xml.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return xmlBytes
+}
+
+// ParseXML parses the XML representation of a ` + "`{{.Name}}`" + ` messages from the ` + "`xmlBytes`" + ` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *{{.Name}}) ParseXML(xmlBytes []byte) error {
+	var decoded {{.Name}}
+	if err := xml.Unmarshal(xmlBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// Avro returns with the ` + "`{{.Name}}`" + ` message content in Avro representation format
+func (msg *{{.Name}}) Avro() []byte {
+	avroBytes, err := avro.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return avroBytes
+}
+
+// ParseAvro parses the Avro representation of a ` + "`{{.Name}}`" + ` messages from the ` + "`avroBytes`" + ` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *{{.Name}}) ParseAvro(avroBytes []byte) error {
+	var decoded {{.Name}}
+	if err := avro.Unmarshal(avroBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// Msgpack returns with the ` + "`{{.Name}}`" + ` message content in MessagePack representation format
+func (msg *{{.Name}}) Msgpack() []byte {
+	msgpackBytes, err := msgpack.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return msgpackBytes
+}
+
+// ParseMsgpack parses the MessagePack representation of a ` + "`{{.Name}}`" + ` messages from the ` + "`msgpackBytes`" + ` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *{{.Name}}) ParseMsgpack(msgpackBytes []byte) error {
+	var decoded {{.Name}}
+	if err := msgpack.Unmarshal(msgpackBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// CBOR returns with the ` + "`{{.Name}}`" + ` message content in CBOR representation format
+func (msg *{{.Name}}) CBOR() []byte {
+	cborBytes, err := cbor.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return cborBytes
+}
+
+// ParseCBOR parses the CBOR representation of a ` + "`{{.Name}}`" + ` messages from the ` + "`cborBytes`" + ` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *{{.Name}}) ParseCBOR(cborBytes []byte) error {
+	var decoded {{.Name}}
+	if err := cbor.Unmarshal(cborBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// New{{.Name}}Message returns with a new ` + "`{{.Name}}`" + ` message. The header will contain the current time in ` + "`Nanoseconds`" + ` precision.
+func New{{.Name}}Message({{template "params" .}}) msgs.Message {
+	return New{{.Name}}MessageAt({{template "argNames" .}}, time.Now().UnixNano(), "ns")
+}
+
+// New{{.Name}}MessageAt returns with a new ` + "`{{.Name}}`" + ` message. The header will contain the ` + "`at`" + ` time in ` + "`withPrecision`" + ` precision.
+func New{{.Name}}MessageAt({{template "params" .}}, at int64, withPrecision common.TimePrecision) msgs.Message {
+	var msg {{.Name}}
+	msg.Header = common.NewHeaderAt(at, withPrecision)
+	msg.Header.Version = msgs.GetMessageTypeVersion({{.Name}}TypeName)
+{{- if .Args}}
+{{- range .Args}}
+	msg.Body.{{.Field}} = {{.Name}}
+{{- end}}
+{{- else}}
+	msg.Body = body
+{{- end}}
+	return &msg
+}
+{{- if .BodyFields}}
+
+// AppendProtobuf appends the protobuf encoded fields of the body to ` + "`b`" + `
+func (body {{.BodyType}}) AppendProtobuf(b []byte) []byte {
+{{- range $i, $f := .BodyFields}}
+	b = common.AppendProtobuf{{template "protobufType" $f}}(b, {{add $i 1}}, body.{{$f.Name}})
+{{- end}}
+	return b
+}
+
+// ParseProtobuf parses the protobuf encoded body from ` + "`b`" + `
+func (body *{{.BodyType}}) ParseProtobuf(b []byte) error {
+	fields, err := common.ParseProtobufFields(b)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range fields {
+		switch f.Num {
+{{- range $i, $f := .BodyFields}}
+		case {{add $i 1}}:
+			body.{{$f.Name}} = f.{{template "protobufType" $f}}()
+{{- end}}
+		}
+	}
+	return nil
+}
+
+// CSVColumns returns with the names of the CSV columns of the body values
+func (body {{.BodyType}}) CSVColumns() []string {
+	return []string{ {{- range $i, $f := .BodyFields}}{{if $i}}, {{end}}"{{lowerFirst $f.Name}}"{{end -}} }
+}
+
+// CSVValues returns with the body values in text format
+func (body {{.BodyType}}) CSVValues() []string {
+	return []string{ {{- range $i, $f := .BodyFields}}{{if $i}}, {{end}}{{template "formatValue" $f}}{{end -}} }
+}
+
+// ParseCSVValues parses the body from the ` + "`values`" + ` in text format
+func (body *{{.BodyType}}) ParseCSVValues(values []string) error {
+	if len(values) != {{len .BodyFields}} {
+		return fmt.Errorf("wrong number of CSV values: expected {{len .BodyFields}}, got %d", len(values))
+	}
+{{- if .ParsedFields}}
+
+	var err error
+{{- end}}
+{{- range $i, $f := .BodyFields}}
+{{- if eq $f.Type "string"}}
+	body.{{$f.Name}} = values[{{$i}}]
+{{- else}}
+	if body.{{$f.Name}}, err = {{template "parseValue" $f}}(strings.TrimSpace(values[{{$i}}]){{template "parseArgs" $f}}); err != nil {
+		return err
+	}
+{{- end}}
+{{- end}}
+	return nil
+}
+
+// Text returns with the body values in plain text format, separated by space
+func (body {{.BodyType}}) Text() string {
+	return strings.Join(body.CSVValues(), " ")
+}
+
+// ParseText parses the body from the ` + "`text`" + ` in plain text format, that holds the values separated by white space
+func (body *{{.BodyType}}) ParseText(text string) error {
+	return body.ParseCSVValues(strings.Fields(text))
+}
+{{- end}}
+
+{{- define "params"}}
+{{- if .Args}}
+{{- range $i, $a := .Args}}{{if $i}}, {{end}}{{$a.Name}} {{$a.Type}}{{end}}
+{{- else}}body {{.BodyType}}
+{{- end}}
+{{- end}}
+
+{{- define "argNames"}}
+{{- if .Args}}
+{{- range $i, $a := .Args}}{{if $i}}, {{end}}{{$a.Name}}{{end}}
+{{- else}}body
+{{- end}}
+{{- end}}
+
+{{- define "zeroArgs"}}
+{{- if .Args}}
+{{- range $i, $a := .Args}}{{if $i}}, {{end}}{{zeroValue $a.Type}}{{end}}
+{{- else}}{{.BodyType}}{}
+{{- end}}
+{{- end}}
+
+{{- define "protobufType"}}
+{{- if eq .Type "float64"}}Float64{{else if eq .Type "int64"}}Int64{{else if eq .Type "bool"}}Bool{{else}}String{{end}}
+{{- end}}
+
+{{- define "formatValue"}}
+{{- if eq .Type "float64"}}strconv.FormatFloat(body.{{.Name}}, 'g', -1, 64)
+{{- else if eq .Type "int64"}}strconv.FormatInt(body.{{.Name}}, 10)
+{{- else if eq .Type "bool"}}strconv.FormatBool(body.{{.Name}})
+{{- else}}body.{{.Name}}
+{{- end}}
+{{- end}}
+
+{{- define "parseValue"}}
+{{- if eq .Type "float64"}}strconv.ParseFloat{{else if eq .Type "int64"}}strconv.ParseInt{{else}}strconv.ParseBool{{end}}
+{{- end}}
+
+{{- define "parseArgs"}}
+{{- if eq .Type "float64"}}, 64{{else if eq .Type "int64"}}, 10, 64{{end}}
+{{- end}}
+`))
+
+// testTemplate generates the tests of the message-type
+var testTemplate = template.Must(template.Must(messageTemplate.Clone()).New("test").Parse(`// Code generated by axon-msggen from {{.Source}}. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+{{- range .TestImports}}
+	"{{.}}"
+{{- end}}
+)
+
+func Test{{.Name}}GetType(t *testing.T) {
+	assert.Equal(t, New{{.Name}}Message({{template "zeroArgs" .}}).GetType(), {{.Name}}TypeName)
+}
+
+func Test{{.Name}}Message(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := New{{.Name}}MessageAt({{template "testArgs" .}}, at, prec)
+	var n {{.Name}}
+	err := n.ParseJSON(m.JSON())
+	assert.Nil(t, err)
+	err = n.ParseJSON([]byte(m.String()))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func Test{{.Name}}MessageCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := New{{.Name}}MessageAt({{template "testArgs" .}}, at, prec)
+	representations := []msgs.Representation{
+{{- range .CodecRepresentations}}
+		msgs.{{.}},
+{{- end}}
+	}
+	for _, representation := range representations {
+		t.Run(string(representation), func(t *testing.T) {
+			assert.True(t, msgs.DoesMessageTypeImplementsRepresentation({{.Name}}TypeName, representation))
+			var n {{.Name}}
+			err := n.Decode(representation, m.Encode(representation))
+			assert.Nil(t, err)
+			assert.Equal(t, m, &n)
+		})
+	}
+}
+{{- if .Has.text}}
+
+func Test{{.Name}}MessageTextCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := New{{.Name}}MessageAt({{template "testArgs" .}}, at, prec).(*{{.Name}})
+	var n {{.Name}}
+	err := n.Decode(msgs.TextRepresentation, m.Encode(msgs.TextRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m.Body, n.Body)
+	assert.Equal(t, common.DefaultTimePrecision, n.Header.TimePrecision)
+}
+{{- end}}
+
+func Test{{.Name}}MessageCodecPanic(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := New{{.Name}}MessageAt({{template "testArgs" .}}, at, prec)
+	var n {{.Name}}
+	func() {
+		defer func() {
+			if r := recover(); r != nil {
+				assert.Equal(t, r, errors.New("Decode error: unknown representational format 'wrong-representation'"))
+			}
+		}()
+		err := n.Decode(msgs.Representation("wrong-representation"), m.Encode(msgs.JSONRepresentation))
+		assert.Nil(t, err)
+	}()
+	func() {
+		defer func() {
+			if r := recover(); r != nil {
+				assert.Equal(t, r, errors.New("Encode error: unknown representational format 'wrong-representation'"))
+			}
+		}()
+		err := n.Decode(msgs.JSONRepresentation, m.Encode(msgs.Representation("wrong-representation")))
+		assert.Nil(t, err)
+	}()
+}
+
+{{- define "testArgs"}}
+{{- if .TestArgs}}{{join .TestArgs ", "}}{{else}}{{template "zeroArgs" .}}{{end}}
+{{- end}}
+`))
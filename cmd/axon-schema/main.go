@@ -0,0 +1,68 @@
+// Command axon-schema prints the JSON Schema of the node config file, or of the registered message-types.
+//
+// Usage:
+//
+//	axon-schema                       # the schema of the `config.yml` file
+//	axon-schema -type base/Float64    # the schema of the `base/Float64` message-type
+//	axon-schema -types                # the schemas of all the message-types
+//	axon-schema -avro base/Float64    # the Avro schema of the `base/Float64` message-type
+//	axon-schema -list                 # the names of the message-types
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"github.com/tombenke/axon-go-common/msgs"
+	_ "github.com/tombenke/axon-go-common/msgs/base"
+	_ "github.com/tombenke/axon-go-common/msgs/geometry"
+	_ "github.com/tombenke/axon-go-common/msgs/orchestra"
+	_ "github.com/tombenke/axon-go-common/msgs/sensors"
+	"github.com/tombenke/axon-go-common/schema"
+	"os"
+)
+
+func main() {
+	typeName := flag.String("type", "", "The name of the message-type to print the schema of")
+	allTypes := flag.Bool("types", false, "Print the schemas of all the message-types")
+	avroTypeName := flag.String("avro", "", "The name of the message-type to print the Avro schema of")
+	list := flag.Bool("list", false, "List the names of the registered message-types")
+	flag.Parse()
+
+	switch {
+	case *list:
+		for _, t := range msgs.GetMessageTypes() {
+			fmt.Println(t)
+		}
+
+	case *allTypes:
+		jsonBytes, err := json.MarshalIndent(schema.MessageTypes(), "", "  ")
+		if err != nil {
+			fail(err)
+		}
+		fmt.Println(string(jsonBytes))
+
+	case *avroTypeName != "":
+		s, err := msgs.GetAvroSchema(*avroTypeName)
+		if err != nil {
+			fail(err)
+		}
+		fmt.Println(string(s.JSON()))
+
+	case *typeName != "":
+		s, err := schema.MessageType(*typeName)
+		if err != nil {
+			fail(err)
+		}
+		fmt.Println(string(s.JSON()))
+
+	default:
+		fmt.Println(string(schema.ConfigFile().JSON()))
+	}
+}
+
+// fail prints the `err` error to the standard error, then exits
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, err)
+	os.Exit(1)
+}
@@ -0,0 +1,160 @@
+package config
+
+import (
+	"fmt"
+	"github.com/tombenke/axon-go-common/msgs/base"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The buffering policies of the input ports
+const (
+	// BufferLatest keeps only the latest message received. This is the default policy.
+	BufferLatest = "latest"
+
+	// BufferQueue keeps the messages received since the last processing in a bounded FIFO queue
+	BufferQueue = "queue"
+
+	// BufferWindow keeps the messages received within a sliding time window
+	BufferWindow = "window"
+
+	// BufferAggregate aggregates the values of the messages received since the last processing,
+	// or within a sliding time window, into one message
+	BufferAggregate = "aggregate"
+)
+
+// The aggregation functions of the `aggregate` buffering policy
+const (
+	AggregateSum   = "sum"
+	AggregateMean  = "mean"
+	AggregateMin   = "min"
+	AggregateMax   = "max"
+	AggregateCount = "count"
+)
+
+// NumericTypes holds the message-types that have a numeric value,
+// so the `aggregate` buffering policy and the deadband trigger rule can be used with them
+var NumericTypes = []string{base.Float64TypeName, base.Int64TypeName}
+
+// IsNumericType returns true if the `messageType` is one of the `NumericTypes`
+func IsNumericType(messageType string) bool {
+	for _, numeric := range NumericTypes {
+		if messageType == numeric {
+			return true
+		}
+	}
+	return false
+}
+
+// Buffer describes how an input port buffers the messages received between two processing cycles
+type Buffer struct {
+	// Policy is the buffering policy: `latest`, `queue`, `window` or `aggregate`. The empty string means `latest`.
+	Policy string `yaml:"policy"`
+
+	// Size is the capacity of the buffer. The oldest messages are dropped if the buffer is full.
+	// It is mandatory for the `queue` policy, and optional for the `window` and `aggregate` policies.
+	Size int `yaml:"size"`
+
+	// Window is the length of the sliding time window, e.g. `5s`.
+	// It is mandatory for the `window` policy, and optional for the `aggregate` policy.
+	Window string `yaml:"window"`
+
+	// Aggregate is the aggregation function of the `aggregate` policy: `sum`, `mean`, `min`, `max` or `count`
+	Aggregate string `yaml:"aggregate"`
+}
+
+// IsLatest returns true if the buffer keeps only the latest message
+func (b Buffer) IsLatest() bool {
+	return b.Policy == "" || b.Policy == BufferLatest
+}
+
+// WindowDuration returns with the length of the sliding time window, or 0 if it is not defined or invalid
+func (b Buffer) WindowDuration() time.Duration {
+	window, err := time.ParseDuration(b.Window)
+	if err != nil {
+		return 0
+	}
+	return window
+}
+
+// Validate checks if the buffer can be used with an input port of `portType` message-type
+func (b Buffer) Validate(portType string) error {
+	if b.Size < 0 {
+		return fmt.Errorf("the size of the buffer can not be negative")
+	}
+	if b.Window != "" {
+		if window, err := time.ParseDuration(b.Window); err != nil || window <= 0 {
+			return fmt.Errorf("wrong '%s' buffer window, it must be a positive duration, e.g. '5s'", b.Window)
+		}
+	}
+
+	switch b.Policy {
+	case "", BufferLatest:
+		return nil
+	case BufferQueue:
+		if b.Size == 0 {
+			return fmt.Errorf("the size of the 'queue' buffer must be defined")
+		}
+	case BufferWindow:
+		if b.Window == "" {
+			return fmt.Errorf("the window of the 'window' buffer must be defined")
+		}
+	case BufferAggregate:
+		switch b.Aggregate {
+		case AggregateSum, AggregateMean, AggregateMin, AggregateMax, AggregateCount:
+		default:
+			return fmt.Errorf("unknown '%s' aggregation function, it must be one of sum, mean, min, max or count", b.Aggregate)
+		}
+		if !IsNumericType(portType) {
+			return fmt.Errorf("the '%s' message-type can not be aggregated, only %s", portType, strings.Join(NumericTypes, ", "))
+		}
+	default:
+		return fmt.Errorf("unknown '%s' buffering policy", b.Policy)
+	}
+	return nil
+}
+
+// String returns with the `<policy>[:<arguments>]` CLI format of the buffer, that `ParseBuffer` can parse
+func (b Buffer) String() string {
+	switch b.Policy {
+	case BufferQueue:
+		return BufferQueue + ":" + strconv.Itoa(b.Size)
+	case BufferWindow:
+		return BufferWindow + ":" + b.Window
+	case BufferAggregate:
+		if b.Window != "" {
+			return BufferAggregate + ":" + b.Aggregate + ":" + b.Window
+		}
+		return BufferAggregate + ":" + b.Aggregate
+	default:
+		return b.Policy
+	}
+}
+
+// ParseBuffer parses the CLI format of the buffer: `latest`, `queue:<size>`, `window:<duration>`,
+// `aggregate:<function>` or `aggregate:<function>:<duration>`, e.g. `aggregate:mean:10s`
+func ParseBuffer(spec string) (Buffer, error) {
+	parts := strings.Split(spec, ":")
+	b := Buffer{Policy: parts[0]}
+
+	switch {
+	case spec == "" || (b.Policy == BufferLatest && len(parts) == 1):
+	case b.Policy == BufferQueue && len(parts) == 2:
+		size, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return b, fmt.Errorf("wrong '%s' queue size", parts[1])
+		}
+		b.Size = size
+	case b.Policy == BufferWindow && len(parts) == 2:
+		b.Window = parts[1]
+	case b.Policy == BufferAggregate && (len(parts) == 2 || len(parts) == 3):
+		b.Aggregate = parts[1]
+		if len(parts) == 3 {
+			b.Window = parts[2]
+		}
+	default:
+		return b, fmt.Errorf("wrong '%s' buffer format", spec)
+	}
+	return b, nil
+}
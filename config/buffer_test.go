@@ -0,0 +1,74 @@
+package config
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestParseBuffer(t *testing.T) {
+	valid := map[string]Buffer{
+		"":                   {},
+		"latest":             {Policy: BufferLatest},
+		"queue:10":           {Policy: BufferQueue, Size: 10},
+		"window:5s":          {Policy: BufferWindow, Window: "5s"},
+		"aggregate:mean":     {Policy: BufferAggregate, Aggregate: AggregateMean},
+		"aggregate:max:1m0s": {Policy: BufferAggregate, Aggregate: AggregateMax, Window: "1m0s"},
+	}
+	for spec, expected := range valid {
+		b, err := ParseBuffer(spec)
+		assert.Nil(t, err)
+		assert.Equal(t, expected, b)
+		assert.Equal(t, spec, b.String())
+	}
+
+	for _, spec := range []string{"latest:1", "queue", "queue:ten", "window", "aggregate", "aggregate:sum:1s:2", "fifo:3"} {
+		_, err := ParseBuffer(spec)
+		assert.NotNil(t, err, spec)
+	}
+}
+
+func TestBufferValidate(t *testing.T) {
+	valid := []Buffer{
+		{},
+		{Policy: BufferLatest},
+		{Policy: BufferQueue, Size: 3},
+		{Policy: BufferWindow, Window: "500ms"},
+		{Policy: BufferWindow, Window: "10s", Size: 100},
+		{Policy: BufferAggregate, Aggregate: AggregateCount},
+		{Policy: BufferAggregate, Aggregate: AggregateMean, Window: "1m"},
+	}
+	for _, b := range valid {
+		assert.Nil(t, b.Validate("base/Float64"), b.String())
+	}
+
+	type testCase struct {
+		buffer   Buffer
+		portType string
+		expected string
+	}
+	cases := []testCase{
+		{Buffer{Policy: "fifo"}, "base/Float64", "unknown 'fifo' buffering policy"},
+		{Buffer{Policy: BufferQueue}, "base/Float64", "the size of the 'queue' buffer must be defined"},
+		{Buffer{Policy: BufferQueue, Size: -1}, "base/Float64", "the size of the buffer can not be negative"},
+		{Buffer{Policy: BufferWindow}, "base/Float64", "the window of the 'window' buffer must be defined"},
+		{Buffer{Policy: BufferWindow, Window: "5"}, "base/Float64", "wrong '5' buffer window, it must be a positive duration, e.g. '5s'"},
+		{Buffer{Policy: BufferWindow, Window: "-5s"}, "base/Float64", "wrong '-5s' buffer window, it must be a positive duration, e.g. '5s'"},
+		{Buffer{Policy: BufferAggregate, Aggregate: "median"}, "base/Float64", "unknown 'median' aggregation function, it must be one of sum, mean, min, max or count"},
+		{Buffer{Policy: BufferAggregate, Aggregate: AggregateSum}, "base/String", "the 'base/String' message-type can not be aggregated, only base/Float64, base/Int64"},
+	}
+	for _, c := range cases {
+		err := c.buffer.Validate(c.portType)
+		if assert.NotNil(t, err) {
+			assert.Equal(t, c.expected, err.Error())
+		}
+	}
+}
+
+func TestBufferWindowDuration(t *testing.T) {
+	assert.Equal(t, 1500*time.Millisecond, Buffer{Window: "1.5s"}.WindowDuration())
+	assert.Equal(t, time.Duration(0), Buffer{}.WindowDuration())
+	assert.True(t, Buffer{}.IsLatest())
+	assert.True(t, Buffer{Policy: BufferLatest}.IsLatest())
+	assert.False(t, Buffer{Policy: BufferQueue, Size: 1}.IsLatest())
+}
@@ -41,8 +41,8 @@ const (
 	messagingClusterIDEnvVar  = "MESSAGING_CLUSTER_ID"
 	defaultMessagingClusterID = ""
 
-	inputsHelp  = "Input. Format: <name>[|<channel>[|<type>|<representation>|<default>]]"
-	outputsHelp = "Output. Format: <name>[|<channel>[|<type>|<representation>]]"
+	inputsHelp  = "Input. Format: <name>[|<channel>[|<type>|<representation>|<default>[|<deadLetter>[|<buffer>]]]]"
+	outputsHelp = "Output. Format: <name>[|<channel>[|<type>|<representation>[|<emit>]]]"
 )
 
 // GetDefaultFlagSet returns with the default values of the generic configuration parameters
@@ -2,6 +2,7 @@ package config
 
 import (
 	"errors"
+	"fmt"
 	"github.com/tombenke/axon-go-common/messenger"
 )
 
@@ -36,6 +37,9 @@ type Node struct {
 	// use the orchestration features of the EPN.
 	Orchestration Orchestration `yaml:"orchestration"`
 
+	// Trigger holds the rules that decide when the processing fires in asynchronous mode
+	Trigger Trigger `yaml:"trigger"`
+
 	// SpecsURL holds an URL to the base-path of the detailed specification of the Node.
 	// This parameter is optional. If it is given it has to point to a valid URL of a content server
 	// which provides additional information  on the Node, e.g. README.md, symbol.svg, icon.svg, etc.
@@ -127,6 +131,12 @@ type Channels struct {
 	// The Nodes that work in synchronous mode must publish to this channel
 	// the processing-completed message which includes the ID of the Node.
 	ProcessingCompleted string `yaml:"processingCompleted"`
+
+	// ConfigurePorts is the prefix of the name of the channel through which the I/O ports
+	// of a running Node can be reconfigured. Every Node listens to its own control channel,
+	// that is made of this prefix and the name of the Node, e.g.: `configure-ports.<node-name>`.
+	// The Node responds to the requests with its new status report.
+	ConfigurePorts string `yaml:"configurePorts"`
 }
 
 // GetDefaultNode returns with a new Node structure with default values
@@ -160,6 +170,7 @@ func GetDefaultNode() Node {
 				SendingCompleted:    "sending-completed",
 				ReceiveAndProcess:   "receive-and-process",
 				ProcessingCompleted: "processing-completed",
+				ConfigurePorts:      "configure-ports",
 			},
 		},
 	}
@@ -199,6 +210,8 @@ func (n *Node) AddSpecsURL(specsURL string) {
 // after merging the coming from the three sources
 func MergeNodeConfigs(hardCoded Node, cli Node) (Node, error) {
 	resulting := hardCoded
+	resulting.Ports.Inputs = append(Inputs{}, hardCoded.Ports.Inputs...)
+	resulting.Ports.Outputs = append(Outputs{}, hardCoded.Ports.Outputs...)
 
 	resulting.Name = cli.Name
 	resulting.LogLevel = cli.LogLevel
@@ -208,6 +221,10 @@ func MergeNodeConfigs(hardCoded Node, cli Node) (Node, error) {
 	resulting.Orchestration.Presence = hardCoded.Orchestration.Presence
 	resulting.Orchestration.Synchronization = hardCoded.Orchestration.Synchronization
 
+	// The CLI port descriptors can not express every property of the ports, so the missing ones are taken from the hard-coded ports
+	cli.Ports.Inputs = hardCoded.Ports.Inputs.completeWith(cli.Ports.Inputs)
+	cli.Ports.Outputs = hardCoded.Ports.Outputs.completeWith(cli.Ports.Outputs)
+
 	if wouldExtend(resulting, cli) {
 		if resulting.Ports.Configure.Extend {
 			// Add new I/O ports
@@ -231,6 +248,80 @@ func MergeNodeConfigs(hardCoded Node, cli Node) (Node, error) {
 	return resulting, nil
 }
 
+// GetConfigurePortsChannel returns with the name of the control channel
+// through which the I/O ports of the running Node can be reconfigured.
+func (n Node) GetConfigurePortsChannel() string {
+	return n.Orchestration.Channels.ConfigurePorts + "." + n.Name
+}
+
+// ReconfigurePorts returns with a copy of the `n` Node configuration which has its I/O ports
+// modified and/or extended by the `inputs` and `outputs` port descriptors.
+// The empty properties of the port descriptors leave the current values of the ports unchanged.
+// The changes are validated against the `Ports.Configure` flags of the node,
+// and the message-type of an existing port can not be changed.
+// It also returns with the complete descriptors of the input and output ports that have been changed or added.
+func (n Node) ReconfigurePorts(inputs Inputs, outputs Outputs) (Node, Inputs, Outputs, error) {
+	resulting := n
+	resulting.Ports.Inputs = append(Inputs{}, n.Ports.Inputs...)
+	resulting.Ports.Outputs = append(Outputs{}, n.Ports.Outputs...)
+	changedInputs := Inputs{}
+	changedOutputs := Outputs{}
+
+	for _, mod := range inputs {
+		if mod.Name == "" {
+			return n, nil, nil, errors.New("input port name must be defined")
+		}
+		if in, found := resulting.Ports.Inputs.FindByName(mod.Name); found {
+			mod = in.completeWith(mod)
+			if !in.WouldModify(mod) {
+				continue
+			}
+			if !n.Ports.Configure.Modify {
+				return n, nil, nil, errors.New("port modification is disabled")
+			}
+			if mod.Type != in.Type {
+				return n, nil, nil, fmt.Errorf("the message-type of the '%s' input port can not be changed", mod.Name)
+			}
+			in.ModifyWith(mod)
+		} else {
+			if !n.Ports.Configure.Extend {
+				return n, nil, nil, errors.New("port extension is disabled")
+			}
+			mod = In{IO: IO{Type: DefaultType, Representation: DefaultRepresentation}}.completeWith(mod)
+			resulting.Ports.Inputs = append(resulting.Ports.Inputs, mod)
+		}
+		changedInputs = append(changedInputs, mod)
+	}
+
+	for _, mod := range outputs {
+		if mod.Name == "" {
+			return n, nil, nil, errors.New("output port name must be defined")
+		}
+		if out, found := resulting.Ports.Outputs.FindByName(mod.Name); found {
+			mod = out.completeWith(mod)
+			if !out.WouldModify(mod) {
+				continue
+			}
+			if !n.Ports.Configure.Modify {
+				return n, nil, nil, errors.New("port modification is disabled")
+			}
+			if mod.Type != out.Type {
+				return n, nil, nil, fmt.Errorf("the message-type of the '%s' output port can not be changed", mod.Name)
+			}
+			out.ModifyWith(mod)
+		} else {
+			if !n.Ports.Configure.Extend {
+				return n, nil, nil, errors.New("port extension is disabled")
+			}
+			mod = Out{IO: IO{Type: DefaultType, Representation: DefaultRepresentation}}.completeWith(mod)
+			resulting.Ports.Outputs = append(resulting.Ports.Outputs, mod)
+		}
+		changedOutputs = append(changedOutputs, mod)
+	}
+
+	return resulting, changedInputs, changedOutputs, nil
+}
+
 // wouldExtend returns true if the `src` Node has more I/O ports than the `dst` Node
 func wouldExtend(dst Node, src Node) bool {
 	// Check input ports
@@ -156,7 +156,11 @@ func TestMergeNodeConfigs_noExt_Mod_Mod(t *testing.T) {
 
 	resulting, err := MergeNodeConfigs(hardCoded, cli)
 	assert.Nil(t, err)
-	assert.Equal(t, cli, resulting)
+	// The empty default value of the CLI port keeps the hard-coded one
+	expected := cli
+	expected.Ports.Inputs = append(Inputs{}, cliModInputs...)
+	expected.Ports.Inputs[0].Default = hcInputs[0].Default
+	assert.Equal(t, expected, resulting)
 }
 
 func TestMergeNodeConfigs_noExt_Mod_Mod2(t *testing.T) {
@@ -174,5 +178,87 @@ func TestMergeNodeConfigs_overwrite(t *testing.T) {
 
 	resulting, err := MergeNodeConfigs(hardCoded, cli)
 	assert.Nil(t, err)
-	assert.Equal(t, cli, resulting)
+	// The empty default value of the CLI port keeps the hard-coded one
+	expected := cli
+	expected.Ports.Inputs = append(Inputs{}, cliOverwriteInputs...)
+	expected.Ports.Inputs[0].Default = hcInputs[0].Default
+	assert.Equal(t, expected, resulting)
+}
+
+func TestMergeNodeConfigs_keepBuffer(t *testing.T) {
+	inputs := Inputs{In{IO: IO{
+		Name:           "water-level",
+		Type:           "base/Float64",
+		Representation: "application/json",
+		Channel:        "well-water-buffer-tank-level",
+	}, Buffer: Buffer{Policy: BufferQueue, Size: 10}}}
+	hardCoded := makeNode("test-node", "test-node-type", false, false, true, true, inputs, hcOutputs)
+
+	// The port is restated by a `--in` CLI parameter, that has no buffer part
+	cliIn, err := parseIn("water-level|well-water-buffer-tank-level|base/Float64|application/json|")
+	assert.Nil(t, err)
+	cli := makeNode("test-node", "test-node-type", false, false, true, true, Inputs{cliIn}, Outputs{})
+
+	resulting, err := MergeNodeConfigs(hardCoded, cli)
+	assert.Nil(t, err)
+	assert.Equal(t, inputs, resulting.Ports.Inputs)
+}
+
+func TestMergeNodeConfigs_keepFreshness(t *testing.T) {
+	inputs := Inputs{In{IO: IO{
+		Name:           "water-level",
+		Type:           "base/Float64",
+		Representation: "application/json",
+		Channel:        "well-water-buffer-tank-level",
+	}, MaxAge: "30s", OnStale: StaleDefault, Required: true}}
+	hardCoded := makeNode("test-node", "test-node-type", false, false, true, true, inputs, hcOutputs)
+
+	// The `--in` CLI parameters can not express the freshness properties of the port
+	cliIn, err := parseIn("water-level|well-water-buffer-tank-level|base/Float64|application/json||")
+	assert.Nil(t, err)
+	cli := makeNode("test-node", "test-node-type", false, false, true, true, Inputs{cliIn}, Outputs{})
+
+	resulting, err := MergeNodeConfigs(hardCoded, cli)
+	assert.Nil(t, err)
+	assert.Equal(t, inputs, resulting.Ports.Inputs)
+}
+
+func TestMergeNodeConfigs_keepEmission(t *testing.T) {
+	outputs := Outputs{Out{IO: IO{
+		Name:           "water-level",
+		Type:           "base/Float64",
+		Representation: "application/json",
+		Channel:        "water-level",
+	}, Channels: []OutChannel{{Channel: "water-level-yaml", Representation: "application/yaml"}}, Emit: EmitChange, Deadband: 0.5}}
+	hardCoded := makeNode("test-node", "test-node-type", false, false, true, true, hcInputs, outputs)
+
+	// The `--out` CLI parameters can not express the additional channels of the port
+	cliOut, err := parseOut("water-level|water-level|base/Float64|application/json")
+	assert.Nil(t, err)
+	cli := makeNode("test-node", "test-node-type", false, false, true, true, Inputs{}, Outputs{cliOut})
+
+	resulting, err := MergeNodeConfigs(hardCoded, cli)
+	assert.Nil(t, err)
+	assert.Equal(t, outputs, resulting.Ports.Outputs)
+}
+
+func TestMergeNodeConfigs_keepRate(t *testing.T) {
+	outputs := Outputs{Out{IO: IO{
+		Name:           "water-level",
+		Type:           "base/Float64",
+		Representation: "application/json",
+		Channel:        "water-level",
+	}, Rate: RateLimit{Interval: "100ms", Policy: RateAverage}, Batch: Batch{Size: 10}}}
+	hardCoded := makeNode("test-node", "test-node-type", false, true, true, true, hcInputs, outputs)
+
+	// The `--out` CLI parameter overrides the channel only, it can not express the rate limit and the batch of the port
+	cliOut, err := parseOut("water-level|new-water-level|base/Float64|application/json")
+	assert.Nil(t, err)
+	cli := makeNode("test-node", "test-node-type", false, true, true, true, Inputs{}, Outputs{cliOut})
+
+	resulting, err := MergeNodeConfigs(hardCoded, cli)
+	assert.Nil(t, err)
+	expected := append(Outputs{}, outputs...)
+	expected[0].Channel = "new-water-level"
+	assert.Equal(t, expected, resulting.Ports.Outputs)
 }
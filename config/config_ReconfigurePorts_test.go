@@ -0,0 +1,126 @@
+package config
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var rcInputs = Inputs{
+	In{IO: IO{
+		Name:           "reference-water-level",
+		Type:           "base/Float64",
+		Representation: "application/json",
+		Channel:        "",
+	}, Default: `{"Body": {"Data": 0.75}}`},
+	In{IO: IO{
+		Name:           "water-level",
+		Type:           "base/Float64",
+		Representation: "application/json",
+		Channel:        "well-water-buffer-tank-level",
+	}, Default: ""},
+}
+
+var rcOutputs = Outputs{
+	Out{IO: IO{
+		Name:           "water-level-state",
+		Type:           "base/Bool",
+		Representation: "application/json",
+		Channel:        "buffer-water-tank-upper-level-state",
+	}},
+}
+
+// makeReconfigurableNode returns with a new Node that has its own copy of the `rcInputs` and `rcOutputs` ports
+func makeReconfigurableNode(extend bool, modify bool) Node {
+	return makeNode("test-node", "test-node-type", extend, modify, true, true, append(Inputs{}, rcInputs...), append(Outputs{}, rcOutputs...))
+}
+
+func TestReconfigurePorts_noChange(t *testing.T) {
+	current := makeReconfigurableNode(false, false)
+	resulting, changedInputs, changedOutputs, err := current.ReconfigurePorts(Inputs{In{IO: IO{Name: "water-level"}}}, Outputs{})
+	assert.Nil(t, err)
+	assert.Equal(t, current, resulting)
+	assert.Equal(t, 0, len(changedInputs))
+	assert.Equal(t, 0, len(changedOutputs))
+}
+
+func TestReconfigurePorts_Mod(t *testing.T) {
+	current := makeReconfigurableNode(false, true)
+	modInputs := Inputs{In{IO: IO{Name: "water-level", Channel: "new-water-level-ch"}, Default: `{"Body": {"Data": 0.5}}`}}
+	modOutputs := Outputs{Out{IO: IO{Name: "water-level-state", Channel: "new-water-level-state-ch"}}}
+
+	resulting, changedInputs, changedOutputs, err := current.ReconfigurePorts(modInputs, modOutputs)
+	assert.Nil(t, err)
+
+	expectedIn := In{IO: IO{
+		Name:           "water-level",
+		Type:           "base/Float64",
+		Representation: "application/json",
+		Channel:        "new-water-level-ch",
+	}, Default: `{"Body": {"Data": 0.5}}`}
+	expectedOut := Out{IO: IO{
+		Name:           "water-level-state",
+		Type:           "base/Bool",
+		Representation: "application/json",
+		Channel:        "new-water-level-state-ch",
+	}}
+	assert.Equal(t, Inputs{expectedIn}, changedInputs)
+	assert.Equal(t, Outputs{expectedOut}, changedOutputs)
+	assert.Equal(t, Inputs{rcInputs[0], expectedIn}, resulting.Ports.Inputs)
+	assert.Equal(t, Outputs{expectedOut}, resulting.Ports.Outputs)
+
+	// The original configuration must remain untouched
+	assert.Equal(t, rcInputs, current.Ports.Inputs)
+	assert.Equal(t, rcOutputs, current.Ports.Outputs)
+}
+
+func TestReconfigurePorts_noMod_Mod(t *testing.T) {
+	current := makeReconfigurableNode(true, false)
+	modInputs := Inputs{In{IO: IO{Name: "water-level", Channel: "new-water-level-ch"}}}
+
+	resulting, _, _, err := current.ReconfigurePorts(modInputs, Outputs{})
+	assert.NotNil(t, err)
+	assert.Equal(t, "port modification is disabled", err.Error())
+	assert.Equal(t, current, resulting)
+}
+
+func TestReconfigurePorts_Mod_Type(t *testing.T) {
+	current := makeReconfigurableNode(true, true)
+	modOutputs := Outputs{Out{IO: IO{Name: "water-level-state", Type: "base/String"}}}
+
+	resulting, _, _, err := current.ReconfigurePorts(Inputs{}, modOutputs)
+	assert.NotNil(t, err)
+	assert.Equal(t, "the message-type of the 'water-level-state' output port can not be changed", err.Error())
+	assert.Equal(t, current, resulting)
+}
+
+func TestReconfigurePorts_Ext(t *testing.T) {
+	current := makeReconfigurableNode(true, false)
+	extInputs := Inputs{In{IO: IO{Name: "new-input", Channel: "new-input-ch"}}}
+
+	resulting, changedInputs, _, err := current.ReconfigurePorts(extInputs, Outputs{})
+	assert.Nil(t, err)
+
+	expectedIn := In{IO: IO{
+		Name:           "new-input",
+		Type:           DefaultType,
+		Representation: DefaultRepresentation,
+		Channel:        "new-input-ch",
+	}, Default: ""}
+	assert.Equal(t, Inputs{expectedIn}, changedInputs)
+	assert.Equal(t, append(append(Inputs{}, rcInputs...), expectedIn), resulting.Ports.Inputs)
+}
+
+func TestReconfigurePorts_noExt_Ext(t *testing.T) {
+	current := makeReconfigurableNode(false, true)
+	extOutputs := Outputs{Out{IO: IO{Name: "new-output", Channel: "new-output-ch"}}}
+
+	resulting, _, _, err := current.ReconfigurePorts(Inputs{}, extOutputs)
+	assert.NotNil(t, err)
+	assert.Equal(t, "port extension is disabled", err.Error())
+	assert.Equal(t, current, resulting)
+}
+
+func TestGetConfigurePortsChannel(t *testing.T) {
+	node := NewNode("test-node", "test-node-type", true, true, true, true)
+	assert.Equal(t, "configure-ports.test-node", node.GetConfigurePortsChannel())
+}
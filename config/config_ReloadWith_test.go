@@ -0,0 +1,95 @@
+package config
+
+import (
+	"github.com/stretchr/testify/assert"
+	"os"
+	"testing"
+)
+
+func TestReadNodeConfigFromFile(t *testing.T) {
+	cwd, err := os.Getwd()
+	assert.Nil(t, err)
+
+	base := NewNode("base-node", "water-level-sensor-simulator", true, true, true, true)
+	base.AddInputPort("base-input", "base/Float64", "application/json", "base-input-ch", "")
+
+	node, err := ReadNodeConfigFromFile(cwd+"/test-config.yml", base)
+	assert.Nil(t, err)
+
+	// The properties defined by the file overwrite the base ones
+	assert.Equal(t, "well-water-upper-level-sensor-simulator", node.Name)
+	assert.Equal(t, "debug", node.LogLevel)
+	assert.Equal(t, 2, len(node.Ports.Inputs))
+	assert.Equal(t, "reference-water-level", node.Ports.Inputs[0].Name)
+
+	// The properties the file does not define keep the base values
+	assert.Equal(t, "water-level-sensor-simulator", node.Type)
+	assert.Equal(t, "configure-ports", node.Orchestration.Channels.ConfigurePorts)
+}
+
+func TestReadNodeConfigFromFile_missing(t *testing.T) {
+	base := NewNode("base-node", "base-node-type", true, true, true, true)
+	node, err := ReadNodeConfigFromFile("non-existing-config.yml", base)
+	assert.NotNil(t, err)
+	assert.Equal(t, base, node)
+}
+
+func TestReloadWith_live(t *testing.T) {
+	current := makeReconfigurableNode(true, true)
+	next := makeReconfigurableNode(true, true)
+	next.LogLevel = "debug"
+	next.LogFormat = "json"
+	next.Orchestration.Channels.StatusRequest = "new-status-request"
+	next.Ports.Inputs[1].Channel = "new-water-level-ch"
+	next.Ports.Outputs = append(next.Ports.Outputs, Out{IO: IO{
+		Name:           "new-output",
+		Type:           "base/Bool",
+		Representation: "application/json",
+		Channel:        "new-output-ch",
+	}})
+
+	resulting, changedInputs, changedOutputs, restartRequired, err := current.ReloadWith(next)
+	assert.Nil(t, err)
+	assert.Equal(t, next, resulting)
+	assert.Equal(t, Inputs{next.Ports.Inputs[1]}, changedInputs)
+	assert.Equal(t, Outputs{next.Ports.Outputs[1]}, changedOutputs)
+	assert.Equal(t, []string{}, restartRequired)
+}
+
+func TestReloadWith_restartRequired(t *testing.T) {
+	current := makeReconfigurableNode(true, true)
+	next := makeReconfigurableNode(true, true)
+	next.Name = "new-test-node"
+	next.Messenger.Urls = "localhost:4223"
+	next.Orchestration.Channels.SendResults = "new-send-results"
+	next.Ports.Inputs = next.Ports.Inputs[:1]
+	next.Ports.Inputs[0].Required = true
+	next.Trigger = Trigger{Ports: []string{"reference-water-level"}, Throttle: "1s"}
+	next.Ports.Outputs[0].Type = "base/String"
+
+	resulting, changedInputs, changedOutputs, restartRequired, err := current.ReloadWith(next)
+	assert.Nil(t, err)
+	assert.Equal(t, current, resulting)
+	assert.Equal(t, 0, len(changedInputs))
+	assert.Equal(t, 0, len(changedOutputs))
+	assert.Equal(t, []string{
+		"name: 'test-node' -> 'new-test-node'",
+		"messenger.urls: 'localhost:4222' -> 'localhost:4223'",
+		"orchestration.channels.sendResults: 'send-results' -> 'new-send-results'",
+		"trigger: '{Ports:[] Join:[] Throttle: Debounce: Deadband:map[]}' -> '{Ports:[reference-water-level] Join:[] Throttle:1s Debounce: Deadband:map[]}'",
+		"ports.inputs.reference-water-level.required: 'false' -> 'true'",
+		"ports.inputs.water-level: removed",
+		"ports.outputs.water-level-state.type: 'base/Bool' -> 'base/String'",
+	}, restartRequired)
+}
+
+func TestReloadWith_noMod(t *testing.T) {
+	current := makeReconfigurableNode(true, false)
+	next := makeReconfigurableNode(true, false)
+	next.Ports.Inputs[1].Channel = "new-water-level-ch"
+
+	resulting, _, _, _, err := current.ReloadWith(next)
+	assert.NotNil(t, err)
+	assert.Equal(t, "port modification is disabled", err.Error())
+	assert.Equal(t, current, resulting)
+}
@@ -0,0 +1,66 @@
+package config
+
+import (
+	"github.com/stretchr/testify/assert"
+	_ "github.com/tombenke/axon-go-common/msgs/base"
+	"testing"
+)
+
+func makeValidNode() Node {
+	node := NewNode("test-node", "test-node-type", true, true, true, true)
+	node.AddInputPort("water-level", "base/Float64", "application/json", "water-level-ch", `{"Body": {"Data": 0.5}}`)
+	node.AddInputPort("reference", "base/Float64", "application/json", "", "")
+	node.AddOutputPort("water-level-state", "base/Bool", "application/json", "water-level-state-ch")
+	return node
+}
+
+func TestValidate_valid(t *testing.T) {
+	assert.Nil(t, makeValidNode().Validate())
+}
+
+func TestValidate_aggregated(t *testing.T) {
+	node := makeValidNode()
+	node.AddInputPort("water-level", "base/Float64", "application/json", "", "")
+	node.AddInputPort("_RAP", "orchestra/ReceiveAndProcess", "application/json", "", "")
+	node.AddInputPort("unknown", "base/Unknown", "application/json", "", "")
+	node.AddInputPort("wrong-default", "base/Float64", "application/json", "", "wrong default")
+	node.Ports.Inputs = append(node.Ports.Inputs, In{IO: IO{Name: "wrong-buffer", Type: "base/Float64", Representation: "application/json"}, Buffer: Buffer{Policy: BufferQueue}})
+	node.Ports.Inputs = append(node.Ports.Inputs, In{IO: IO{Name: "wrong-max-age", Type: "base/Float64", Representation: "application/json", Channel: "c1"}, MaxAge: "30"})
+	node.Ports.Inputs = append(node.Ports.Inputs, In{IO: IO{Name: "wrong-on-stale", Type: "base/Float64", Representation: "application/json", Channel: "c2"}, OnStale: "drop"})
+	node.Ports.Inputs = append(node.Ports.Inputs, In{IO: IO{Name: "required-parameter", Type: "base/Float64", Representation: "application/json"}, Required: true})
+	node.AddOutputPort("wrong-repr", "base/Bool", "wrong/representation", "")
+	node.AddOutputPort("loop", "base/Float64", "application/json", "water-level-ch")
+	node.Orchestration.Channels.SendResults = ""
+
+	err := node.Validate()
+	assert.NotNil(t, err)
+	errs, ok := err.(ValidationErrors)
+	assert.True(t, ok)
+
+	expected := []string{
+		"the 'water-level' input port is defined more than once",
+		"the '_RAP' input port has a reserved name",
+		"the 'base/Unknown' message type of the 'unknown' input port has not been registered",
+		"wrong default value of the 'wrong-default' input port: invalid character 'w' looking for beginning of value",
+		"wrong buffer of the 'wrong-buffer' input port: the size of the 'queue' buffer must be defined",
+		"wrong '30' max age of the 'wrong-max-age' input port, it must be a positive duration, e.g. '30s'",
+		"unknown 'drop' stale action of the 'wrong-on-stale' input port, it must be 'mark' or 'default'",
+		"the required 'required-parameter' input port must have a channel",
+		"'base/Bool' message-type of the 'wrong-repr' output port does not implement codec for 'wrong/representation' representation format",
+		"the 'water-level-ch' channel of the 'loop' output port is already bound to the 'water-level' port",
+		"the 'sendResults' orchestration channel must be defined",
+	}
+	assert.Equal(t, len(expected), len(errs))
+	for i := range expected {
+		assert.Equal(t, expected[i], errs[i].Error())
+	}
+}
+
+func TestValidate_channelsOfDisabledProtocols(t *testing.T) {
+	node := NewNode("test-node", "test-node-type", true, true, false, false)
+	node.Orchestration.Channels = Channels{ConfigurePorts: "configure-ports"}
+	assert.Nil(t, node.Validate())
+
+	node.Orchestration.Channels.ConfigurePorts = ""
+	assert.Equal(t, "invalid configuration:\n  the 'configurePorts' orchestration channel must be defined", node.Validate().Error())
+}
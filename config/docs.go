@@ -5,12 +5,14 @@ that every node has.
 This package also provides functions to load the config parameters from file,
 parse the CLI parameters, and merge all these parameters into a final, resulting config structure.
 
-* TODO: Implement the validity check of representation types.
+The `Node.Validate()` function checks the resulting configuration before the node starts,
+and reports all the problems found at once, e.g. duplicated or reserved port names,
+unregistered message-types, unsupported representations, malformed default values,
+missing orchestration channels, channels bound to more than one port,
+and trigger rules that refer to unknown ports or have malformed intervals.
 
 * TODO: Implement the generic config file loader (YAML).
 
-* TODO: Implement the validity check of message-types.
-
 * TODO: Implement the merge functions for CLI and file config to resulting config struct.
 
 */
@@ -0,0 +1,81 @@
+package config
+
+import (
+	"fmt"
+	"github.com/tombenke/axon-go-common/msgs"
+	"strconv"
+	"strings"
+)
+
+// The emission modes of the output ports
+const (
+	// EmitSet publishes the output only if the processor set its message in the actual invocation. This is the default mode.
+	EmitSet = "set"
+
+	// EmitAlways publishes the output after every invocation, so its previous message is re-sent if the processor did not set it
+	EmitAlways = "always"
+
+	// EmitChange publishes the output only if the processor set a message that differs from the last published one.
+	// The numeric outputs differ only if their change is beyond the deadband of the port.
+	EmitChange = "change"
+)
+
+// OutChannel is an additional channel an output port publishes its messages to
+type OutChannel struct {
+	// Channel is the name of the channel
+	Channel string `yaml:"channel"`
+
+	// Representation is the format the messages are encoded to. It defaults to the representation of the port.
+	Representation string `yaml:"representation"`
+}
+
+// validateEmission checks the additional channels and the emission mode of the output port
+func (out Out) validateEmission() error {
+	for _, ch := range out.Channels {
+		if ch.Channel == "" {
+			return fmt.Errorf("the additional channels of the '%s' output port must have a name", out.Name)
+		}
+		if ch.Representation != "" && msgs.IsMessageTypeRegistered(out.Type) &&
+			!msgs.DoesMessageTypeImplementsRepresentation(out.Type, msgs.Representation(ch.Representation)) {
+			return fmt.Errorf("'%s' message-type of the '%s' output port does not implement codec for '%s' representation format of the '%s' channel", out.Type, out.Name, ch.Representation, ch.Channel)
+		}
+	}
+
+	switch out.Emit {
+	case "", EmitSet, EmitAlways, EmitChange:
+	default:
+		return fmt.Errorf("unknown '%s' emission mode of the '%s' output port, it must be one of set, always or change", out.Emit, out.Name)
+	}
+
+	if out.Deadband < 0 {
+		return fmt.Errorf("the deadband of the '%s' output port can not be negative", out.Name)
+	}
+	if out.Deadband > 0 {
+		if out.Emit != EmitChange {
+			return fmt.Errorf("the deadband of the '%s' output port can be used only in 'change' emission mode", out.Name)
+		}
+		if !IsNumericType(out.Type) {
+			return fmt.Errorf("the '%s' output port has '%s' message-type that is not numeric, so it can not have deadband", out.Name, out.Type)
+		}
+	}
+	return nil
+}
+
+// parseEmit parses the CLI format of the emission mode: `set`, `always`, `change` or `change:<deadband>`,
+// and sets the emission properties of the output port
+func (out *Out) parseEmit(spec string) error {
+	parts := strings.Split(spec, ":")
+	switch {
+	case len(parts) == 1:
+		out.Emit = spec
+	case len(parts) == 2 && parts[0] == EmitChange:
+		deadband, err := strconv.ParseFloat(parts[1], 64)
+		if err != nil {
+			return fmt.Errorf("wrong '%s' deadband", parts[1])
+		}
+		out.Emit, out.Deadband = EmitChange, deadband
+	default:
+		return fmt.Errorf("wrong '%s' emission format", spec)
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package config
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestValidateEmission(t *testing.T) {
+	node := makeValidNode()
+	node.Ports.Outputs[0].Channels = []OutChannel{{Channel: "water-level-state-msgpack", Representation: "application/msgpack"}, {Channel: "water-level-state-copy"}}
+	node.Ports.Outputs[0].Emit = EmitChange
+	node.AddOutputPort("level", "base/Float64", "application/json", "level-ch")
+	node.Ports.Outputs[1].Emit = EmitChange
+	node.Ports.Outputs[1].Deadband = 0.01
+	assert.Nil(t, node.Validate())
+
+	node.Ports.Outputs = Outputs{
+		Out{IO: IO{Name: "no-name", Type: "base/Bool", Representation: "application/json"}, Channels: []OutChannel{{Representation: "application/json"}}},
+		Out{IO: IO{Name: "wrong-repr", Type: "base/Bool", Representation: "application/json"}, Channels: []OutChannel{{Channel: "c1", Representation: "wrong/representation"}}},
+		Out{IO: IO{Name: "wrong-emit", Type: "base/Bool", Representation: "application/json"}, Emit: "never"},
+		Out{IO: IO{Name: "negative", Type: "base/Float64", Representation: "application/json"}, Emit: EmitChange, Deadband: -1},
+		Out{IO: IO{Name: "not-change", Type: "base/Float64", Representation: "application/json"}, Deadband: 1},
+		Out{IO: IO{Name: "not-numeric", Type: "base/Bool", Representation: "application/json"}, Emit: EmitChange, Deadband: 1},
+		Out{IO: IO{Name: "bound", Type: "base/Bool", Representation: "application/json"}, Channels: []OutChannel{{Channel: "water-level-ch"}}},
+	}
+	err := node.Validate()
+	if assert.IsType(t, ValidationErrors{}, err) {
+		assert.Equal(t, "invalid configuration:\n"+
+			"  the additional channels of the 'no-name' output port must have a name\n"+
+			"  'base/Bool' message-type of the 'wrong-repr' output port does not implement codec for 'wrong/representation' representation format of the 'c1' channel\n"+
+			"  unknown 'never' emission mode of the 'wrong-emit' output port, it must be one of set, always or change\n"+
+			"  the deadband of the 'negative' output port can not be negative\n"+
+			"  the deadband of the 'not-change' output port can be used only in 'change' emission mode\n"+
+			"  the 'not-numeric' output port has 'base/Bool' message-type that is not numeric, so it can not have deadband\n"+
+			"  the 'water-level-ch' channel of the 'bound' output port is already bound to the 'water-level' port", err.Error())
+	}
+}
+
+func TestOutCompleteWithEmission(t *testing.T) {
+	out := Out{IO: IO{Name: "level"}, Channels: []OutChannel{{Channel: "level-copy"}}, Emit: EmitChange, Deadband: 0.5}
+	completed := out.completeWith(Out{IO: IO{Name: "level"}})
+	assert.Equal(t, out.Channels, completed.Channels)
+	assert.Equal(t, EmitChange, completed.Emit)
+	assert.Equal(t, 0.5, completed.Deadband)
+	assert.False(t, out.WouldModify(completed))
+
+	completed = out.completeWith(Out{IO: IO{Name: "level"}, Emit: EmitAlways})
+	assert.Equal(t, EmitAlways, completed.Emit)
+	assert.Equal(t, 0.0, completed.Deadband)
+	assert.True(t, out.WouldModify(completed))
+}
@@ -0,0 +1,44 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+// The actions taken on the message of a stale input port
+const (
+	// StaleMark keeps the message of the stale port, and marks the port stale. This is the default action.
+	StaleMark = "mark"
+
+	// StaleDefault reverts the message of the stale port to its default message
+	StaleDefault = "default"
+)
+
+// MaxAgeDuration returns with the max age of the messages of the input port, or 0 if it is not defined or invalid
+func (in In) MaxAgeDuration() time.Duration {
+	maxAge, err := time.ParseDuration(in.MaxAge)
+	if err != nil {
+		return 0
+	}
+	return maxAge
+}
+
+// validateFreshness checks the `maxAge`, `onStale` and `required` properties of the input port
+func (in In) validateFreshness() error {
+	if in.MaxAge != "" {
+		if maxAge, err := time.ParseDuration(in.MaxAge); err != nil || maxAge <= 0 {
+			return fmt.Errorf("wrong '%s' max age of the '%s' input port, it must be a positive duration, e.g. '30s'", in.MaxAge, in.Name)
+		}
+	}
+
+	switch in.OnStale {
+	case "", StaleMark, StaleDefault:
+	default:
+		return fmt.Errorf("unknown '%s' stale action of the '%s' input port, it must be 'mark' or 'default'", in.OnStale, in.Name)
+	}
+
+	if in.Required && in.Channel == "" {
+		return fmt.Errorf("the required '%s' input port must have a channel", in.Name)
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package config
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestInMaxAgeDuration(t *testing.T) {
+	assert.Equal(t, 30*time.Second, In{MaxAge: "30s"}.MaxAgeDuration())
+	assert.Equal(t, time.Duration(0), In{}.MaxAgeDuration())
+	assert.Equal(t, time.Duration(0), In{MaxAge: "30"}.MaxAgeDuration())
+}
+
+func TestValidateFreshness(t *testing.T) {
+	node := makeValidNode()
+	node.Ports.Inputs[0].MaxAge = "1m30s"
+	node.Ports.Inputs[0].OnStale = StaleDefault
+	node.Ports.Inputs[0].Required = true
+	assert.Nil(t, node.Validate())
+}
+
+func TestInCompleteWithFreshness(t *testing.T) {
+	in := In{IO: IO{Name: "level"}, MaxAge: "30s", OnStale: StaleDefault, Required: true}
+	completed := in.completeWith(In{IO: IO{Name: "level"}})
+	assert.Equal(t, "30s", completed.MaxAge)
+	assert.Equal(t, StaleDefault, completed.OnStale)
+	assert.True(t, completed.Required)
+	assert.False(t, in.WouldModify(completed))
+
+	added := In{IO: IO{Name: "level"}}.completeWith(In{IO: IO{Name: "level"}, Required: true})
+	assert.True(t, added.Required)
+}
+
+func TestReconfigureRequiredPort(t *testing.T) {
+	node := makeValidNode()
+	node.Ports.Inputs[0].Required = true
+	node.Ports.Configure.Modify = false
+
+	resulting, changedInputs, _, err := node.ReconfigurePorts(Inputs{In{IO: IO{Name: "water-level"}}}, Outputs{})
+	assert.Nil(t, err)
+	assert.Empty(t, changedInputs)
+	assert.True(t, resulting.Ports.Inputs[0].Required)
+}
@@ -1,6 +1,8 @@
 package config
 
-import ()
+import (
+	"reflect"
+)
 
 var (
 	// DefaultType is the default message-type for IO ports
@@ -17,10 +19,39 @@ type IO struct {
 	Channel        string
 }
 
+// completeWith returns with a copy of `mod` which has its empty properties filled
+// with the corresponding properties of the `io` port.
+func (io IO) completeWith(mod IO) IO {
+	if mod.Type == "" {
+		mod.Type = io.Type
+	}
+	if mod.Representation == "" {
+		mod.Representation = io.Representation
+	}
+	if mod.Channel == "" {
+		mod.Channel = io.Channel
+	}
+	return mod
+}
+
 // In defines the properties of an input descriptor CLI parameter
 type In struct {
 	IO      `yaml:",inline"`
 	Default string
+	// DeadLetter is the channel where the raw content of the messages
+	// that the port can not decode is forwarded to. Undecodable messages are dropped if it is empty.
+	DeadLetter string `yaml:"deadLetter"`
+	// Buffer is the buffering policy of the messages received between two processing cycles.
+	// The port keeps only the latest message if it is not defined.
+	Buffer Buffer `yaml:"buffer"`
+	// MaxAge is the duration, e.g. `30s`, after the last message received when the port becomes stale.
+	// The port never becomes stale if it is empty.
+	MaxAge string `yaml:"maxAge"`
+	// OnStale tells what happens to the message of a stale port: it is kept and marked stale (`mark`, the default),
+	// or it reverts to the default message of the port (`default`).
+	OnStale string `yaml:"onStale"`
+	// Required suppresses the processing until the port has received a message that is not stale
+	Required bool `yaml:"required"`
 }
 
 // WouldModify returns true if the modifiable properties of the `in` input
@@ -29,7 +60,12 @@ func (in In) WouldModify(mod In) bool {
 	if in.Type == mod.Type &&
 		in.Representation == mod.Representation &&
 		in.Channel == mod.Channel &&
-		in.Default == mod.Default {
+		in.Default == mod.Default &&
+		in.DeadLetter == mod.DeadLetter &&
+		in.Buffer == mod.Buffer &&
+		in.MaxAge == mod.MaxAge &&
+		in.OnStale == mod.OnStale &&
+		in.Required == mod.Required {
 
 		return false
 	}
@@ -43,6 +79,35 @@ func (in *In) ModifyWith(mod In) {
 	(*in).Representation = mod.Representation
 	(*in).Channel = mod.Channel
 	(*in).Default = mod.Default
+	(*in).DeadLetter = mod.DeadLetter
+	(*in).Buffer = mod.Buffer
+	(*in).MaxAge = mod.MaxAge
+	(*in).OnStale = mod.OnStale
+	(*in).Required = mod.Required
+}
+
+// completeWith returns with a copy of `mod` which has its empty properties filled
+// with the corresponding properties of the `in` input.
+func (in In) completeWith(mod In) In {
+	mod.IO = in.IO.completeWith(mod.IO)
+	if mod.Default == "" {
+		mod.Default = in.Default
+	}
+	if mod.DeadLetter == "" {
+		mod.DeadLetter = in.DeadLetter
+	}
+	if mod.Buffer == (Buffer{}) {
+		mod.Buffer = in.Buffer
+	}
+	if mod.MaxAge == "" {
+		mod.MaxAge = in.MaxAge
+	}
+	if mod.OnStale == "" {
+		mod.OnStale = in.OnStale
+	}
+	// The port descriptors of the `configure-ports` requests can not express an unset `Required`, so it is kept
+	mod.Required = mod.Required || in.Required
+	return mod
 }
 
 // Inputs is an array of the input CLI parameters
@@ -78,9 +143,32 @@ func (inputs Inputs) ModifyWith(mod Inputs) {
 	}
 }
 
+// completeWith returns with a copy of the `mod` inputs, which have their empty properties filled
+// with the corresponding properties of the inputs that have the same `Name`.
+func (inputs Inputs) completeWith(mod Inputs) Inputs {
+	completed := Inputs{}
+	for _, m := range mod {
+		if i, found := inputs.FindByName(m.Name); found {
+			m = i.completeWith(m)
+		}
+		completed = append(completed, m)
+	}
+	return completed
+}
+
 // Out defines the properties of an output descriptor CLI parameter
 type Out struct {
 	IO `yaml:",inline"`
+	// Channels are the additional channels the messages of the port are published to, each with its own representation
+	Channels []OutChannel `yaml:"channels"`
+	// Emit is the emission mode of the port: `set` (the default), `always` or `change`
+	Emit string `yaml:"emit"`
+	// Deadband is the minimum change of the numeric messages that are published in `change` emission mode
+	Deadband float64 `yaml:"deadband"`
+	// Rate limits the number of messages published by the port
+	Rate RateLimit `yaml:"rate"`
+	// Batch collects the messages of the port, and publishes them together in one array message
+	Batch Batch `yaml:"batch"`
 }
 
 // WouldModify returns true if the modifiable properties of the `out` output
@@ -88,7 +176,12 @@ type Out struct {
 func (out Out) WouldModify(mod Out) bool {
 	if out.Type == mod.Type &&
 		out.Representation == mod.Representation &&
-		out.Channel == mod.Channel {
+		out.Channel == mod.Channel &&
+		reflect.DeepEqual(out.Channels, mod.Channels) &&
+		out.Emit == mod.Emit &&
+		out.Deadband == mod.Deadband &&
+		out.Rate == mod.Rate &&
+		out.Batch == mod.Batch {
 
 		return false
 	}
@@ -101,6 +194,33 @@ func (out *Out) ModifyWith(mod Out) {
 	(*out).Type = mod.Type
 	(*out).Representation = mod.Representation
 	(*out).Channel = mod.Channel
+	(*out).Channels = mod.Channels
+	(*out).Emit = mod.Emit
+	(*out).Deadband = mod.Deadband
+	(*out).Rate = mod.Rate
+	(*out).Batch = mod.Batch
+}
+
+// completeWith returns with a copy of `mod` which has its empty properties filled
+// with the corresponding properties of the `out` output.
+func (out Out) completeWith(mod Out) Out {
+	mod.IO = out.IO.completeWith(mod.IO)
+	if len(mod.Channels) == 0 {
+		mod.Channels = out.Channels
+	}
+	if mod.Emit == "" {
+		mod.Emit = out.Emit
+		if mod.Deadband == 0 {
+			mod.Deadband = out.Deadband
+		}
+	}
+	if !mod.Rate.IsDefined() {
+		mod.Rate = out.Rate
+	}
+	if !mod.Batch.IsDefined() {
+		mod.Batch = out.Batch
+	}
+	return mod
 }
 
 // Outputs is an array of the output CLI parameters
@@ -135,3 +255,16 @@ func (outputs Outputs) ModifyWith(mod Outputs) {
 		}
 	}
 }
+
+// completeWith returns with a copy of the `mod` outputs, which have their empty properties filled
+// with the corresponding properties of the outputs that have the same `Name`.
+func (outputs Outputs) completeWith(mod Outputs) Outputs {
+	completed := Outputs{}
+	for _, m := range mod {
+		if o, found := outputs.FindByName(m.Name); found {
+			m = o.completeWith(m)
+		}
+		completed = append(completed, m)
+	}
+	return completed
+}
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"errors"
 	"strings"
 )
 
@@ -11,7 +12,10 @@ func (i *Inputs) String() string {
 
 // Set appends a new In CLI parameter to the inputs array, or overwrites if yet exists with the same name
 func (i *Inputs) Set(value string) error {
-	newIn := parseIn(value)
+	newIn, err := parseIn(value)
+	if err != nil {
+		return err
+	}
 	if in, found := (*i).FindByName(newIn.Name); found {
 		*in = newIn
 		return nil
@@ -20,8 +24,9 @@ func (i *Inputs) Set(value string) error {
 	return nil
 }
 
-// parseIn parses the input CLI parameter and returns with an `In` object build from the parse results
-func parseIn(inStr string) (result In) {
+// parseIn parses the input CLI parameter and returns with an `In` object build from the parse results,
+// or with an error if the parameter is malformed.
+func parseIn(inStr string) (result In, err error) {
 	parts := strings.Split(inStr, "|")
 
 	switch len(parts) {
@@ -31,12 +36,20 @@ func parseIn(inStr string) (result In) {
 		result = In{IO: IO{Name: parts[0], Channel: parts[1], Type: DefaultType, Representation: DefaultRepresentation}, Default: ""}
 	case 5:
 		result = In{IO: IO{Name: parts[0], Channel: parts[1], Type: parts[2], Representation: parts[3]}, Default: parts[4]}
+	case 6:
+		result = In{IO: IO{Name: parts[0], Channel: parts[1], Type: parts[2], Representation: parts[3]}, Default: parts[4], DeadLetter: parts[5]}
+	case 7:
+		buffer, err := ParseBuffer(parts[6])
+		if err != nil {
+			return result, err
+		}
+		result = In{IO: IO{Name: parts[0], Channel: parts[1], Type: parts[2], Representation: parts[3]}, Default: parts[4], DeadLetter: parts[5], Buffer: buffer}
 	default:
-		panic("Wrong number of input port parameters")
+		return result, errors.New("wrong number of input port parameters")
 	}
 
 	if result.Name == "" {
-		panic("Input port name must be defined!")
+		return result, errors.New("input port name must be defined")
 	}
 
 	if result.Type == "" {
@@ -47,7 +60,7 @@ func parseIn(inStr string) (result In) {
 		result.Representation = DefaultRepresentation
 	}
 
-	return result
+	return result, nil
 }
 
 // String is a dummy implementation of the function
@@ -57,7 +70,10 @@ func (o *Outputs) String() string {
 
 // Set appends a new out CLI parameter to the outputs array
 func (o *Outputs) Set(value string) error {
-	newOut := parseOut(value)
+	newOut, err := parseOut(value)
+	if err != nil {
+		return err
+	}
 	if out, found := (*o).FindByName(newOut.Name); found {
 		*out = newOut
 		return nil
@@ -66,8 +82,9 @@ func (o *Outputs) Set(value string) error {
 	return nil
 }
 
-// parseOut parses the output CLI parameter and returns with an `Out` object build from the parse results
-func parseOut(inStr string) (result Out) {
+// parseOut parses the output CLI parameter and returns with an `Out` object build from the parse results,
+// or with an error if the parameter is malformed.
+func parseOut(inStr string) (result Out, err error) {
 	parts := strings.Split(inStr, "|")
 
 	switch len(parts) {
@@ -77,12 +94,17 @@ func parseOut(inStr string) (result Out) {
 		result = Out{IO: IO{Name: parts[0], Channel: parts[1], Type: DefaultType, Representation: DefaultRepresentation}}
 	case 4:
 		result = Out{IO: IO{Name: parts[0], Channel: parts[1], Type: parts[2], Representation: parts[3]}}
+	case 5:
+		result = Out{IO: IO{Name: parts[0], Channel: parts[1], Type: parts[2], Representation: parts[3]}}
+		if err := result.parseEmit(parts[4]); err != nil {
+			return result, err
+		}
 	default:
-		panic("Wrong number of output port parameters")
+		return result, errors.New("wrong number of output port parameters")
 	}
 
 	if result.Name == "" {
-		panic("Output port name must be defined!")
+		return result, errors.New("output port name must be defined")
 	}
 
 	if result.Type == "" {
@@ -93,5 +115,5 @@ func parseOut(inStr string) (result Out) {
 		result.Representation = DefaultRepresentation
 	}
 
-	return result
+	return result, nil
 }
@@ -7,14 +7,15 @@ import (
 
 // invalid input strings
 var invalidIns []string = []string{
-	"",              // no name string
-	"|",             // empty name string
-	"||",            // empty name string
-	"|channel||",    // empty name string
-	"||0.1",         // empty name string
-	"|channel||0.1", // empty name string
-	"name||||||",    // Wrong number of arguments
-	"name|||",       // Wrong number of arguments
+	"",                  // no name string
+	"|",                 // empty name string
+	"||",                // empty name string
+	"|channel||",        // empty name string
+	"||0.1",             // empty name string
+	"|channel||0.1",     // empty name string
+	"name|||||||",       // Wrong number of arguments
+	"name|||",           // Wrong number of arguments
+	"name||||||queue:x", // Wrong buffer
 }
 
 type validIn struct {
@@ -23,12 +24,14 @@ type validIn struct {
 }
 
 var validIns []validIn = []validIn{
-	validIn{"name", In{IO{"name", DefaultType, DefaultRepresentation, ""}, ""}},                                                 // name only
-	validIn{"name||||0.1", In{IO{"name", DefaultType, DefaultRepresentation, ""}, "0.1"}},                                       // name and default value
-	validIn{"name||||0.1", In{IO{"name", DefaultType, DefaultRepresentation, ""}, "0.1"}},                                       // name and default value
-	validIn{"name|channel|||", In{IO{"name", DefaultType, DefaultRepresentation, "channel"}, ""}},                               // channel and name
-	validIn{"name|channel|||false", In{IO{"name", DefaultType, DefaultRepresentation, "channel"}, "false"}},                     // channel and name
-	validIn{"name|channel|base/Bool|application/json|true", In{IO{"name", "base/Bool", "application/json", "channel"}, "true"}}, // full
+	validIn{"name", In{IO{"name", DefaultType, DefaultRepresentation, ""}, "", "", Buffer{}, "", "", false}},                                                                                              // name only
+	validIn{"name||||0.1", In{IO{"name", DefaultType, DefaultRepresentation, ""}, "0.1", "", Buffer{}, "", "", false}},                                                                                    // name and default value
+	validIn{"name||||0.1", In{IO{"name", DefaultType, DefaultRepresentation, ""}, "0.1", "", Buffer{}, "", "", false}},                                                                                    // name and default value
+	validIn{"name|channel|||", In{IO{"name", DefaultType, DefaultRepresentation, "channel"}, "", "", Buffer{}, "", "", false}},                                                                            // channel and name
+	validIn{"name|channel|||false", In{IO{"name", DefaultType, DefaultRepresentation, "channel"}, "false", "", Buffer{}, "", "", false}},                                                                  // channel and name
+	validIn{"name|channel|base/Bool|application/json|true", In{IO{"name", "base/Bool", "application/json", "channel"}, "true", "", Buffer{}, "", "", false}},                                              // full
+	validIn{"name|channel|base/Bool|application/json|true|dead", In{IO{"name", "base/Bool", "application/json", "channel"}, "true", "dead", Buffer{}, "", "", false}},                                     // full with dead-letter channel
+	validIn{"name|channel|base/Float64|application/json||dead|queue:10", In{IO{"name", "base/Float64", "application/json", "channel"}, "", "dead", Buffer{Policy: BufferQueue, Size: 10}, "", "", false}}, // full with buffer
 }
 
 // Test input args
@@ -37,17 +40,15 @@ func TestParseInArgs(t *testing.T) {
 
 	// Test valid cases
 	for _, i := range validIns {
-		assert.Equal(i.Expected, parseIn(i.Arg))
+		in, err := parseIn(i.Arg)
+		assert.Nil(err)
+		assert.Equal(i.Expected, in)
 	}
 
 	// Test invalid cases
 	for _, i := range invalidIns {
-		assert.Panics(
-			func() {
-				parseIn(i)
-			},
-			"It should panic!",
-		)
+		_, err := parseIn(i)
+		assert.NotNil(err, "It should fail!")
 	}
 }
 
@@ -58,6 +59,8 @@ var invalidOuts []string = []string{
 	"|channel",  // empty name string
 	"name||",    // Wrong number of arguments
 	"name|||||", // Wrong number of arguments
+	"name||||change:x",
+	"name||||set:1",
 }
 
 type validOut struct {
@@ -66,9 +69,11 @@ type validOut struct {
 }
 
 var validOuts []validOut = []validOut{
-	validOut{"name", Out{IO{"name", DefaultType, DefaultRepresentation, ""}}},
-	validOut{"name|", Out{IO{"name", DefaultType, DefaultRepresentation, ""}}},
-	validOut{"name|channel|base/Bool|application/json", Out{IO{"name", "base/Bool", "application/json", "channel"}}},
+	validOut{"name", Out{IO: IO{"name", DefaultType, DefaultRepresentation, ""}}},
+	validOut{"name|", Out{IO: IO{"name", DefaultType, DefaultRepresentation, ""}}},
+	validOut{"name|channel|base/Bool|application/json", Out{IO: IO{"name", "base/Bool", "application/json", "channel"}}},
+	validOut{"name|channel|base/Bool|application/json|always", Out{IO: IO{"name", "base/Bool", "application/json", "channel"}, Emit: EmitAlways}},
+	validOut{"name|channel|base/Float64||change:0.5", Out{IO: IO{"name", "base/Float64", DefaultRepresentation, "channel"}, Emit: EmitChange, Deadband: 0.5}},
 }
 
 // Test output args
@@ -77,17 +82,15 @@ func TestParseOutArgs(t *testing.T) {
 
 	// Test valid cases
 	for _, i := range validOuts {
-		assert.Equal(parseOut(i.Arg), i.Expected)
+		out, err := parseOut(i.Arg)
+		assert.Nil(err)
+		assert.Equal(i.Expected, out)
 	}
 
 	// Test invalid cases
 	for _, i := range invalidOuts {
-		assert.Panics(
-			func() {
-				parseOut(i)
-			},
-			"It should panic!",
-		)
+		_, err := parseOut(i)
+		assert.NotNil(err, "It should fail!")
 	}
 }
 
@@ -99,9 +102,9 @@ func TestSetIn(t *testing.T) {
 	assert.Nil(t, inputs.Set(`name3|channel3|base/Float|application/json|{"Body":{"Data":42.}}`))
 
 	expected := Inputs{
-		In{IO{"name", "base/Bytes", "text/plain", "channelx"}, ""},
-		In{IO{"name2", "base/Any", "application/json", "channel2"}, "{}"},
-		In{IO{"name3", "base/Float", "application/json", "channel3"}, `{"Body":{"Data":42.}}`},
+		In{IO{"name", "base/Bytes", "text/plain", "channelx"}, "", "", Buffer{}, "", "", false},
+		In{IO{"name2", "base/Any", "application/json", "channel2"}, "{}", "", Buffer{}, "", "", false},
+		In{IO{"name3", "base/Float", "application/json", "channel3"}, `{"Body":{"Data":42.}}`, "", Buffer{}, "", "", false},
 	}
 	assert.Equal(t, expected, *inputs)
 }
@@ -114,9 +117,9 @@ func TestSetOut(t *testing.T) {
 	assert.Nil(t, outputs.Set("name3|channel3|base/Float|application/json"))
 
 	expected := Outputs{
-		Out{IO{"name", "base/Bytes", "text/plain", "channelx"}},
-		Out{IO{"name2", "base/Any", "application/json", "channel2"}},
-		Out{IO{"name3", "base/Float", "application/json", "channel3"}},
+		Out{IO: IO{"name", "base/Bytes", "text/plain", "channelx"}},
+		Out{IO: IO{"name2", "base/Any", "application/json", "channel2"}},
+		Out{IO: IO{"name3", "base/Float", "application/json", "channel3"}},
 	}
 	assert.Equal(t, expected, *outputs)
 }
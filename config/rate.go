@@ -0,0 +1,144 @@
+package config
+
+import (
+	"fmt"
+	"github.com/tombenke/axon-go-common/msgs"
+	"github.com/tombenke/axon-go-common/msgs/base"
+	"time"
+)
+
+// The rate limiting policies of the output ports
+const (
+	// RateDrop publishes the first message of each interval, and drops the others set within the interval. This is the default policy.
+	RateDrop = "drop"
+
+	// RateLatest collects the messages set within the interval, and publishes the latest one at the end of the interval
+	RateLatest = "latest"
+
+	// RateAverage collects the numeric messages set within the interval, and publishes their mean at the end of the interval
+	RateAverage = "average"
+)
+
+// BatchTypes holds the array message-types the messages of the batchable message-types are batched into.
+// The key is the message-type of the port.
+var BatchTypes = map[string]string{
+	base.Float64TypeName: base.Float64ArrayTypeName,
+	base.Int64TypeName:   base.Int64ArrayTypeName,
+	base.BoolTypeName:    base.BoolArrayTypeName,
+	base.StringTypeName:  base.StringArrayTypeName,
+}
+
+// RateLimit limits the number of messages an output port publishes
+type RateLimit struct {
+	// Interval is the minimum interval between two messages published, e.g. `100ms`
+	Interval string `yaml:"interval"`
+
+	// Policy is the rate limiting policy: `drop`, `latest` or `average`. The empty string means `drop`.
+	Policy string `yaml:"policy"`
+}
+
+// IsDefined returns true if the rate of the port is limited
+func (r RateLimit) IsDefined() bool {
+	return r.Interval != ""
+}
+
+// IntervalDuration returns with the rate limiting interval, or 0 if it is not defined or invalid
+func (r RateLimit) IntervalDuration() time.Duration {
+	interval, err := time.ParseDuration(r.Interval)
+	if err != nil {
+		return 0
+	}
+	return interval
+}
+
+// Validate checks if the rate limit can be used with an output port of `portType` message-type
+func (r RateLimit) Validate(portType string) error {
+	if !r.IsDefined() {
+		if r.Policy != "" {
+			return fmt.Errorf("the interval of the rate limit must be defined")
+		}
+		return nil
+	}
+	if interval, err := time.ParseDuration(r.Interval); err != nil || interval <= 0 {
+		return fmt.Errorf("wrong '%s' rate limit interval, it must be a positive duration, e.g. '100ms'", r.Interval)
+	}
+
+	switch r.Policy {
+	case "", RateDrop, RateLatest:
+	case RateAverage:
+		if !IsNumericType(portType) {
+			return fmt.Errorf("the '%s' message-type can not be averaged", portType)
+		}
+	default:
+		return fmt.Errorf("unknown '%s' rate limiting policy, it must be one of drop, latest or average", r.Policy)
+	}
+	return nil
+}
+
+// Batch collects the messages of an output port, and publishes them together in one array message
+type Batch struct {
+	// Size is the number of messages that are published together
+	Size int `yaml:"size"`
+
+	// Interval is the period, e.g. `1s`, the messages collected within are published together
+	Interval string `yaml:"interval"`
+}
+
+// IsDefined returns true if the messages of the port are batched
+func (b Batch) IsDefined() bool {
+	return b.Size != 0 || b.Interval != ""
+}
+
+// IntervalDuration returns with the batching period, or 0 if it is not defined or invalid
+func (b Batch) IntervalDuration() time.Duration {
+	interval, err := time.ParseDuration(b.Interval)
+	if err != nil {
+		return 0
+	}
+	return interval
+}
+
+// Validate checks if the messages of an output port of `portType` message-type can be batched
+// and encoded in the `representations` formats
+func (b Batch) Validate(portType string, representations ...string) error {
+	if !b.IsDefined() {
+		return nil
+	}
+	if b.Size < 0 {
+		return fmt.Errorf("the size of the batch can not be negative")
+	}
+	if b.Interval != "" {
+		if interval, err := time.ParseDuration(b.Interval); err != nil || interval <= 0 {
+			return fmt.Errorf("wrong '%s' batch interval, it must be a positive duration, e.g. '1s'", b.Interval)
+		}
+	}
+
+	batchType, ok := BatchTypes[portType]
+	if !ok {
+		return fmt.Errorf("the '%s' message-type can not be batched", portType)
+	}
+	for _, representation := range representations {
+		if !msgs.DoesMessageTypeImplementsRepresentation(batchType, msgs.Representation(representation)) {
+			return fmt.Errorf("the '%s' batch message-type does not implement codec for '%s' representation format", batchType, representation)
+		}
+	}
+	return nil
+}
+
+// validateRate checks the rate limit and the batching of the output port
+func (out Out) validateRate() error {
+	if err := out.Rate.Validate(out.Type); err != nil {
+		return fmt.Errorf("wrong rate limit of the '%s' output port: %w", out.Name, err)
+	}
+
+	representations := []string{out.Representation}
+	for _, ch := range out.Channels {
+		if ch.Representation != "" {
+			representations = append(representations, ch.Representation)
+		}
+	}
+	if err := out.Batch.Validate(out.Type, representations...); err != nil {
+		return fmt.Errorf("wrong batch of the '%s' output port: %w", out.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package config
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestRateLimitValidate(t *testing.T) {
+	assert.Nil(t, RateLimit{}.Validate("base/String"))
+	assert.Nil(t, RateLimit{Interval: "100ms"}.Validate("base/String"))
+	assert.Nil(t, RateLimit{Interval: "100ms", Policy: RateLatest}.Validate("base/String"))
+	assert.Nil(t, RateLimit{Interval: "100ms", Policy: RateAverage}.Validate("base/Int64"))
+
+	assert.Equal(t, "the interval of the rate limit must be defined", RateLimit{Policy: RateLatest}.Validate("base/Float64").Error())
+	assert.Equal(t, "wrong '100' rate limit interval, it must be a positive duration, e.g. '100ms'", RateLimit{Interval: "100"}.Validate("base/Float64").Error())
+	assert.Equal(t, "the 'base/String' message-type can not be averaged", RateLimit{Interval: "1s", Policy: RateAverage}.Validate("base/String").Error())
+	assert.Equal(t, "unknown 'skip' rate limiting policy, it must be one of drop, latest or average", RateLimit{Interval: "1s", Policy: "skip"}.Validate("base/Float64").Error())
+}
+
+func TestBatchValidate(t *testing.T) {
+	assert.Nil(t, Batch{}.Validate("base/Any"))
+	assert.Nil(t, Batch{Size: 10}.Validate("base/Float64", "application/json", "application/yaml"))
+	assert.Nil(t, Batch{Interval: "1s"}.Validate("base/String", "application/json"))
+
+	assert.Equal(t, "the size of the batch can not be negative", Batch{Size: -1}.Validate("base/Float64").Error())
+	assert.Equal(t, "wrong '1' batch interval, it must be a positive duration, e.g. '1s'", Batch{Interval: "1"}.Validate("base/Float64").Error())
+	assert.Equal(t, "the 'base/Any' message-type can not be batched", Batch{Size: 10}.Validate("base/Any").Error())
+	assert.Equal(t, "the 'base/BoolArray' batch message-type does not implement codec for 'application/x-ros1' representation format", Batch{Size: 10}.Validate("base/Bool", "application/x-ros1").Error())
+}
+
+func TestRateAndBatchIntervalDuration(t *testing.T) {
+	assert.Equal(t, 100*time.Millisecond, RateLimit{Interval: "100ms"}.IntervalDuration())
+	assert.Equal(t, time.Duration(0), RateLimit{}.IntervalDuration())
+	assert.Equal(t, time.Second, Batch{Interval: "1s"}.IntervalDuration())
+	assert.Equal(t, time.Duration(0), Batch{Size: 10}.IntervalDuration())
+}
+
+func TestValidateRate(t *testing.T) {
+	node := makeValidNode()
+	node.AddOutputPort("level", "base/Float64", "application/json", "level-ch")
+	node.Ports.Outputs[1].Rate = RateLimit{Interval: "100ms", Policy: RateAverage}
+	node.Ports.Outputs[1].Batch = Batch{Size: 10, Interval: "1s"}
+	assert.Nil(t, node.Validate())
+
+	node.Ports.Outputs[0].Rate = RateLimit{Interval: "100ms", Policy: RateAverage}
+	node.Ports.Outputs[1].Batch = Batch{Size: -1}
+	err := node.Validate()
+	if assert.IsType(t, ValidationErrors{}, err) {
+		assert.Equal(t, "invalid configuration:\n"+
+			"  wrong rate limit of the 'water-level-state' output port: the 'base/Bool' message-type can not be averaged\n"+
+			"  wrong batch of the 'level' output port: the size of the batch can not be negative", err.Error())
+	}
+}
+
+func TestOutCompleteWithRate(t *testing.T) {
+	out := Out{IO: IO{Name: "level"}, Rate: RateLimit{Interval: "100ms"}, Batch: Batch{Size: 10}}
+	completed := out.completeWith(Out{IO: IO{Name: "level"}})
+	assert.Equal(t, out.Rate, completed.Rate)
+	assert.Equal(t, out.Batch, completed.Batch)
+	assert.False(t, out.WouldModify(completed))
+
+	completed = out.completeWith(Out{IO: IO{Name: "level"}, Batch: Batch{Interval: "1s"}})
+	assert.Equal(t, Batch{Interval: "1s"}, completed.Batch)
+	assert.True(t, out.WouldModify(completed))
+}
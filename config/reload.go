@@ -0,0 +1,140 @@
+package config
+
+import (
+	"fmt"
+	"github.com/tombenke/axon-go-common/file"
+	"gopkg.in/yaml.v2"
+	"reflect"
+)
+
+// nodeConfigFile is the structure of the config file that holds the node configuration under the `node` property
+type nodeConfigFile struct {
+	Node Node `yaml:"node"`
+}
+
+// ReadNodeConfigFromFile reads the `node` section of the YAML format config file identified by `path`.
+// The properties that the file defines overwrite the properties of the `base` configuration,
+// the others keep their values.
+func ReadNodeConfigFromFile(path string, base Node) (Node, error) {
+	content, err := file.LoadFile(path)
+	if err != nil {
+		return base, err
+	}
+
+	c := nodeConfigFile{Node: base}
+	if err := yaml.Unmarshal(content, &c); err != nil {
+		return base, err
+	}
+	return c.Node, nil
+}
+
+// ReloadNodeConfig re-reads the config file of the `current` configuration,
+// and merges it with the `hardCoded` configuration through `MergeNodeConfigs`.
+// It returns with the resulting configuration of the node, that still refers to the same config file.
+func ReloadNodeConfig(hardCoded Node, current Node) (Node, error) {
+	fromFile, err := ReadNodeConfigFromFile(current.ConfigFileName, current)
+	if err != nil {
+		return current, err
+	}
+
+	resulting, err := MergeNodeConfigs(hardCoded, fromFile)
+	if err != nil {
+		return current, err
+	}
+
+	// Keep on using the config file the node has been started with
+	resulting.ConfigFileName = current.ConfigFileName
+	return resulting, nil
+}
+
+// ReloadWith returns with a copy of the `n` Node configuration, which has those properties of the `next`
+// configuration applied, that can be changed while the node is running: the log level and format,
+// the specs URL, the status channels of the orchestration, the channel, representation and default value of the ports,
+// and the new ports.
+// It also returns with the complete descriptors of the input and output ports that have been changed or added,
+// and with the descriptions of the changes that can only be applied by restarting the node.
+func (n Node) ReloadWith(next Node) (Node, Inputs, Outputs, []string, error) {
+	restartRequired := []string{}
+	requireRestart := func(property string, from interface{}, to interface{}) {
+		if from != to {
+			restartRequired = append(restartRequired, fmt.Sprintf("%s: '%v' -> '%v'", property, from, to))
+		}
+	}
+
+	requireRestart("name", n.Name, next.Name)
+	requireRestart("configFileName", n.ConfigFileName, next.ConfigFileName)
+	requireRestart("messenger.urls", n.Messenger.Urls, next.Messenger.Urls)
+	requireRestart("messenger.credentials", n.Messenger.UserCreds, next.Messenger.UserCreds)
+	requireRestart("messenger.clusterID", n.Messenger.ClusterID, next.Messenger.ClusterID)
+	requireRestart("orchestration.presence", n.Orchestration.Presence, next.Orchestration.Presence)
+	requireRestart("orchestration.synchronization", n.Orchestration.Synchronization, next.Orchestration.Synchronization)
+
+	current, nextChannels := n.Orchestration.Channels, next.Orchestration.Channels
+	requireRestart("orchestration.channels.sendResults", current.SendResults, nextChannels.SendResults)
+	requireRestart("orchestration.channels.sendingCompleted", current.SendingCompleted, nextChannels.SendingCompleted)
+	requireRestart("orchestration.channels.receiveAndProcess", current.ReceiveAndProcess, nextChannels.ReceiveAndProcess)
+	requireRestart("orchestration.channels.processingCompleted", current.ProcessingCompleted, nextChannels.ProcessingCompleted)
+	requireRestart("orchestration.channels.configurePorts", current.ConfigurePorts, nextChannels.ConfigurePorts)
+
+	// The trigger rules are used by the receiver that is started with the node
+	if !reflect.DeepEqual(n.Trigger, next.Trigger) {
+		restartRequired = append(restartRequired, fmt.Sprintf("trigger: '%+v' -> '%+v'", n.Trigger, next.Trigger))
+	}
+
+	// Collect the port changes that can be applied live
+	inputs := Inputs{}
+	for _, in := range next.Ports.Inputs {
+		if currentIn, found := n.Ports.Inputs.FindByName(in.Name); found {
+			property := fmt.Sprintf("ports.inputs.%s", in.Name)
+			requireRestart(property+".type", currentIn.Type, in.Type)
+			if in.Channel == "" {
+				requireRestart(property+".channel", currentIn.Channel, in.Channel)
+			}
+			requireRestart(property+".required", currentIn.Required, in.Required)
+			in.Required = currentIn.Required
+			if currentIn.Type != in.Type {
+				continue
+			}
+		}
+		inputs = append(inputs, in)
+	}
+	for _, in := range n.Ports.Inputs {
+		if _, found := next.Ports.Inputs.FindByName(in.Name); !found {
+			restartRequired = append(restartRequired, fmt.Sprintf("ports.inputs.%s: removed", in.Name))
+		}
+	}
+
+	outputs := Outputs{}
+	for _, out := range next.Ports.Outputs {
+		if currentOut, found := n.Ports.Outputs.FindByName(out.Name); found {
+			property := fmt.Sprintf("ports.outputs.%s", out.Name)
+			requireRestart(property+".type", currentOut.Type, out.Type)
+			if out.Channel == "" {
+				requireRestart(property+".channel", currentOut.Channel, out.Channel)
+			}
+			if currentOut.Type != out.Type {
+				continue
+			}
+		}
+		outputs = append(outputs, out)
+	}
+	for _, out := range n.Ports.Outputs {
+		if _, found := next.Ports.Outputs.FindByName(out.Name); !found {
+			restartRequired = append(restartRequired, fmt.Sprintf("ports.outputs.%s: removed", out.Name))
+		}
+	}
+
+	resulting, changedInputs, changedOutputs, err := n.ReconfigurePorts(inputs, outputs)
+	if err != nil {
+		return n, nil, nil, nil, err
+	}
+
+	// Apply the rest of the live properties
+	resulting.LogLevel = next.LogLevel
+	resulting.LogFormat = next.LogFormat
+	resulting.SpecsURL = next.SpecsURL
+	resulting.Orchestration.Channels.StatusRequest = nextChannels.StatusRequest
+	resulting.Orchestration.Channels.StatusReport = nextChannels.StatusReport
+
+	return resulting, changedInputs, changedOutputs, restartRequired, nil
+}
@@ -0,0 +1,103 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Trigger holds the firing rules that decide when the processor is called by the receiver in asynchronous mode.
+// The rules are combined: the processing fires when a message arrives that passes the `ports` and `deadband` filters,
+// the `join` ports have all received new values, and the `throttle` and `debounce` delays have elapsed.
+// The processor is called on every message received via any port if no rules are defined.
+// The rules are not used in synchronous mode, when the orchestrator triggers the processing.
+type Trigger struct {
+	// Ports are the names of the trigger ports. If defined, only the messages of these ports fire the processing,
+	// the messages of the other ports only update the inputs.
+	Ports []string `yaml:"ports"`
+
+	// Join are the names of the ports that all have to receive new values since the last processing before it fires
+	Join []string `yaml:"join"`
+
+	// Throttle is the minimum interval between two processings, e.g. `1s`.
+	// The messages arriving within the interval are processed together at the end of the interval.
+	Throttle string `yaml:"throttle"`
+
+	// Debounce is the quiet period, e.g. `200ms`, that has to elapse after the last firing message before the processing
+	Debounce string `yaml:"debounce"`
+
+	// Deadband holds the minimum change of the numeric ports, compared to the value of the last processing,
+	// that fires the processing. The key is the name of the port.
+	Deadband map[string]float64 `yaml:"deadband"`
+}
+
+// IsDefined returns true if any firing rule is defined
+func (t Trigger) IsDefined() bool {
+	return len(t.Ports) > 0 || len(t.Join) > 0 || t.Throttle != "" || t.Debounce != "" || len(t.Deadband) > 0
+}
+
+// ThrottleDuration returns with the throttle interval, or 0 if it is not defined or invalid
+func (t Trigger) ThrottleDuration() time.Duration {
+	throttle, err := time.ParseDuration(t.Throttle)
+	if err != nil {
+		return 0
+	}
+	return throttle
+}
+
+// DebounceDuration returns with the debounce period, or 0 if it is not defined or invalid
+func (t Trigger) DebounceDuration() time.Duration {
+	debounce, err := time.ParseDuration(t.Debounce)
+	if err != nil {
+		return 0
+	}
+	return debounce
+}
+
+// validateTrigger checks if the firing rules refer to existing ports, and their durations and deadbands are valid
+func (n Node) validateTrigger() ValidationErrors {
+	errs := ValidationErrors{}
+	trigger := n.Trigger
+
+	exists := func(rule string, name string) *In {
+		in, found := n.Ports.Inputs.FindByName(name)
+		if !found {
+			errs = append(errs, fmt.Errorf("the '%s' %s port of the trigger is not an input port", name, rule))
+		}
+		return in
+	}
+	for _, name := range trigger.Ports {
+		exists("trigger", name)
+	}
+	for _, name := range trigger.Join {
+		exists("join", name)
+	}
+
+	duration := func(rule string, value string) {
+		if value == "" {
+			return
+		}
+		if d, err := time.ParseDuration(value); err != nil || d <= 0 {
+			errs = append(errs, fmt.Errorf("wrong '%s' %s interval of the trigger, it must be a positive duration, e.g. '1s'", value, rule))
+		}
+	}
+	duration("throttle", trigger.Throttle)
+	duration("debounce", trigger.Debounce)
+
+	names := make([]string, 0, len(trigger.Deadband))
+	for name := range trigger.Deadband {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		band := trigger.Deadband[name]
+		if in := exists("deadband", name); in != nil && !IsNumericType(in.Type) {
+			errs = append(errs, fmt.Errorf("the '%s' deadband port of the trigger has '%s' message-type that is not numeric", name, in.Type))
+		}
+		if band < 0 {
+			errs = append(errs, fmt.Errorf("the deadband of the '%s' port can not be negative", name))
+		}
+	}
+
+	return errs
+}
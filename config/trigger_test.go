@@ -0,0 +1,57 @@
+package config
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestTriggerDurations(t *testing.T) {
+	trigger := Trigger{Throttle: "1s", Debounce: "200ms"}
+	assert.Equal(t, time.Second, trigger.ThrottleDuration())
+	assert.Equal(t, 200*time.Millisecond, trigger.DebounceDuration())
+	assert.Equal(t, time.Duration(0), Trigger{}.ThrottleDuration())
+	assert.Equal(t, time.Duration(0), Trigger{Debounce: "wrong"}.DebounceDuration())
+}
+
+func TestTriggerIsDefined(t *testing.T) {
+	assert.False(t, Trigger{}.IsDefined())
+	assert.True(t, Trigger{Ports: []string{"a"}}.IsDefined())
+	assert.True(t, Trigger{Join: []string{"a"}}.IsDefined())
+	assert.True(t, Trigger{Throttle: "1s"}.IsDefined())
+	assert.True(t, Trigger{Debounce: "1s"}.IsDefined())
+	assert.True(t, Trigger{Deadband: map[string]float64{"a": 0.1}}.IsDefined())
+}
+
+func TestValidateTrigger(t *testing.T) {
+	node := makeValidNode()
+	node.Trigger = Trigger{
+		Ports:    []string{"water-level"},
+		Join:     []string{"water-level", "reference"},
+		Throttle: "1s",
+		Debounce: "100ms",
+		Deadband: map[string]float64{"water-level": 0.05},
+	}
+	assert.Nil(t, node.Validate())
+
+	node.AddInputPort("switch", "base/Bool", "application/json", "switch-ch", "")
+	node.Trigger = Trigger{
+		Ports:    []string{"unknown"},
+		Join:     []string{"water-level", "missing"},
+		Throttle: "1",
+		Debounce: "-1s",
+		Deadband: map[string]float64{"switch": 0.5},
+	}
+	err := node.Validate()
+	if assert.IsType(t, ValidationErrors{}, err) {
+		assert.Equal(t, "invalid configuration:\n"+
+			"  the 'unknown' trigger port of the trigger is not an input port\n"+
+			"  the 'missing' join port of the trigger is not an input port\n"+
+			"  wrong '1' throttle interval of the trigger, it must be a positive duration, e.g. '1s'\n"+
+			"  wrong '-1s' debounce interval of the trigger, it must be a positive duration, e.g. '1s'\n"+
+			"  the 'switch' deadband port of the trigger has 'base/Bool' message-type that is not numeric", err.Error())
+	}
+
+	node.Trigger = Trigger{Deadband: map[string]float64{"water-level": -1}}
+	assert.Equal(t, "invalid configuration:\n  the deadband of the 'water-level' port can not be negative", node.Validate().Error())
+}
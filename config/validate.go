@@ -0,0 +1,171 @@
+package config
+
+import (
+	"fmt"
+	"github.com/tombenke/axon-go-common/msgs"
+	"strings"
+)
+
+// ReservedPortNames holds the names of the ports that are used internally by the node
+var ReservedPortNames = []string{"_RAP"}
+
+// ValidationErrors holds all the problems found during the validation of a configuration
+type ValidationErrors []error
+
+// Error returns with the list of the problems, one problem per line
+func (errs ValidationErrors) Error() string {
+	lines := make([]string, len(errs))
+	for i, err := range errs {
+		lines[i] = err.Error()
+	}
+	return fmt.Sprintf("invalid configuration:\n  %s", strings.Join(lines, "\n  "))
+}
+
+// Validate checks if the node can be started with the `n` configuration.
+// It checks the I/O ports, the orchestration channels and the trigger rules, and collects all the problems found.
+// It returns `nil` if the configuration is valid, otherwise a `ValidationErrors` with the list of the problems.
+func (n Node) Validate() error {
+	errs := ValidationErrors{}
+	errs = append(errs, n.validatePorts()...)
+	errs = append(errs, n.validateChannels()...)
+	errs = append(errs, n.validateTrigger()...)
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// Validate checks if the input port can be created with the `in` descriptor without failure
+func (in In) Validate() error {
+	if err := in.IO.validate("input"); err != nil {
+		return err
+	}
+
+	if err := in.validateFreshness(); err != nil {
+		return err
+	}
+
+	if err := in.Buffer.Validate(in.Type); err != nil {
+		return fmt.Errorf("wrong buffer of the '%s' input port: %s", in.Name, err)
+	}
+
+	if in.Default != "" && msgs.IsMessageTypeRegistered(in.Type) {
+		if !msgs.DoesMessageTypeImplementsRepresentation(in.Type, msgs.JSONRepresentation) {
+			return fmt.Errorf("'%s' message-type of the '%s' input port can not have default value in JSON format", in.Type, in.Name)
+		}
+		if err := msgs.GetDefaultMessageByType(in.Type).Decode(msgs.JSONRepresentation, []byte(in.Default)); err != nil {
+			return fmt.Errorf("wrong default value of the '%s' input port: %s", in.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// Validate checks if the output port can be created with the `out` descriptor without failure
+func (out Out) Validate() error {
+	if err := out.IO.validate("output"); err != nil {
+		return err
+	}
+	if err := out.validateEmission(); err != nil {
+		return err
+	}
+	return out.validateRate()
+}
+
+// validate checks the name, the message-type and the representation of the port of `kind` direction
+func (io IO) validate(kind string) error {
+	if io.Name == "" {
+		return fmt.Errorf("%s port name must be defined", kind)
+	}
+
+	for _, reserved := range ReservedPortNames {
+		if io.Name == reserved {
+			return fmt.Errorf("the '%s' %s port has a reserved name", io.Name, kind)
+		}
+	}
+
+	if !msgs.IsMessageTypeRegistered(io.Type) {
+		return fmt.Errorf("the '%s' message type of the '%s' %s port has not been registered", io.Type, io.Name, kind)
+	}
+
+	if !msgs.DoesMessageTypeImplementsRepresentation(io.Type, msgs.Representation(io.Representation)) {
+		return fmt.Errorf("'%s' message-type of the '%s' %s port does not implement codec for '%s' representation format", io.Type, io.Name, kind, io.Representation)
+	}
+
+	return nil
+}
+
+// validatePorts checks the individual ports, and the uniqueness of the port names and channels
+func (n Node) validatePorts() ValidationErrors {
+	errs := ValidationErrors{}
+
+	// channels holds the port that a channel is already bound to
+	channels := map[string]string{}
+	bind := func(kind string, io IO) {
+		if io.Channel == "" {
+			return
+		}
+		if port, bound := channels[io.Channel]; bound {
+			errs = append(errs, fmt.Errorf("the '%s' channel of the '%s' %s port is already bound to the '%s' port", io.Channel, io.Name, kind, port))
+			return
+		}
+		channels[io.Channel] = io.Name
+	}
+
+	names := map[string]bool{}
+	for _, in := range n.Ports.Inputs {
+		if err := in.Validate(); err != nil {
+			errs = append(errs, err)
+		}
+		if in.Name != "" && names[in.Name] {
+			errs = append(errs, fmt.Errorf("the '%s' input port is defined more than once", in.Name))
+		}
+		names[in.Name] = true
+		bind("input", in.IO)
+	}
+
+	names = map[string]bool{}
+	for _, out := range n.Ports.Outputs {
+		if err := out.Validate(); err != nil {
+			errs = append(errs, err)
+		}
+		if out.Name != "" && names[out.Name] {
+			errs = append(errs, fmt.Errorf("the '%s' output port is defined more than once", out.Name))
+		}
+		names[out.Name] = true
+		bind("output", out.IO)
+		for _, ch := range out.Channels {
+			bind("output", IO{Name: out.Name, Channel: ch.Channel})
+		}
+	}
+
+	return errs
+}
+
+// validateChannels checks if the channels needed by the enabled orchestration protocols are defined
+func (n Node) validateChannels() ValidationErrors {
+	errs := ValidationErrors{}
+	required := func(name string, channel string) {
+		if channel == "" {
+			errs = append(errs, fmt.Errorf("the '%s' orchestration channel must be defined", name))
+		}
+	}
+
+	channels := n.Orchestration.Channels
+	required("configurePorts", channels.ConfigurePorts)
+
+	if n.Orchestration.Presence {
+		required("statusRequest", channels.StatusRequest)
+		required("statusReport", channels.StatusReport)
+	}
+
+	if n.Orchestration.Synchronization {
+		required("sendResults", channels.SendResults)
+		required("sendingCompleted", channels.SendingCompleted)
+		required("receiveAndProcess", channels.ReceiveAndProcess)
+		required("processingCompleted", channels.ProcessingCompleted)
+	}
+
+	return errs
+}
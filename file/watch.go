@@ -0,0 +1,60 @@
+package file
+
+import (
+	"github.com/tombenke/axon-go-common/log"
+	"os"
+	"sync"
+	"time"
+)
+
+// Watch starts a go routine that checks the file identified by `path` in every `interval` time,
+// and sends a notification through the returned channel when the file has been created or modified.
+// The watcher keeps running until the `doneCh` channel is closed.
+func Watch(path string, interval time.Duration, doneCh chan interface{}, wg *sync.WaitGroup) chan interface{} {
+	changedCh := make(chan interface{})
+	lastModTime, lastSize := stat(path)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-doneCh:
+				return
+
+			case <-ticker.C:
+				modTime, size := stat(path)
+				if modTime.Equal(lastModTime) && size == lastSize {
+					continue
+				}
+				lastModTime, lastSize = modTime, size
+				if modTime.IsZero() {
+					// The file has been removed, so there is nothing to load
+					continue
+				}
+
+				log.Logger.Debugf("File '%s' has been changed", path)
+				select {
+				case changedCh <- true:
+				case <-doneCh:
+					return
+				}
+			}
+		}
+	}()
+
+	return changedCh
+}
+
+// stat returns with the modification time and the size of the file identified by `path`.
+// It returns with zero values if the file does not exist.
+func stat(path string) (time.Time, int64) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return time.Time{}, 0
+	}
+	return info.ModTime(), info.Size()
+}
@@ -0,0 +1,37 @@
+package file
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWatch(t *testing.T) {
+	dir, err := ioutil.TempDir("", "watch-test")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "config.yml")
+
+	wg := sync.WaitGroup{}
+	doneCh := make(chan interface{})
+	changedCh := Watch(path, 10*time.Millisecond, doneCh, &wg)
+
+	// Create the file
+	assert.Nil(t, ioutil.WriteFile(path, []byte("logLevel: info\n"), 0644))
+	<-changedCh
+
+	// Modify the file
+	assert.Nil(t, ioutil.WriteFile(path, []byte("logLevel: debug\n"), 0644))
+	<-changedCh
+
+	content, err := LoadFile(path)
+	assert.Nil(t, err)
+	assert.Equal(t, "logLevel: debug\n", string(content))
+
+	close(doneCh)
+	wg.Wait()
+}
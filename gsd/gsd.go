@@ -1,4 +1,6 @@
-// Package gsd is a simple package to manage graceful shut-down via catching the terminations signals
+// Package gsd is a simple package to manage graceful shut-down via catching the terminations signals.
+// `Register` calls a single callback on the first termination signal, while the `Manager`
+// calls prioritized shutdown hooks within a deadline, and exits immediately on a second signal.
 package gsd
 
 import (
@@ -24,6 +26,7 @@ func Register(wg *sync.WaitGroup, cb func(os.Signal)) chan os.Signal {
 		// Block until a signal is received.
 		s := <-sigs
 		log.Logger.Debugf("Got '%s' signal", s)
+		signal.Stop(sigs)
 		close(sigs)
 		wg.Done()
 		cb(s)
@@ -31,3 +34,31 @@ func Register(wg *sync.WaitGroup, cb func(os.Signal)) chan os.Signal {
 
 	return sigs
 }
+
+// RegisterHangup is an observer go routine to get notified when SIGHUP signals arrive,
+// then call the `cb` callback function with the signal every time the signal arrives.
+// It is typically used to trigger the reloading of the configuration.
+// The observer keeps running until the `doneCh` channel is closed.
+func RegisterHangup(doneCh chan interface{}, wg *sync.WaitGroup, cb func(os.Signal)) chan os.Signal {
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGHUP)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer signal.Stop(sigs)
+
+		for {
+			select {
+			case <-doneCh:
+				return
+
+			case s := <-sigs:
+				log.Logger.Debugf("Got '%s' signal", s)
+				cb(s)
+			}
+		}
+	}()
+
+	return sigs
+}
@@ -0,0 +1,31 @@
+package gsd
+
+import (
+	"github.com/stretchr/testify/assert"
+	"os"
+	"sync"
+	"syscall"
+	"testing"
+)
+
+func TestRegisterHangup(t *testing.T) {
+	wg := sync.WaitGroup{}
+	doneCh := make(chan interface{})
+	cbCalledCh := make(chan os.Signal)
+
+	// Register the callback handler
+	RegisterHangup(doneCh, &wg, func(s os.Signal) {
+		cbCalledCh <- s
+	})
+
+	// Sent HUP signals, and checks if callback was called every time
+	for i := 0; i < 2; i++ {
+		err := syscall.Kill(syscall.Getpid(), syscall.SIGHUP)
+		assert.Nil(t, err)
+		assert.Equal(t, syscall.SIGHUP, <-cbCalledCh)
+	}
+
+	// Stop the observer, then wait for termination
+	close(doneCh)
+	wg.Wait()
+}
@@ -0,0 +1,154 @@
+package gsd
+
+import (
+	"context"
+	"github.com/tombenke/axon-go-common/log"
+	"os"
+	"os/signal"
+	"sort"
+	"sync"
+	"syscall"
+	"time"
+)
+
+const (
+	// ExitCodeTimeout is the exit code of the process when the shutdown hooks do not finish before the deadline
+	ExitCodeTimeout = 1
+
+	// ExitCodeForced is the exit code of the process when a second termination signal arrives during the shutdown
+	ExitCodeForced = 2
+)
+
+// Hook is a function that the Manager calls during the shutdown.
+// The `ctx` context is cancelled when the deadline of the shutdown expires.
+type Hook func(ctx context.Context) error
+
+// hook holds a registered shutdown hook with its name and priority
+type hook struct {
+	name     string
+	priority int
+	fn       Hook
+}
+
+// Manager manages the graceful shut-down of the application.
+// It calls the registered hooks in the order of their priority when a termination signal arrives,
+// or the shutdown is triggered by the `Shutdown()` function.
+// If the hooks do not finish before the deadline, or a second termination signal arrives,
+// the Manager exits the process immediately.
+type Manager struct {
+	timeout    time.Duration
+	hooks      []hook
+	mu         sync.Mutex
+	sigs       chan os.Signal
+	shutdownCh chan interface{}
+	doneCh     chan interface{}
+	once       sync.Once
+	exit       func(int)
+}
+
+// NewManager creates a new Manager, that gives `timeout` time to the hooks to finish the shutdown.
+func NewManager(timeout time.Duration) *Manager {
+	return &Manager{
+		timeout:    timeout,
+		hooks:      []hook{},
+		sigs:       make(chan os.Signal, 2),
+		shutdownCh: make(chan interface{}),
+		doneCh:     make(chan interface{}),
+		exit:       os.Exit,
+	}
+}
+
+// AddHook registers the `fn` hook under the `name` with the given `priority`.
+// The hooks with lower priority values are called first, the hooks with the same priority
+// are called in the order of registration.
+func (m *Manager) AddHook(name string, priority int, fn Hook) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.hooks = append(m.hooks, hook{name: name, priority: priority, fn: fn})
+}
+
+// Start starts observing the termination signals.
+func (m *Manager) Start() {
+	signal.Notify(m.sigs, syscall.SIGINT, syscall.SIGTERM)
+
+	go func() {
+		defer signal.Stop(m.sigs)
+
+		// Block until a signal is received, or the shutdown is triggered
+		select {
+		case s := <-m.sigs:
+			log.Logger.Debugf("Got '%s' signal", s)
+		case <-m.shutdownCh:
+			log.Logger.Debugf("Shutdown is triggered")
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), m.timeout)
+		defer cancel()
+
+		hooksDoneCh := make(chan interface{})
+		go func() {
+			m.runHooks(ctx)
+			close(hooksDoneCh)
+		}()
+
+		select {
+		case <-hooksDoneCh:
+			// The hooks may return because their deadline has expired, that is not a completed shutdown
+			if ctx.Err() != nil {
+				m.timedOut()
+				return
+			}
+			log.Logger.Debugf("Shutdown completed")
+			close(m.doneCh)
+
+		case s := <-m.sigs:
+			log.Logger.Errorf("Got '%s' signal again, exit immediately", s)
+			m.exit(ExitCodeForced)
+
+		case <-ctx.Done():
+			m.timedOut()
+		}
+	}()
+}
+
+// timedOut exits the process because the hooks did not finish before the deadline
+func (m *Manager) timedOut() {
+	log.Logger.Errorf("Shutdown did not complete within %v, exit immediately", m.timeout)
+	m.exit(ExitCodeTimeout)
+}
+
+// Shutdown triggers the shutdown, like a termination signal were received
+func (m *Manager) Shutdown() {
+	m.once.Do(func() {
+		close(m.shutdownCh)
+	})
+}
+
+// Done returns with a channel that is closed when all the hooks have finished
+func (m *Manager) Done() chan interface{} {
+	return m.doneCh
+}
+
+// Wait waits until all the hooks have finished
+func (m *Manager) Wait() {
+	<-m.doneCh
+}
+
+// runHooks calls the hooks one after the other in the order of their priority
+func (m *Manager) runHooks(ctx context.Context) {
+	m.mu.Lock()
+	hooks := make([]hook, len(m.hooks))
+	copy(hooks, m.hooks)
+	m.mu.Unlock()
+
+	sort.SliceStable(hooks, func(i, j int) bool {
+		return hooks[i].priority < hooks[j].priority
+	})
+
+	for _, h := range hooks {
+		log.Logger.Debugf("Call '%s' shutdown hook", h.name)
+		if err := h.fn(ctx); err != nil {
+			log.Logger.Errorf("'%s' shutdown hook failed: %s", h.name, err)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package gsd
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// newTestManager creates a Manager that reports the exit code through the returned channel instead of exiting
+func newTestManager(timeout time.Duration) (*Manager, chan int) {
+	exitCh := make(chan int, 1)
+	m := NewManager(timeout)
+	m.exit = func(code int) { exitCh <- code }
+	return m, exitCh
+}
+
+func TestManagerHooksOrder(t *testing.T) {
+	m, _ := newTestManager(time.Second)
+
+	var mu sync.Mutex
+	called := []string{}
+	addHook := func(name string, priority int, err error) {
+		m.AddHook(name, priority, func(ctx context.Context) error {
+			mu.Lock()
+			defer mu.Unlock()
+			called = append(called, name)
+			return err
+		})
+	}
+	addHook("third", 10, nil)
+	addHook("first", 0, errors.New("failing hook"))
+	addHook("second", 0, nil)
+
+	m.Start()
+	m.sigs <- syscall.SIGTERM
+	m.Wait()
+
+	mu.Lock()
+	assert.Equal(t, []string{"first", "second", "third"}, called)
+	mu.Unlock()
+}
+
+func TestManagerShutdown(t *testing.T) {
+	m, _ := newTestManager(time.Second)
+
+	deadlineCh := make(chan bool, 1)
+	m.AddHook("hook", 0, func(ctx context.Context) error {
+		_, hasDeadline := ctx.Deadline()
+		deadlineCh <- hasDeadline
+		return nil
+	})
+
+	m.Start()
+	m.Shutdown()
+	m.Shutdown()
+	<-m.Done()
+	assert.True(t, <-deadlineCh)
+}
+
+func TestManagerTimeout(t *testing.T) {
+	m, exitCh := newTestManager(50 * time.Millisecond)
+
+	m.AddHook("stuck", 0, func(ctx context.Context) error {
+		<-ctx.Done()
+		time.Sleep(time.Second)
+		return ctx.Err()
+	})
+
+	m.Start()
+	m.Shutdown()
+	assert.Equal(t, ExitCodeTimeout, <-exitCh)
+}
+
+func TestManagerTimeoutWithHooksReturned(t *testing.T) {
+	m, exitCh := newTestManager(50 * time.Millisecond)
+
+	m.AddHook("cancelled", 0, func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	m.Start()
+	m.Shutdown()
+	assert.Equal(t, ExitCodeTimeout, <-exitCh)
+	select {
+	case <-m.Done():
+		assert.Fail(t, "shutdown must not be completed after the deadline")
+	default:
+	}
+}
+
+func TestManagerSecondSignal(t *testing.T) {
+	m, exitCh := newTestManager(time.Minute)
+
+	hookCalledCh := make(chan interface{})
+	m.AddHook("stuck", 0, func(ctx context.Context) error {
+		close(hookCalledCh)
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	m.Start()
+	m.sigs <- syscall.SIGINT
+	<-hookCalledCh
+	m.sigs <- syscall.SIGINT
+	assert.Equal(t, ExitCodeForced, <-exitCh)
+}
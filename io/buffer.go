@@ -0,0 +1,185 @@
+package io
+
+import (
+	"fmt"
+	"github.com/tombenke/axon-go-common/config"
+	"github.com/tombenke/axon-go-common/msgs"
+	"github.com/tombenke/axon-go-common/msgs/base"
+	"math"
+	"time"
+)
+
+// bufferedMessage is a message held by the buffer of an input port, together with its arrival time
+type bufferedMessage struct {
+	msg msgs.Message
+	at  time.Time
+}
+
+// Receive sets the `inMsg` message that arrived at `at` time to the port selected by the `name` parameter,
+// according to the buffering policy of the port.
+// The `latest`, `queue` and `window` policies make the message the actual message of the port immediately,
+// the `aggregate` policy changes the actual message only at the next delivery.
+func (inputs *Inputs) Receive(name string, inMsg msgs.Message, at time.Time) {
+	(*inputs).RW.RLock()
+	input, ok := (*inputs).Map[name]
+	(*inputs).RW.RUnlock()
+
+	if !ok || input.Buffer.Policy != config.BufferAggregate {
+		inputs.SetMessage(name, inMsg)
+	}
+
+	(*inputs).RW.Lock()
+	defer (*inputs).RW.Unlock()
+
+	input = (*inputs).Map[name]
+	input.freshness.received(input.Channel, at)
+	if !input.Buffer.IsLatest() {
+		input.buffered = append(input.buffered, bufferedMessage{msg: inMsg, at: at})
+		input.buffered = input.trim(at)
+	}
+	(*inputs).Map[name] = input
+}
+
+// Deliver prepares the buffered messages of the ports to be processed at `now` time.
+// It is called by the receiver right before it forwards the inputs to the processor:
+// the `queue` policy moves the messages received since the last delivery to `Messages`,
+// the `window` policy moves the messages of the time window to `Messages`,
+// and the `aggregate` policy replaces the actual message of the port with the aggregated one.
+// Then it checks whether the ports became stale.
+func (inputs *Inputs) Deliver(now time.Time) {
+	(*inputs).RW.Lock()
+	defer (*inputs).RW.Unlock()
+
+	for name, input := range (*inputs).Map {
+		switch input.Buffer.Policy {
+		case config.BufferQueue:
+			input.Messages = input.messages()
+			input.buffered = nil
+		case config.BufferWindow:
+			input.buffered = input.trim(now)
+			input.Messages = input.messages()
+		case config.BufferAggregate:
+			input.buffered = input.trim(now)
+			input.Message = input.aggregate(now)
+			if input.Buffer.WindowDuration() == 0 {
+				input.buffered = nil
+			}
+		}
+		input.checkFreshness(now)
+		(*inputs).Map[name] = input
+	}
+}
+
+// GetMessages returns with the messages of the input port selected by the `name` parameter, oldest first.
+// The ports of `queue` and `window` policies return with their delivered messages, that may be empty,
+// the other ports return with their actual message only.
+func (inputs *Inputs) GetMessages(name string) []msgs.Message {
+	messages, ok := inputs.LookupMessages(name)
+	if !ok {
+		errorMessage := fmt.Sprintf("There is no input port named to '%s'", name)
+		panic(errorMessage)
+	}
+	return messages
+}
+
+// LookupMessages returns with the messages of the input port selected by the `name` parameter like `GetMessages`,
+// and true if the port exists. It does not panic on unknown port names.
+func (inputs *Inputs) LookupMessages(name string) ([]msgs.Message, bool) {
+	(*inputs).RW.RLock()
+	defer (*inputs).RW.RUnlock()
+
+	input, ok := inputs.Map[name]
+	if !ok {
+		return nil, false
+	}
+	switch input.Buffer.Policy {
+	case config.BufferQueue, config.BufferWindow:
+		return input.Messages, true
+	default:
+		return []msgs.Message{input.Message}, true
+	}
+}
+
+// trim returns with the buffered messages that are within the time window before `now`,
+// and within the size limit of the buffer. The oldest messages are dropped first.
+func (input Input) trim(now time.Time) []bufferedMessage {
+	buffered := input.buffered
+	if window := input.Buffer.WindowDuration(); window > 0 {
+		from := now.Add(-window)
+		for len(buffered) > 0 && buffered[0].at.Before(from) {
+			buffered = buffered[1:]
+		}
+	}
+	if size := input.Buffer.Size; size > 0 && len(buffered) > size {
+		buffered = buffered[len(buffered)-size:]
+	}
+	return buffered
+}
+
+// messages returns with a new slice of the buffered messages
+func (input Input) messages() []msgs.Message {
+	messages := make([]msgs.Message, len(input.buffered))
+	for i, b := range input.buffered {
+		messages[i] = b.msg
+	}
+	return messages
+}
+
+// aggregate returns with a new message of the port's message-type created at `now` time,
+// that holds the aggregated value of the buffered messages.
+// If there is no message to aggregate, the `sum` and `count` functions result 0,
+// and the `mean`, `min` and `max` functions, that have no value then, return with the default message of the port.
+func (input Input) aggregate(now time.Time) msgs.Message {
+	if len(input.buffered) == 0 {
+		switch input.Buffer.Aggregate {
+		case config.AggregateMean, config.AggregateMin, config.AggregateMax:
+			return input.DefaultMessage
+		}
+	}
+
+	var result float64
+	for i, b := range input.buffered {
+		value, _ := NumericValue(b.msg)
+		switch input.Buffer.Aggregate {
+		case config.AggregateSum, config.AggregateMean:
+			result += value
+		case config.AggregateMin:
+			if i == 0 || value < result {
+				result = value
+			}
+		case config.AggregateMax:
+			if i == 0 || value > result {
+				result = value
+			}
+		}
+	}
+	switch input.Buffer.Aggregate {
+	case config.AggregateMean:
+		result /= float64(len(input.buffered))
+	case config.AggregateCount:
+		result = float64(len(input.buffered))
+	}
+
+	return NumericMessageAt(input.Type, result, now)
+}
+
+// NumericMessageAt returns with a new message of the `messageType` numeric message-type created at `now` time,
+// that holds the `value`. The value of the `base/Int64` messages is rounded.
+func NumericMessageAt(messageType string, value float64, now time.Time) msgs.Message {
+	if messageType == base.Int64TypeName {
+		return base.NewInt64MessageAt(int64(math.Round(value)), now.UnixNano(), "ns")
+	}
+	return base.NewFloat64MessageAt(value, now.UnixNano(), "ns")
+}
+
+// NumericValue returns with the value of the messages of numeric message-types, and true if the message is numeric
+func NumericValue(msg msgs.Message) (float64, bool) {
+	switch m := msg.(type) {
+	case *base.Float64:
+		return m.Body.Data, true
+	case *base.Int64:
+		return float64(m.Body.Data), true
+	default:
+		return 0, false
+	}
+}
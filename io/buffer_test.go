@@ -0,0 +1,165 @@
+package io
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/tombenke/axon-go-common/config"
+	"github.com/tombenke/axon-go-common/msgs"
+	"github.com/tombenke/axon-go-common/msgs/base"
+	"testing"
+	"time"
+)
+
+// newBufferedInputs creates inputs with one `base/Float64` port named to `value`, that has the `buffer` policy
+func newBufferedInputs(portType string, buffer config.Buffer) *Inputs {
+	return NewInputs(config.Inputs{config.In{IO: config.IO{Name: "value", Type: portType, Representation: "application/json"}, Buffer: buffer}})
+}
+
+// values returns with the data of the `base/Float64` messages
+func values(messages []msgs.Message) []float64 {
+	result := []float64{}
+	for _, msg := range messages {
+		result = append(result, msg.(*base.Float64).Body.Data)
+	}
+	return result
+}
+
+func TestInputsReceiveLatest(t *testing.T) {
+	inputs := newBufferedInputs(base.Float64TypeName, config.Buffer{})
+	now := time.Now()
+	inputs.Receive("value", base.NewFloat64Message(1), now)
+	inputs.Receive("value", base.NewFloat64Message(2), now)
+	inputs.Deliver(now)
+
+	assert.Equal(t, []float64{2}, values(inputs.GetMessages("value")))
+	assert.Panics(t, func() { inputs.Receive("unknown", base.NewFloat64Message(1), now) })
+	assert.Panics(t, func() { inputs.GetMessages("unknown") })
+}
+
+func TestInputsReceiveQueue(t *testing.T) {
+	inputs := newBufferedInputs(base.Float64TypeName, config.Buffer{Policy: config.BufferQueue, Size: 3})
+	now := time.Now()
+	for _, v := range []float64{1, 2, 3, 4} {
+		inputs.Receive("value", base.NewFloat64Message(v), now)
+	}
+	assert.Equal(t, []float64{}, values(inputs.GetMessages("value")))
+	assert.Equal(t, 4.0, inputs.GetMessage("value").(*base.Float64).Body.Data)
+
+	inputs.Deliver(now)
+	assert.Equal(t, []float64{2, 3, 4}, values(inputs.GetMessages("value")))
+
+	inputs.Receive("value", base.NewFloat64Message(5), now)
+	assert.Equal(t, []float64{2, 3, 4}, values(inputs.GetMessages("value")))
+	inputs.Deliver(now)
+	assert.Equal(t, []float64{5}, values(inputs.GetMessages("value")))
+	inputs.Deliver(now)
+	assert.Equal(t, []float64{}, values(inputs.GetMessages("value")))
+}
+
+func TestInputsReceiveWindow(t *testing.T) {
+	inputs := newBufferedInputs(base.Float64TypeName, config.Buffer{Policy: config.BufferWindow, Window: "10s"})
+	start := time.Now()
+	for i, v := range []float64{1, 2, 3, 4} {
+		inputs.Receive("value", base.NewFloat64Message(v), start.Add(time.Duration(i)*5*time.Second))
+	}
+	inputs.Deliver(start.Add(15 * time.Second))
+	assert.Equal(t, []float64{2, 3, 4}, values(inputs.GetMessages("value")))
+
+	inputs.Deliver(start.Add(22 * time.Second))
+	assert.Equal(t, []float64{4}, values(inputs.GetMessages("value")))
+
+	inputs.Deliver(start.Add(30 * time.Second))
+	assert.Equal(t, []float64{}, values(inputs.GetMessages("value")))
+}
+
+func TestInputsReceiveAggregate(t *testing.T) {
+	type testCase struct {
+		portType  string
+		aggregate string
+		expected  float64
+	}
+	cases := []testCase{
+		{base.Float64TypeName, config.AggregateSum, 10},
+		{base.Float64TypeName, config.AggregateMean, 2.5},
+		{base.Float64TypeName, config.AggregateMin, 1},
+		{base.Float64TypeName, config.AggregateMax, 4},
+		{base.Float64TypeName, config.AggregateCount, 4},
+		{base.Int64TypeName, config.AggregateMean, 3},
+		{base.Int64TypeName, config.AggregateCount, 4},
+	}
+	for _, c := range cases {
+		inputs := newBufferedInputs(c.portType, config.Buffer{Policy: config.BufferAggregate, Aggregate: c.aggregate})
+		defaultMessage := inputs.GetMessage("value")
+		now := time.Now()
+		for _, v := range []float64{4, 1, 3, 2} {
+			if c.portType == base.Int64TypeName {
+				inputs.Receive("value", base.NewInt64Message(int64(v)), now)
+			} else {
+				inputs.Receive("value", base.NewFloat64Message(v), now)
+			}
+		}
+		assert.Equal(t, defaultMessage, inputs.GetMessage("value"), "the message changes only at delivery")
+
+		inputs.Deliver(now)
+		messages := inputs.GetMessages("value")
+		if assert.Len(t, messages, 1) {
+			if c.portType == base.Int64TypeName {
+				assert.Equal(t, int64(c.expected), messages[0].(*base.Int64).Body.Data, c.aggregate)
+			} else {
+				assert.Equal(t, c.expected, messages[0].(*base.Float64).Body.Data, c.aggregate)
+			}
+		}
+	}
+}
+
+func TestInputsReceiveAggregateNoMessages(t *testing.T) {
+	cases := map[string]float64{
+		config.AggregateSum:   0,
+		config.AggregateCount: 0,
+		config.AggregateMean:  0.5,
+		config.AggregateMin:   0.5,
+		config.AggregateMax:   0.5,
+	}
+	for aggregate, expected := range cases {
+		inputs := NewInputs(config.Inputs{config.In{
+			IO:      config.IO{Name: "value", Type: base.Float64TypeName, Representation: "application/json"},
+			Default: `{"Body": {"Data": 0.5}}`,
+			Buffer:  config.Buffer{Policy: config.BufferAggregate, Aggregate: aggregate},
+		}})
+		now := time.Now()
+		inputs.Receive("value", base.NewFloat64Message(42), now)
+		inputs.Deliver(now)
+		assert.NotEqual(t, expected, inputs.GetMessage("value").(*base.Float64).Body.Data, aggregate)
+
+		// The cycle without messages does not keep the former aggregate
+		inputs.Deliver(now)
+		assert.Equal(t, expected, inputs.GetMessage("value").(*base.Float64).Body.Data, aggregate)
+	}
+}
+
+func TestInputsReceiveAggregateWindow(t *testing.T) {
+	inputs := newBufferedInputs(base.Float64TypeName, config.Buffer{Policy: config.BufferAggregate, Aggregate: config.AggregateMean, Window: "10s"})
+	start := time.Now()
+	inputs.Receive("value", base.NewFloat64Message(1), start)
+	inputs.Receive("value", base.NewFloat64Message(3), start.Add(5*time.Second))
+	inputs.Deliver(start.Add(5 * time.Second))
+	assert.Equal(t, 2.0, inputs.GetMessage("value").(*base.Float64).Body.Data)
+
+	inputs.Receive("value", base.NewFloat64Message(5), start.Add(12*time.Second))
+	inputs.Deliver(start.Add(12 * time.Second))
+	assert.Equal(t, 4.0, inputs.GetMessage("value").(*base.Float64).Body.Data, "the first message is out of the window")
+}
+
+func TestInputsConfigurePortKeepsBuffer(t *testing.T) {
+	queue := config.Buffer{Policy: config.BufferQueue, Size: 10}
+	inputs := newBufferedInputs(base.Float64TypeName, queue)
+	now := time.Now()
+	inputs.Receive("value", base.NewFloat64Message(1), now)
+	inputs.ConfigurePort(config.In{IO: config.IO{Name: "value", Type: base.Float64TypeName, Representation: "application/json", Channel: "new"}, Buffer: queue})
+	inputs.Deliver(now)
+	assert.Equal(t, []float64{1}, values(inputs.GetMessages("value")))
+
+	inputs.Receive("value", base.NewFloat64Message(2), now)
+	inputs.ConfigurePort(config.In{IO: config.IO{Name: "value", Type: base.Float64TypeName, Representation: "application/json"}, Buffer: config.Buffer{Policy: config.BufferQueue, Size: 5}})
+	inputs.Deliver(now)
+	assert.Equal(t, []float64{}, values(inputs.GetMessages("value")), "the buffer is reset when the policy changes")
+}
@@ -41,6 +41,35 @@ An output port has the following configuration parameters:
 
 * `channel`: A string value. Optional. Default value: "". The default empty string value means, it is a `nil-channel`, so the messages must not be forwarded.
 
+* `channels`: A list of additional channels. Optional. The messages of the port are published to each of them too.
+Every item has a `channel` name, and an optional `representation` that defaults to the representation of the port.
+
+* `emit`: The emission mode of the port. Optional. Default value: `set`.
+  - `set`: The message is published only if the processor set it in the actual invocation.
+  - `always`: The message is published after every invocation, so the previous message is re-sent if the processor did not set a new one.
+  - `change`: The message is published only if the processor set it, and it differs from the last published message.
+
+* `deadband`: A non-negative number. Optional. It can be used only with `base/Float64` and `base/Int64` ports in `change` emission mode.
+The numeric messages are published only if their change is greater than the deadband.
+
+* `rate`: Limits the number of messages the port publishes. Optional.
+  - `interval`: The minimum interval between two messages, e.g. `100ms`. Mandatory if the rate is limited.
+  - `policy`: The rate limiting policy. Optional. Default value: `drop`.
+    `drop` publishes the first message of each interval and drops the others,
+    `latest` publishes the latest message set within the interval at its end,
+    `average` publishes the mean of the messages set within the interval at its end. It can be used only with `base/Float64` and `base/Int64` ports.
+
+* `batch`: Collects the messages of the port, and publishes them together in one array message. Optional.
+The `base/Float64`, `base/Int64`, `base/Bool` and `base/String` messages are batched into `base/Float64Array`, `base/Int64Array`, `base/BoolArray` and `base/StringArray` messages.
+  - `size`: The number of messages published together. Optional.
+  - `interval`: The period, e.g. `1s`, the messages collected within are published together. Optional.
+
+The messages that pass the emission mode go through the rate limit first, then into the batch.
+In asynchronous mode the messages held back by the rate limit or the batch are published when they are due, or when the node shuts down.
+In synchronous mode they are published when the orchestrator triggers the sending after they are due, and the ones still held back at shutdown are lost.
+
+The emission mode can also be given as the 5th part of the `-out` CLI parameter, e.g. `level|level-ch|base/Float64|application/json|change:0.5`.
+
 Examples for outputs port configuration:
 
     outputs:
@@ -52,6 +81,20 @@ Examples for outputs port configuration:
         type: base/Float64
         representation: application/json
         channel: high-pressure-wss-input-need
+        channels:
+          - channel: high-pressure-wss-input-need-log
+            representation: application/yaml
+        emit: change
+        deadband: 0.1
+      - name: water-level-samples
+        type: base/Float64
+        representation: application/json
+        channel: water-level-samples
+        rate:
+          interval: 100ms
+          policy: average
+        batch:
+          size: 10
 
 The configuration of the input ports
 
@@ -70,6 +113,41 @@ In case of the input channel the "" empty channel name means that it is a parame
 If it is the "" empty string, then the port will use the default message object that belongs to the message type identified by the `type` parameter.
 The default value is used instead of the channel value when either there is no channel defined, or the orchestrator commands the input receiver to forward the inputs to the processor, but there was no input message received yet via the channel.
 
+* `deadLetter`: A string value. Optional. Default value: "". The messages received via the `channel` that the port can not decode are logged, counted, and dropped.
+If `deadLetter` is not empty, the raw content of these messages is also published to this channel, so they can be inspected later.
+The number of undecodable messages of a port is returned by `Inputs.Undecodable()`.
+
+* `buffer`: An object. Optional. It defines how the port buffers the messages received between two processing cycles.
+Its `policy` property selects the buffering policy:
+
+  - `latest`: The port holds only the latest message received. This is the default policy, if `buffer` is not defined.
+  - `queue`: The port collects the messages received since the last processing in a FIFO queue of `size` capacity.
+    The oldest messages are dropped if the queue is full.
+  - `window`: The port holds the messages received within the sliding time window of the `window` duration, e.g. `5s`.
+    The optional `size` limits the number of messages held.
+  - `aggregate`: The receiver computes the `sum`, `mean`, `min`, `max` or `count` of the messages, selected by the `aggregate` property,
+    right before forwarding the inputs to the processor, and the result becomes the message of the port.
+    The messages received since the last processing are aggregated, or the ones within the `window` duration if it is defined.
+    If no message arrived, the `sum` and `count` functions result 0, and the `mean`, `min` and `max` functions,
+    that have no value then, revert the port to its default message. Only `base/Float64` and `base/Int64` ports can be aggregated.
+
+The processor function gets the buffered messages of the `queue` and `window` ports, oldest first, via `Context.GetInputMessages()`,
+while `Context.GetInputMessage()` still returns with the latest one.
+In the CLI format the buffer is the seventh part of the port parameter: `latest`, `queue:<size>`, `window:<duration>`, `aggregate:<function>` or `aggregate:<function>:<duration>`.
+
+* `maxAge`: A duration string, e.g. `30s`. Optional. Default value: "". The port becomes stale if its last message is older than `maxAge`,
+or it has not received any message yet. The port never becomes stale if `maxAge` is empty.
+
+* `onStale`: Either `mark` or `default`. Optional. Default value: `mark`. The stale port keeps its last message with the `mark` action,
+and its message reverts to the default message with the `default` action.
+
+* `required`: A boolean value. Optional. Default value: `false`. The processing is suppressed until all the required ports are fresh,
+i.e. they have received a message that is not stale. A required port must have a channel.
+
+The processor function gets the freshness metadata of the ports via `Context.GetInputMetadata()`:
+the time the last message was received, the channel it came from, the number of messages received,
+and whether the port holds its default message, or it is stale.
+
 Examples for inputs port configuration:
 
     inputs:
@@ -98,6 +176,14 @@ Examples for inputs port configuration:
         representation: application/json
         channel: well-water-buffer-tank-water-output
         default: "" # Use the default value defined to the message-type
+        deadLetter: well-water-buffer-tank-water-output-dead-letter
+        buffer:
+          policy: aggregate
+          aggregate: mean
+          window: 1m
+        maxAge: 5m
+        onStale: default
+        required: true
       - name: water-buffer-tank-level
         type: base/Float64
         representation: application/json
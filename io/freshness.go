@@ -0,0 +1,116 @@
+package io
+
+import (
+	"fmt"
+	"github.com/tombenke/axon-go-common/config"
+	"sort"
+	"time"
+)
+
+// Metadata holds the freshness metadata of the actual message of an input port
+type Metadata struct {
+	// ReceivedAt is the time when the port received its last message. It is zero if no message has been received yet.
+	ReceivedAt time.Time
+	// Source is the channel the actual message was received from. It is empty if the port holds its default message.
+	Source string
+	// ReceiveCount is the number of messages the port has received
+	ReceiveCount uint64
+	// IsDefault is true if the port holds its default message
+	IsDefault bool
+	// Stale is true if the last message is older than the max age of the port, or no message has been received yet
+	Stale bool
+}
+
+// Fresh returns true if the port has received a message that is not stale
+func (m Metadata) Fresh() bool {
+	return m.ReceiveCount > 0 && !m.Stale
+}
+
+// freshness holds the bookkeeping of the messages received by an input port
+type freshness struct {
+	receivedAt   time.Time
+	source       string
+	receiveCount uint64
+	stale        bool
+}
+
+// received registers a message received from the `source` channel at `at` time
+func (f *freshness) received(source string, at time.Time) {
+	f.receivedAt = at
+	f.source = source
+	f.receiveCount++
+	f.stale = false
+}
+
+// setFreshnessConfig sets the freshness properties of the input according to the `in` port descriptor
+func (input *Input) setFreshnessConfig(in config.In) {
+	input.MaxAge = in.MaxAgeDuration()
+	input.OnStale = in.OnStale
+	input.Required = in.Required
+}
+
+// checkFreshness marks the input stale, if its last message is older than its max age at `now` time,
+// and reverts its message to the default message if its stale action is `default`
+func (input *Input) checkFreshness(now time.Time) {
+	if input.MaxAge == 0 {
+		return
+	}
+	input.freshness.stale = input.freshness.receiveCount == 0 || now.Sub(input.freshness.receivedAt) > input.MaxAge
+	if input.freshness.stale && input.OnStale == config.StaleDefault {
+		input.Message = input.DefaultMessage
+	}
+}
+
+// metadata returns with the freshness metadata of the actual message of the input
+func (input Input) metadata() Metadata {
+	m := Metadata{
+		ReceivedAt:   input.freshness.receivedAt,
+		Source:       input.freshness.source,
+		ReceiveCount: input.freshness.receiveCount,
+		IsDefault:    input.Message == input.DefaultMessage,
+		Stale:        input.freshness.stale || (input.MaxAge > 0 && input.freshness.receiveCount == 0),
+	}
+	if m.IsDefault {
+		m.Source = ""
+	}
+	return m
+}
+
+// GetMetadata returns with the freshness metadata of the input port selected by the `name` parameter
+func (inputs *Inputs) GetMetadata(name string) Metadata {
+	m, ok := inputs.LookupMetadata(name)
+	if !ok {
+		errorMessage := fmt.Sprintf("There is no input port named to '%s'", name)
+		panic(errorMessage)
+	}
+	return m
+}
+
+// LookupMetadata returns with the freshness metadata of the input port selected by the `name` parameter,
+// and true if the port exists. It does not panic on unknown port names.
+func (inputs *Inputs) LookupMetadata(name string) (Metadata, bool) {
+	(*inputs).RW.RLock()
+	defer (*inputs).RW.RUnlock()
+
+	input, ok := inputs.Map[name]
+	if !ok {
+		return Metadata{}, false
+	}
+	return input.metadata(), true
+}
+
+// NotFresh returns with the sorted names of the required input ports that are not fresh,
+// so the processing has to be suppressed until they receive new messages
+func (inputs *Inputs) NotFresh() []string {
+	(*inputs).RW.RLock()
+	defer (*inputs).RW.RUnlock()
+
+	names := []string{}
+	for name, input := range inputs.Map {
+		if input.Required && !input.metadata().Fresh() {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
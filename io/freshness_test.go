@@ -0,0 +1,99 @@
+package io
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/tombenke/axon-go-common/config"
+	"github.com/tombenke/axon-go-common/msgs/base"
+	"testing"
+	"time"
+)
+
+// newFreshnessInputs creates inputs with one `base/Float64` port named to `level`, that has the `maxAge` and `onStale` properties
+func newFreshnessInputs(maxAge string, onStale string, required bool) *Inputs {
+	return NewInputs(config.Inputs{config.In{
+		IO:       config.IO{Name: "level", Type: base.Float64TypeName, Representation: "application/json", Channel: "level-ch"},
+		Default:  `{"Body": {"Data": -1}}`,
+		MaxAge:   maxAge,
+		OnStale:  onStale,
+		Required: required,
+	}})
+}
+
+func TestInputsMetadata(t *testing.T) {
+	inputs := newFreshnessInputs("", "", false)
+	assert.Equal(t, Metadata{IsDefault: true}, inputs.GetMetadata("level"))
+
+	at := time.Now()
+	inputs.Receive("level", base.NewFloat64Message(1), at)
+	inputs.Receive("level", base.NewFloat64Message(2), at.Add(time.Second))
+	inputs.Deliver(at.Add(time.Hour))
+	assert.Equal(t, Metadata{ReceivedAt: at.Add(time.Second), Source: "level-ch", ReceiveCount: 2}, inputs.GetMetadata("level"))
+	assert.True(t, inputs.GetMetadata("level").Fresh())
+
+	_, ok := inputs.LookupMetadata("unknown")
+	assert.False(t, ok)
+	assert.Panics(t, func() { inputs.GetMetadata("unknown") })
+}
+
+func TestInputsStaleMark(t *testing.T) {
+	inputs := newFreshnessInputs("10s", config.StaleMark, false)
+	assert.True(t, inputs.GetMetadata("level").Stale, "no message received yet")
+
+	at := time.Now()
+	inputs.Receive("level", base.NewFloat64Message(42), at)
+	assert.False(t, inputs.GetMetadata("level").Stale)
+
+	inputs.Deliver(at.Add(10 * time.Second))
+	assert.False(t, inputs.GetMetadata("level").Stale)
+
+	inputs.Deliver(at.Add(11 * time.Second))
+	m := inputs.GetMetadata("level")
+	assert.True(t, m.Stale)
+	assert.False(t, m.IsDefault)
+	assert.Equal(t, 42.0, inputs.GetMessage("level").(*base.Float64).Body.Data)
+
+	inputs.Receive("level", base.NewFloat64Message(43), at.Add(12*time.Second))
+	assert.True(t, inputs.GetMetadata("level").Fresh())
+}
+
+func TestInputsStaleDefault(t *testing.T) {
+	inputs := newFreshnessInputs("10s", config.StaleDefault, false)
+	at := time.Now()
+	inputs.Receive("level", base.NewFloat64Message(42), at)
+	inputs.Deliver(at.Add(11 * time.Second))
+
+	m := inputs.GetMetadata("level")
+	assert.True(t, m.Stale)
+	assert.True(t, m.IsDefault)
+	assert.Equal(t, "", m.Source)
+	assert.Equal(t, uint64(1), m.ReceiveCount)
+	assert.Equal(t, -1.0, inputs.GetMessage("level").(*base.Float64).Body.Data)
+}
+
+func TestInputsNotFresh(t *testing.T) {
+	inputs := newFreshnessInputs("10s", "", true)
+	inputs.ConfigurePort(config.In{IO: config.IO{Name: "switch", Type: base.BoolTypeName, Representation: "application/json", Channel: "switch-ch"}, Required: true})
+	inputs.ConfigurePort(config.In{IO: config.IO{Name: "limit", Type: base.Float64TypeName, Representation: "application/json"}})
+	assert.Equal(t, []string{"level", "switch"}, inputs.NotFresh())
+
+	at := time.Now()
+	inputs.Receive("switch", base.NewBoolMessage(true), at)
+	inputs.Receive("level", base.NewFloat64Message(1), at)
+	inputs.Deliver(at.Add(5 * time.Second))
+	assert.Equal(t, []string{}, inputs.NotFresh())
+
+	inputs.Deliver(at.Add(time.Minute))
+	assert.Equal(t, []string{"level"}, inputs.NotFresh(), "the switch port has no max age, so it never becomes stale")
+}
+
+func TestInputsConfigurePortKeepsMetadata(t *testing.T) {
+	inputs := newFreshnessInputs("10s", "", false)
+	at := time.Now()
+	inputs.Receive("level", base.NewFloat64Message(1), at)
+	inputs.ConfigurePort(config.In{IO: config.IO{Name: "level", Type: base.Float64TypeName, Representation: "application/json", Channel: "level-ch"}, MaxAge: "1m"})
+	m := inputs.GetMetadata("level")
+	assert.Equal(t, uint64(1), m.ReceiveCount)
+	assert.Equal(t, at, m.ReceivedAt)
+	inputs.Deliver(at.Add(30 * time.Second))
+	assert.False(t, inputs.GetMetadata("level").Stale)
+}
@@ -5,18 +5,38 @@ import (
 	"github.com/tombenke/axon-go-common/config"
 	"github.com/tombenke/axon-go-common/msgs"
 	"sync"
+	"time"
 )
 
 // Input holds the data of an input port of the actor
 type Input struct {
 	IO
 	DefaultMessage msgs.Message
+	// DeadLetterChannel is the channel to forward the undecodable messages to
+	DeadLetterChannel string
+	// Buffer is the buffering policy of the messages received between two processing cycles
+	Buffer config.Buffer
+	// Messages holds the buffered messages delivered to the processor by the `queue` and `window` policies, oldest first
+	Messages []msgs.Message
+	// buffered holds the messages received by the `queue`, `window` and `aggregate` policies
+	// that are not delivered yet, or still within the time window
+	buffered []bufferedMessage
+	// MaxAge is the age of the last message received when the port becomes stale. The port never becomes stale if it is 0.
+	MaxAge time.Duration
+	// OnStale is the action taken on the message of the stale port: `mark` or `default`
+	OnStale string
+	// Required suppresses the processing until the port is fresh
+	Required bool
+	// freshness holds the bookkeeping of the messages received by the port
+	freshness freshness
 }
 
 // Inputs holds a map of the the input ports of the actor. The key is the name of the port.
 type Inputs struct {
 	RW  sync.RWMutex
 	Map map[string]Input
+	// undecodable counts the messages per port that could not be decoded
+	undecodable map[string]uint64
 }
 
 ////type Inputs map[string]Input
@@ -34,6 +54,25 @@ func (inputs *Inputs) GetMessage(name string) msgs.Message {
 	panic(errorMessage)
 }
 
+// LookupMessage returns the last message received via the input port selected by the `name` parameter,
+// and true if the port exists. Unlike `GetMessage`, it does not panic on unknown port names.
+func (inputs *Inputs) LookupMessage(name string) (msgs.Message, bool) {
+	(*inputs).RW.RLock()
+	defer (*inputs).RW.RUnlock()
+
+	input, ok := inputs.Map[name]
+	return input.Message, ok
+}
+
+// LookupType returns with the message-type of the input port selected by the `name` parameter, and true if the port exists
+func (inputs *Inputs) LookupType(name string) (string, bool) {
+	(*inputs).RW.RLock()
+	defer (*inputs).RW.RUnlock()
+
+	input, ok := inputs.Map[name]
+	return input.Type, ok
+}
+
 // SetMessage sets the message that received via the input channel to the port selected by the `name` parameter
 func (inputs *Inputs) SetMessage(name string, inMsg msgs.Message) {
 	(*inputs).RW.Lock()
@@ -51,30 +90,76 @@ func (inputs *Inputs) SetMessage(name string, inMsg msgs.Message) {
 		panic(errorMessage)
 	}
 
-	(*inputs).Map[name] = Input{
-		IO: IO{
-			Name:           name,
-			Type:           inMsgType,
-			Representation: (*inputs).Map[name].Representation,
-			Channel:        (*inputs).Map[name].Channel,
-			Message:        inMsg,
-		},
-		DefaultMessage: (*inputs).Map[name].DefaultMessage,
+	input := (*inputs).Map[name]
+	input.Name = name
+	input.Type = inMsgType
+	input.Message = inMsg
+	(*inputs).Map[name] = input
+}
+
+// CountUndecodable increments the number of messages that the port selected by `name` could not decode
+func (inputs *Inputs) CountUndecodable(name string) {
+	(*inputs).RW.Lock()
+	defer (*inputs).RW.Unlock()
+
+	if (*inputs).undecodable == nil {
+		(*inputs).undecodable = make(map[string]uint64)
+	}
+	(*inputs).undecodable[name]++
+}
+
+// Undecodable returns with the number of messages that the port selected by `name` could not decode
+func (inputs *Inputs) Undecodable(name string) uint64 {
+	(*inputs).RW.RLock()
+	defer (*inputs).RW.RUnlock()
+
+	return (*inputs).undecodable[name]
+}
+
+// ConfigurePort adds a new input port to the inputs, or replaces the properties of the existing one,
+// according to the `inCfg` port descriptor.
+// The message of an existing port is kept unless it still holds the former default message of the port,
+// in this case the message is replaced by the new default message.
+// The buffered messages of an existing port are kept if neither its message-type nor its buffering policy changes.
+func (inputs *Inputs) ConfigurePort(inCfg config.In) {
+	newInput := NewInput(inCfg.Name, inCfg.Type, msgs.Representation(inCfg.Representation), inCfg.Channel, NewDefaultMessage(inCfg.Type, inCfg.Default))
+	newInput.DeadLetterChannel = inCfg.DeadLetter
+	newInput.Buffer = inCfg.Buffer
+	newInput.setFreshnessConfig(inCfg)
+
+	(*inputs).RW.Lock()
+	defer (*inputs).RW.Unlock()
+
+	if input, ok := (*inputs).Map[inCfg.Name]; ok && input.Type == newInput.Type {
+		if input.Message != input.DefaultMessage {
+			newInput.Message = input.Message
+		}
+		newInput.freshness = input.freshness
+		if input.Buffer == newInput.Buffer {
+			newInput.Messages = input.Messages
+			newInput.buffered = input.buffered
+		}
 	}
+	(*inputs).Map[inCfg.Name] = newInput
 }
 
 // NewInputs creates a new Inputs map based on the config parameters
 func NewInputs(inputsCfg config.Inputs) *Inputs {
 	inputs := Inputs{
-		RW:  *new(sync.RWMutex),
-		Map: make(map[string]Input),
+		RW:          *new(sync.RWMutex),
+		Map:         make(map[string]Input),
+		undecodable: make(map[string]uint64),
 	}
 
 	inputs.RW.Lock()
 	defer inputs.RW.Unlock()
 
 	for _, in := range inputsCfg {
-		inputs.Map[in.Name] = NewInput(in.IO.Name, in.IO.Type, msgs.Representation(in.IO.Representation), in.IO.Channel, NewDefaultMessage(in.Type, in.Default))
+		input := NewInput(in.IO.Name, in.IO.Type, msgs.Representation(in.IO.Representation), in.IO.Channel, NewDefaultMessage(in.Type, in.Default))
+		input.DeadLetterChannel = in.DeadLetter
+		input.Buffer = in.Buffer
+		input.setFreshnessConfig(in)
+		inputs.Map[in.Name] = input
 	}
 	return &inputs
 }
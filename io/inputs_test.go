@@ -78,3 +78,28 @@ func TestNewInputsWithMissingRepresentation(t *testing.T) {
 	}
 	assert.Panics(t, func() { NewInputs(inputsCfg) })
 }
+
+func TestInputsConfigurePort(t *testing.T) {
+	inputsCfg := config.Inputs{
+		config.In{IO: config.IO{Name: "sensor-value", Type: "base/Bool", Representation: "application/json", Channel: "value-of-sensor-1"}, Default: ""},
+		config.In{IO: config.IO{Name: "node-state", Type: "base/String", Representation: "application/json", Channel: "state-of-the-node"}, Default: ""},
+	}
+	inputs := NewInputs(inputsCfg)
+	smsg := base.NewStringMessage("Some text...")
+	inputs.SetMessage("node-state", smsg)
+
+	// Change the default message of a port that holds its default message
+	inputs.ConfigurePort(config.In{IO: config.IO{Name: "sensor-value", Type: "base/Bool", Representation: "application/json", Channel: "value-of-sensor-2"}, Default: `{"Body": {"Data": true}}`})
+	assert.Equal(t, "value-of-sensor-2", inputs.Map["sensor-value"].Channel)
+	assert.Equal(t, true, inputs.GetMessage("sensor-value").(*base.Bool).Body.Data)
+
+	// Change a port that has already received a message
+	inputs.ConfigurePort(config.In{IO: config.IO{Name: "node-state", Type: "base/String", Representation: "application/json", Channel: "new-state-of-the-node"}, Default: `{"Body": {"Data": "Default text..."}}`})
+	assert.Equal(t, "new-state-of-the-node", inputs.Map["node-state"].Channel)
+	assert.Equal(t, smsg, inputs.GetMessage("node-state"))
+
+	// Add a new port
+	inputs.ConfigurePort(config.In{IO: config.IO{Name: "new-port", Type: "base/Float64", Representation: "application/json", Channel: "new-channel"}, Default: ""})
+	assert.Equal(t, len(inputs.Map), 3)
+	assert.Equal(t, "new-channel", inputs.Map["new-port"].Channel)
+}
@@ -9,6 +9,26 @@ import (
 // Output holds the data of an output port of the actor
 type Output struct {
 	IO
+	// Channels are the additional channels the messages of the port are published to, each with its own representation
+	Channels []Channel
+	// Emit is the emission mode of the port: `set`, `always` or `change`. The empty string means `set`.
+	Emit string
+	// Deadband is the minimum change of the numeric messages that are published in `change` emission mode
+	Deadband float64
+	// Rate limits the number of messages published by the port
+	Rate config.RateLimit
+	// Batch collects the messages of the port, and publishes them together in one array message
+	Batch config.Batch
+	// Updated is true if the processor set the message of the port in the actual invocation
+	Updated bool
+}
+
+// Channel is an additional channel of an output port
+type Channel struct {
+	// Name is the name of the channel
+	Name string
+	// Representation is the format the messages are encoded to for the channel
+	Representation msgs.Representation
 }
 
 // Outputs holds a map of the the output ports of the actor. The key is the name of the port.
@@ -24,6 +44,12 @@ func (outputs Outputs) GetMessage(name string) msgs.Message {
 	panic(errorMessage)
 }
 
+// LookupType returns with the message-type of the output port selected by the `name` parameter, and true if the port exists
+func (outputs Outputs) LookupType(name string) (string, bool) {
+	output, ok := outputs[name]
+	return output.Type, ok
+}
+
 // SetMessage sets the message to emit via the output port selected by the `name` parameter
 func (outputs *Outputs) SetMessage(name string, outMsg msgs.Message) {
 	if _, ok := (*outputs)[name]; !ok {
@@ -38,15 +64,39 @@ func (outputs *Outputs) SetMessage(name string, outMsg msgs.Message) {
 		panic(errorMessage)
 	}
 
-	(*outputs)[name] = Output{
-		IO: IO{
-			Name:           name,
-			Type:           outMsgType,
-			Message:        outMsg,
-			Representation: (*outputs)[name].Representation,
-			Channel:        (*outputs)[name].Channel,
-		},
+	output := (*outputs)[name]
+	output.Message = outMsg
+	output.Updated = true
+	(*outputs)[name] = output
+}
+
+// Renew returns with a copy of the outputs for the next invocation of the processor.
+// The ports keep their last messages, but none of them is marked as updated.
+func (outputs Outputs) Renew() Outputs {
+	renewed := make(Outputs)
+	for name, output := range outputs {
+		output.Updated = false
+		renewed[name] = output
+	}
+	return renewed
+}
+
+// ConfigurePorts returns with a copy of the outputs, that has its ports added or modified
+// according to the `outputsCfg` port descriptors.
+// The modified ports keep their actual message if their message-type remains the same.
+func (outputs Outputs) ConfigurePorts(outputsCfg config.Outputs) Outputs {
+	configured := make(Outputs)
+	for name, output := range outputs {
+		configured[name] = output
 	}
+
+	for name, output := range NewOutputs(outputsCfg) {
+		if current, ok := outputs[name]; ok && current.Type == output.Type {
+			output.Message = current.Message
+		}
+		configured[name] = output
+	}
+	return configured
 }
 
 // NewOutputs creates a new Outputs map based on the config parameters
@@ -65,7 +115,26 @@ func NewOutputs(outputsCfg config.Outputs) Outputs {
 			errorString := fmt.Sprintf("'%s' message-type does not implement codec for '%s' representation format", Type, Repr)
 			panic(errorString)
 		}
-		outputs[Name] = Output{IO{Name: Name, Type: Type, Representation: Repr, Channel: Chan}}
+		channels := make([]Channel, 0, len(o.Channels))
+		for _, ch := range o.Channels {
+			chRepr := Repr
+			if ch.Representation != "" {
+				chRepr = msgs.Representation(ch.Representation)
+			}
+			if !msgs.DoesMessageTypeImplementsRepresentation(Type, chRepr) {
+				errorString := fmt.Sprintf("'%s' message-type does not implement codec for '%s' representation format", Type, chRepr)
+				panic(errorString)
+			}
+			channels = append(channels, Channel{Name: ch.Channel, Representation: chRepr})
+		}
+		outputs[Name] = Output{
+			IO:       IO{Name: Name, Type: Type, Representation: Repr, Channel: Chan},
+			Channels: channels,
+			Emit:     o.Emit,
+			Deadband: o.Deadband,
+			Rate:     o.Rate,
+			Batch:    o.Batch,
+		}
 	}
 	return outputs
 }
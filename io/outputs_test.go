@@ -23,20 +23,20 @@ func TestOutputsGetMessageWrongPort(t *testing.T) {
 
 func TestOutputsSetMessage(t *testing.T) {
 	bmsg := base.NewBoolMessage(true)
-	out := Outputs{"State": Output{IO{Name: "State", Type: base.BoolTypeName, Message: bmsg}}}
+	out := Outputs{"State": Output{IO: IO{Name: "State", Type: base.BoolTypeName, Message: bmsg}}}
 	(out).SetMessage("State", bmsg)
 	assert.Equal(t, out["State"].IO.Message.String(), bmsg.String())
 }
 
 func TestOutputsSetMessageWrongPort(t *testing.T) {
 	bmsg := base.NewBoolMessage(true)
-	out := Outputs{"State": Output{IO{Name: "State", Type: base.BoolTypeName, Message: bmsg}}}
+	out := Outputs{"State": Output{IO: IO{Name: "State", Type: base.BoolTypeName, Message: bmsg}}}
 	assert.Panics(t, func() { out.SetMessage("WrongPortName", bmsg) })
 }
 
 func TestOutputsSetMessageWrongMessageType(t *testing.T) {
 	bmsg := base.NewBoolMessage(true)
-	out := Outputs{"State": Output{IO{Name: "State", Type: base.BoolTypeName, Message: bmsg}}}
+	out := Outputs{"State": Output{IO: IO{Name: "State", Type: base.BoolTypeName, Message: bmsg}}}
 	smsg := base.NewStringMessage("Wrong message")
 	assert.Panics(t, func() { out.SetMessage("State", smsg) })
 }
@@ -69,3 +69,58 @@ func TestNewOutputsWithMissingRepresentation(t *testing.T) {
 	}
 	assert.Panics(t, func() { NewOutputs(outputsCfg) })
 }
+
+func TestOutputsConfigurePorts(t *testing.T) {
+	outputsCfg := config.Outputs{
+		config.Out{IO: config.IO{Name: "sensor-value", Type: "base/Bool", Representation: "application/json", Channel: "value-of-sensor-1"}},
+		config.Out{IO: config.IO{Name: "node-state", Type: "base/String", Representation: "application/json", Channel: "state-of-the-node"}},
+	}
+	outputs := NewOutputs(outputsCfg)
+	bmsg := base.NewBoolMessage(true)
+	outputs.SetMessage("sensor-value", bmsg)
+
+	configured := outputs.ConfigurePorts(config.Outputs{
+		config.Out{IO: config.IO{Name: "sensor-value", Type: "base/Bool", Representation: "application/json", Channel: "value-of-sensor-2"}},
+		config.Out{IO: config.IO{Name: "new-port", Type: "base/Float64", Representation: "application/json", Channel: "new-channel"}},
+	})
+	assert.Equal(t, len(configured), 3)
+	assert.Equal(t, "value-of-sensor-2", configured["sensor-value"].Channel)
+	assert.Equal(t, bmsg, configured.GetMessage("sensor-value"))
+	assert.Equal(t, "state-of-the-node", configured["node-state"].Channel)
+	assert.Equal(t, "new-channel", configured["new-port"].Channel)
+
+	// The original outputs must remain untouched
+	assert.Equal(t, len(outputs), 2)
+	assert.Equal(t, "value-of-sensor-1", outputs["sensor-value"].Channel)
+}
+
+func TestOutputsSetMessageMarksUpdated(t *testing.T) {
+	out := Outputs{"State": Output{IO: IO{Name: "State", Type: base.BoolTypeName, Channel: "state"}, Emit: config.EmitChange}}
+	bmsg := base.NewBoolMessage(true)
+	out.SetMessage("State", bmsg)
+	assert.True(t, out["State"].Updated)
+	assert.Equal(t, config.EmitChange, out["State"].Emit)
+	assert.Equal(t, "state", out["State"].Channel)
+
+	renewed := out.Renew()
+	assert.False(t, renewed["State"].Updated)
+	assert.Equal(t, bmsg, renewed["State"].Message)
+	assert.True(t, out["State"].Updated)
+}
+
+func TestNewOutputsWithChannels(t *testing.T) {
+	outputsCfg := config.Outputs{config.Out{
+		IO:       config.IO{Name: "level", Type: "base/Float64", Representation: "application/json", Channel: "level"},
+		Channels: []config.OutChannel{{Channel: "level-yaml", Representation: "application/yaml"}, {Channel: "level-copy"}},
+		Emit:     config.EmitChange,
+		Deadband: 0.5,
+	}}
+	output := NewOutputs(outputsCfg)["level"]
+	assert.Equal(t, []Channel{{Name: "level-yaml", Representation: msgs.YAMLRepresentation}, {Name: "level-copy", Representation: msgs.JSONRepresentation}}, output.Channels)
+	assert.Equal(t, config.EmitChange, output.Emit)
+	assert.Equal(t, 0.5, output.Deadband)
+	assert.False(t, output.Updated)
+
+	outputsCfg[0].Channels[0].Representation = "wrong/representation"
+	assert.Panics(t, func() { NewOutputs(outputsCfg) })
+}
@@ -35,7 +35,7 @@ type Messenger interface {
 	Subscribe(string, func([]byte)) Subscriber
 	ChanSubscribe(string, chan []byte) Subscriber
 	Request(subject string, msg []byte, timeout time.Duration) ([]byte, error)
-	Response(subject string, service func([]byte) ([]byte, error))
+	Response(subject string, service func([]byte) ([]byte, error)) Subscriber
 
 	// Durable channels
 	PublishDurable(string, []byte) error
@@ -80,8 +80,9 @@ func (m connections) Request(subject string, msg []byte, timeout time.Duration)
 
 // Subscribe to the `subject` topic, and calls the `service` call-back function with the inbound messages,
 // then respond with the return value of the `service` function through the `Reply` subject.
-func (m connections) Response(subject string, service func([]byte) ([]byte, error)) {
-	_, err := m.nc.Subscribe(subject, func(msg *nats.Msg) {
+// It returns with a Subscriber that can be used to stop responding.
+func (m connections) Response(subject string, service func([]byte) ([]byte, error)) messenger.Subscriber {
+	subscription, err := m.nc.Subscribe(subject, func(msg *nats.Msg) {
 		resp, err := service(msg.Data)
 		if err != nil {
 			if err := m.nc.Publish(msg.Reply, []byte(err.Error())); err != nil {
@@ -97,4 +98,5 @@ func (m connections) Response(subject string, service func([]byte) ([]byte, erro
 		panic(err)
 	}
 	m.nc.Flush()
+	return newSubscriber(subscription)
 }
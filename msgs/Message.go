@@ -14,7 +14,7 @@ const (
 	// AvroRepresentation `application/avro` Representation enum value
 	AvroRepresentation Representation = "application/avro"
 
-	// AvroRepresentation `application/x-protobuf` Representation enum value
+	// ProtobufRepresentation `application/x-protobuf` Representation enum value
 	ProtobufRepresentation Representation = "application/x-protobuf"
 
 	// OctetstreamRepresentation `application/octet-stream` Representation enum value
@@ -25,6 +25,24 @@ const (
 
 	// CSVRepresentation `text/csv` Representation enum value
 	CSVRepresentation Representation = "text/csv"
+
+	// CSVHeaderRepresentation `text/csv; header=present` Representation enum value, the CSV format with a header row
+	CSVHeaderRepresentation Representation = "text/csv; header=present"
+
+	// YAMLRepresentation `application/yaml` Representation enum value
+	YAMLRepresentation Representation = "application/yaml"
+
+	// GobRepresentation `application/x-gob` Representation enum value, the binary format of the Go `encoding/gob` package
+	GobRepresentation Representation = "application/x-gob"
+
+	// MsgpackRepresentation `application/msgpack` Representation enum value
+	MsgpackRepresentation Representation = "application/msgpack"
+
+	// CBORRepresentation `application/cbor` Representation enum value
+	CBORRepresentation Representation = "application/cbor"
+
+	// ROS1Representation `application/x-ros1` Representation enum value, the ROS1 wire-format (serialization) of the messages
+	ROS1Representation Representation = "application/x-ros1"
 )
 
 // Codec interface declares the methods that Encodes and Decodes the message to and from `Representation` format.
@@ -33,6 +51,13 @@ type Codec interface {
 	Decode(Representation, []byte) error
 }
 
+// ErrorCodec interface declares the variant of the Codec methods that return with error
+// instead of panicking when the message can not be encoded or decoded.
+type ErrorCodec interface {
+	EncodeE(Representation) ([]byte, error)
+	DecodeE(Representation, []byte) error
+}
+
 // JSONConverter interface declares the method that Marshals and Unmarshals the message to and from JSON representation.
 type JSONConverter interface {
 	JSON() []byte
@@ -51,12 +76,52 @@ type GobConverter interface {
 	DecodeGob([]byte) error
 }
 
+// XMLConverter interface declares the method that Marshals and Unmarshals the message to and from XML representation.
+type XMLConverter interface {
+	XML() []byte
+	ParseXML([]byte) error
+}
+
+// AvroConverter interface declares the method that Marshals and Unmarshals the message to and from Avro representation.
+type AvroConverter interface {
+	Avro() []byte
+	ParseAvro([]byte) error
+}
+
+// MsgpackConverter interface declares the method that Marshals and Unmarshals the message to and from MessagePack representation.
+type MsgpackConverter interface {
+	Msgpack() []byte
+	ParseMsgpack([]byte) error
+}
+
+// CBORConverter interface declares the method that Marshals and Unmarshals the message to and from CBOR representation.
+type CBORConverter interface {
+	CBOR() []byte
+	ParseCBOR([]byte) error
+}
+
+// CSVConverter interface declares the method that Marshals and Unmarshals the message to and from CSV representation.
+type CSVConverter interface {
+	CSV(withHeaderRow bool) []byte
+	ParseCSV([]byte) error
+}
+
+// TextConverter interface declares the method that Marshals and Unmarshals the message to and from plain text representation.
+type TextConverter interface {
+	Text() []byte
+	ParseText([]byte) error
+}
+
 // ProtobufConverter interface declares the method that Marshals and Unmarshals the message to and from Protobuf representation.
 type ProtobufConverter interface {
+	Protobuf() []byte
+	ParseProtobuf([]byte) error
 }
 
 // ROSConverter interface declares the method that Marshals and Unmarshals the message to and from ROS message format representation.
 type ROSConverter interface {
+	ROS1() []byte
+	ParseROS1([]byte) error
 }
 
 // Message is the generic interface of all messages used by the axon-go actors
@@ -73,8 +138,26 @@ type Message interface {
 	// JSONConverter interface declares the member functions for encoding and decoding the message in JSON representation
 	JSONConverter
 
-	//	YamlConverter
-	//	GobConverter
-	//	ProtobufConverter
+	// YamlConverter interface declares the member functions for encoding and decoding the message in YAML representation
+	YamlConverter
+
+	// GobConverter interface declares the member functions for encoding and decoding the message in Gob representation
+	GobConverter
+
+	// ProtobufConverter interface declares the member functions for encoding and decoding the message in Protobuf representation
+	ProtobufConverter
+
+	// XMLConverter interface declares the member functions for encoding and decoding the message in XML representation
+	XMLConverter
+
+	// AvroConverter interface declares the member functions for encoding and decoding the message in Avro representation
+	AvroConverter
+
+	// MsgpackConverter interface declares the member functions for encoding and decoding the message in MessagePack representation
+	MsgpackConverter
+
+	// CBORConverter interface declares the member functions for encoding and decoding the message in CBOR representation
+	CBORConverter
+
 	//	ROSConverter
 }
@@ -0,0 +1,88 @@
+// Package avro implements the Avro binary representation of the messages.
+//
+// The schemas are generated from the Go types of the messages by reflection (see `SchemaOf`).
+// The encoded messages use the Avro single object encoding: the content is preceded by
+// the two bytes long `0xC3 0x01` marker and the little-endian CRC-64-AVRO fingerprint of the writer schema,
+// so the receivers can reject the messages written by incompatible schemas.
+package avro
+
+import (
+	"encoding/binary"
+	"fmt"
+	"reflect"
+)
+
+// singleObjectMarker is the first two bytes of the single object encoded messages
+var singleObjectMarker = [2]byte{0xC3, 0x01}
+
+// headerSize is the size of the single object encoding header: the marker and the fingerprint
+const headerSize = len(singleObjectMarker) + 8
+
+// FingerprintMismatchError is returned if the writer schema of the message differs from the reader schema
+type FingerprintMismatchError struct {
+	// Writer is the fingerprint of the schema the message was encoded with
+	Writer uint64
+	// Reader is the fingerprint of the schema of the type the message is decoded into
+	Reader uint64
+	// Schema is the reader schema
+	Schema *Schema
+}
+
+// Error returns with the text of the error
+func (e *FingerprintMismatchError) Error() string {
+	return fmt.Sprintf("Avro decode error: the writer schema fingerprint %016x does not match the reader schema fingerprint %016x of '%s'", e.Writer, e.Reader, e.Schema)
+}
+
+// Marshal returns with the single object encoding of `v`
+func Marshal(v interface{}) ([]byte, error) {
+	schema, err := SchemaOf(v)
+	if err != nil {
+		return nil, err
+	}
+
+	e := encoder{buf: make([]byte, headerSize, 64)}
+	copy(e.buf, singleObjectMarker[:])
+	binary.LittleEndian.PutUint64(e.buf[len(singleObjectMarker):], schema.Fingerprint())
+	if err := e.encode(reflect.Indirect(reflect.ValueOf(v))); err != nil {
+		return nil, err
+	}
+	return e.buf, nil
+}
+
+// Unmarshal parses the single object encoded `content` into the value pointed to by `v`.
+// It returns with `FingerprintMismatchError` if the content was written by a different schema.
+func Unmarshal(content []byte, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("Avro decode error: non-pointer or nil target '%T'", v)
+	}
+	schema, err := SchemaOf(v)
+	if err != nil {
+		return err
+	}
+
+	writer, err := ReadFingerprint(content)
+	if err != nil {
+		return err
+	}
+	if writer != schema.Fingerprint() {
+		return &FingerprintMismatchError{Writer: writer, Reader: schema.Fingerprint(), Schema: schema}
+	}
+
+	d := decoder{buf: content[headerSize:]}
+	if err := d.decode(rv.Elem()); err != nil {
+		return err
+	}
+	if len(d.buf) > 0 {
+		return fmt.Errorf("Avro decode error: %d unexpected bytes after the content", len(d.buf))
+	}
+	return nil
+}
+
+// ReadFingerprint returns with the writer schema fingerprint of the single object encoded `content`
+func ReadFingerprint(content []byte) (uint64, error) {
+	if len(content) < headerSize || content[0] != singleObjectMarker[0] || content[1] != singleObjectMarker[1] {
+		return 0, fmt.Errorf("Avro decode error: the content is not in single object encoding")
+	}
+	return binary.LittleEndian.Uint64(content[len(singleObjectMarker):]), nil
+}
@@ -0,0 +1,101 @@
+package avro
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type testRecord struct {
+	Flag   bool
+	Count  int64
+	Ratio  float32
+	Value  float64
+	Text   string
+	Raw    []byte
+	List   []int
+	Dict   map[string]string
+	hidden string
+}
+
+var testRecordValue = testRecord{Flag: true, Count: -3, Ratio: 0.5, Value: 1.25, Text: "ab", Raw: []byte{1, 2}, List: []int{1, 2}, Dict: map[string]string{"k": "v"}}
+
+// testRecordBytes is the single object encoding of `testRecordValue`, made by the reference implementation
+var testRecordBytes = []byte{0xc3, 0x1, 0xb1, 0x7f, 0x30, 0x56, 0x34, 0x56, 0xf3, 0xb1, 0x1, 0x5, 0x0, 0x0, 0x0, 0x3f, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xf4, 0x3f, 0x4, 0x61, 0x62, 0x4, 0x1, 0x2, 0x4, 0x2, 0x4, 0x0, 0x2, 0x2, 0x6b, 0x2, 0x76, 0x0}
+
+func TestFingerprint64(t *testing.T) {
+	// The example of the Avro specification
+	assert.Equal(t, uint64(0x63dd24e7cc258f8a), Fingerprint64([]byte(`"null"`)))
+}
+
+func TestSchemaOf(t *testing.T) {
+	s, err := SchemaOf(&testRecord{})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"name":"avro.testRecord","type":"record","fields":[{"name":"Flag","type":"boolean"},{"name":"Count","type":"long"},{"name":"Ratio","type":"float"},{"name":"Value","type":"double"},{"name":"Text","type":"string"},{"name":"Raw","type":"bytes"},{"name":"List","type":{"type":"array","items":"long"}},{"name":"Dict","type":{"type":"map","values":"string"}}]}`, s.String())
+	assert.Equal(t, uint64(0xb1f3563456307fb1), s.Fingerprint())
+
+	_, err = SchemaOf(map[int]string{})
+	assert.NotNil(t, err)
+	_, err = SchemaOf(struct{ Ch chan int }{})
+	assert.NotNil(t, err)
+}
+
+func TestSchemaOfNamedTypes(t *testing.T) {
+	type pair struct {
+		First  testRecord
+		Second testRecord
+	}
+	s, err := SchemaOf(pair{})
+	assert.Nil(t, err)
+	assert.Contains(t, s.String(), `{"name":"Second","type":"avro.testRecord"}`)
+
+	s, err = SchemaOf(map[string]interface{}{})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"type":"map","values":{"name":"avro.AnyValue","type":"record","fields":[{"name":"value","type":["null","boolean","double","string",{"type":"array","items":"avro.AnyValue"},{"type":"map","values":"avro.AnyValue"}]}]}}`, s.String())
+}
+
+func TestMarshal(t *testing.T) {
+	content, err := Marshal(testRecordValue)
+	assert.Nil(t, err)
+	assert.Equal(t, testRecordBytes, content)
+
+	fingerprint, err := ReadFingerprint(content)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0xb1f3563456307fb1), fingerprint)
+}
+
+func TestUnmarshal(t *testing.T) {
+	var decoded testRecord
+	assert.Nil(t, Unmarshal(testRecordBytes, &decoded))
+	assert.Equal(t, testRecordValue, decoded)
+
+	assert.NotNil(t, Unmarshal(testRecordBytes, decoded))
+	assert.NotNil(t, Unmarshal(testRecordBytes[:20], &decoded))
+	assert.NotNil(t, Unmarshal(append(testRecordBytes, 0), &decoded))
+	assert.NotNil(t, Unmarshal([]byte{0x01, 0x02}, &decoded))
+
+	type otherRecord struct{ Flag bool }
+	var other otherRecord
+	err := Unmarshal(testRecordBytes, &other)
+	assert.IsType(t, &FingerprintMismatchError{}, err)
+	assert.Equal(t, uint64(0xb1f3563456307fb1), err.(*FingerprintMismatchError).Writer)
+}
+
+func TestAnyValueCodec(t *testing.T) {
+	value := map[string]interface{}{
+		"text":   "some text",
+		"flag":   false,
+		"number": 1.5,
+		"null":   nil,
+		"list":   []interface{}{-2.0, "two", []interface{}{}},
+		"object": map[string]interface{}{"nested": map[string]interface{}{}},
+	}
+	content, err := Marshal(value)
+	assert.Nil(t, err)
+
+	var decoded map[string]interface{}
+	assert.Nil(t, Unmarshal(content, &decoded))
+	assert.Equal(t, value, decoded)
+
+	_, err = Marshal(map[string]interface{}{"channel": make(chan int)})
+	assert.NotNil(t, err)
+}
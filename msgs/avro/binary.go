@@ -0,0 +1,364 @@
+package avro
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"sort"
+)
+
+// objectType is the type of the JSON-like objects held by the `AnyValue` records
+var objectType = reflect.TypeOf(map[string]interface{}{})
+
+// encoder writes the Avro binary encoding of the Go values according to the schemas generated by `SchemaOf`
+type encoder struct {
+	buf []byte
+}
+
+// writeLong writes a zig-zag encoded variable-length `long` value
+func (e *encoder) writeLong(v int64) {
+	var b [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(b[:], uint64((v<<1)^(v>>63)))
+	e.buf = append(e.buf, b[:n]...)
+}
+
+// writeBytes writes a length-prefixed `bytes` or `string` value
+func (e *encoder) writeBytes(v []byte) {
+	e.writeLong(int64(len(v)))
+	e.buf = append(e.buf, v...)
+}
+
+// encode writes the `v` value
+func (e *encoder) encode(v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			e.buf = append(e.buf, 1)
+		} else {
+			e.buf = append(e.buf, 0)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		e.writeLong(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		e.writeLong(int64(v.Uint()))
+	case reflect.Float32:
+		var b [4]byte
+		binary.LittleEndian.PutUint32(b[:], math.Float32bits(float32(v.Float())))
+		e.buf = append(e.buf, b[:]...)
+	case reflect.Float64:
+		var b [8]byte
+		binary.LittleEndian.PutUint64(b[:], math.Float64bits(v.Float()))
+		e.buf = append(e.buf, b[:]...)
+	case reflect.String:
+		e.writeBytes([]byte(v.String()))
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			b := make([]byte, v.Len())
+			reflect.Copy(reflect.ValueOf(b), v)
+			e.writeBytes(b)
+			return nil
+		}
+		if v.Len() > 0 {
+			e.writeLong(int64(v.Len()))
+			for i := 0; i < v.Len(); i++ {
+				if err := e.encode(v.Index(i)); err != nil {
+					return err
+				}
+			}
+		}
+		e.writeLong(0)
+	case reflect.Map:
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+		if len(keys) > 0 {
+			e.writeLong(int64(len(keys)))
+			for _, key := range keys {
+				e.writeBytes([]byte(key.String()))
+				if err := e.encode(v.MapIndex(key)); err != nil {
+					return err
+				}
+			}
+		}
+		e.writeLong(0)
+	case reflect.Struct:
+		for _, field := range exportedFields(v.Type()) {
+			if err := e.encode(v.FieldByIndex(field.Index)); err != nil {
+				return err
+			}
+		}
+	case reflect.Interface:
+		return e.encodeAnyValue(v.Interface())
+	default:
+		return fmt.Errorf("Avro encode error: unsupported type '%s'", v.Type())
+	}
+	return nil
+}
+
+// encodeAnyValue writes the `value` as an `AnyValue` union
+func (e *encoder) encodeAnyValue(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		e.writeLong(anyNullIndex)
+	case bool:
+		e.writeLong(anyBooleanIndex)
+		return e.encode(reflect.ValueOf(v))
+	case float32, float64, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		e.writeLong(anyDoubleIndex)
+		return e.encode(reflect.ValueOf(reflect.ValueOf(v).Convert(reflect.TypeOf(float64(0))).Float()))
+	case string:
+		e.writeLong(anyStringIndex)
+		e.writeBytes([]byte(v))
+	case []interface{}:
+		e.writeLong(anyArrayIndex)
+		return e.encode(reflect.ValueOf(v))
+	case map[string]interface{}:
+		e.writeLong(anyMapIndex)
+		return e.encode(reflect.ValueOf(v))
+	case map[interface{}]interface{}:
+		// The YAML decoder produces this kind of maps for the nested objects
+		object := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			object[fmt.Sprint(key)] = item
+		}
+		e.writeLong(anyMapIndex)
+		return e.encode(reflect.ValueOf(object))
+	default:
+		// The named object types, e.g. `base.Any`
+		if rv := reflect.ValueOf(value); rv.Kind() == reflect.Map && rv.Type().ConvertibleTo(objectType) {
+			e.writeLong(anyMapIndex)
+			return e.encode(rv.Convert(objectType))
+		}
+		return fmt.Errorf("Avro encode error: unsupported value type '%T'", value)
+	}
+	return nil
+}
+
+// decoder reads the Avro binary encoding of the Go values according to the schemas generated by `SchemaOf`
+type decoder struct {
+	buf []byte
+}
+
+// readLong reads a zig-zag encoded variable-length `long` value
+func (d *decoder) readLong() (int64, error) {
+	u, n := binary.Uvarint(d.buf)
+	if n <= 0 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	d.buf = d.buf[n:]
+	return int64(u>>1) ^ -int64(u&1), nil
+}
+
+// readFixed reads `size` bytes
+func (d *decoder) readFixed(size int) ([]byte, error) {
+	if size < 0 || size > len(d.buf) {
+		return nil, io.ErrUnexpectedEOF
+	}
+	b := d.buf[:size]
+	d.buf = d.buf[size:]
+	return b, nil
+}
+
+// readBytes reads a length-prefixed `bytes` or `string` value
+func (d *decoder) readBytes() ([]byte, error) {
+	size, err := d.readLong()
+	if err != nil {
+		return nil, err
+	}
+	if size < 0 || size > int64(len(d.buf)) {
+		return nil, io.ErrUnexpectedEOF
+	}
+	return d.readFixed(int(size))
+}
+
+// readBlocks reads the blocks of an `array` or a `map`, and calls `item` for each of the items
+func (d *decoder) readBlocks(item func() error) error {
+	for {
+		count, err := d.readLong()
+		if err != nil {
+			return err
+		}
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			// The negative count is followed by the size of the block in bytes, that is not used here
+			count = -count
+			if _, err := d.readLong(); err != nil {
+				return err
+			}
+		}
+		// Every item takes at least one byte, except the empty records
+		if count > int64(len(d.buf))+1 {
+			return io.ErrUnexpectedEOF
+		}
+		for i := int64(0); i < count; i++ {
+			if err := item(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// decode reads the value into `v`
+func (d *decoder) decode(v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.Bool:
+		b, err := d.readFixed(1)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b[0] != 0)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		l, err := d.readLong()
+		if err != nil {
+			return err
+		}
+		v.SetInt(l)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		l, err := d.readLong()
+		if err != nil {
+			return err
+		}
+		v.SetUint(uint64(l))
+	case reflect.Float32:
+		b, err := d.readFixed(4)
+		if err != nil {
+			return err
+		}
+		v.SetFloat(float64(math.Float32frombits(binary.LittleEndian.Uint32(b))))
+	case reflect.Float64:
+		b, err := d.readFixed(8)
+		if err != nil {
+			return err
+		}
+		v.SetFloat(math.Float64frombits(binary.LittleEndian.Uint64(b)))
+	case reflect.String:
+		b, err := d.readBytes()
+		if err != nil {
+			return err
+		}
+		v.SetString(string(b))
+	case reflect.Slice:
+		return d.decodeSlice(v)
+	case reflect.Array:
+		return d.decodeArray(v)
+	case reflect.Map:
+		m := reflect.MakeMap(v.Type())
+		err := d.readBlocks(func() error {
+			key, err := d.readBytes()
+			if err != nil {
+				return err
+			}
+			item := reflect.New(v.Type().Elem()).Elem()
+			if err := d.decode(item); err != nil {
+				return err
+			}
+			m.SetMapIndex(reflect.ValueOf(string(key)).Convert(v.Type().Key()), item)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		v.Set(m)
+	case reflect.Struct:
+		for _, field := range exportedFields(v.Type()) {
+			if err := d.decode(v.FieldByIndex(field.Index)); err != nil {
+				return err
+			}
+		}
+	case reflect.Interface:
+		value, err := d.decodeAnyValue()
+		if err != nil {
+			return err
+		}
+		if value != nil {
+			v.Set(reflect.ValueOf(value))
+		}
+	default:
+		return fmt.Errorf("Avro decode error: unsupported type '%s'", v.Type())
+	}
+	return nil
+}
+
+// decodeSlice reads an `array` or a `bytes` value into the `v` slice.
+// The `bytes` are never nil, the `array` is nil if it has no items.
+func (d *decoder) decodeSlice(v reflect.Value) error {
+	if v.Type().Elem().Kind() == reflect.Uint8 {
+		b, err := d.readBytes()
+		if err != nil {
+			return err
+		}
+		s := reflect.MakeSlice(v.Type(), len(b), len(b))
+		reflect.Copy(s, reflect.ValueOf(b))
+		v.Set(s)
+		return nil
+	}
+
+	s := reflect.Zero(v.Type())
+	err := d.readBlocks(func() error {
+		item := reflect.New(v.Type().Elem()).Elem()
+		if err := d.decode(item); err != nil {
+			return err
+		}
+		s = reflect.Append(s, item)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	v.Set(s)
+	return nil
+}
+
+// decodeArray reads an `array` or a `bytes` value into the `v` fixed size Go array
+func (d *decoder) decodeArray(v reflect.Value) error {
+	s := reflect.New(reflect.SliceOf(v.Type().Elem())).Elem()
+	if err := d.decodeSlice(s); err != nil {
+		return err
+	}
+	if s.Len() != v.Len() {
+		return fmt.Errorf("Avro decode error: expected %d items for '%s', got %d", v.Len(), v.Type(), s.Len())
+	}
+	reflect.Copy(v, s)
+	return nil
+}
+
+// decodeAnyValue reads an `AnyValue` union. The numbers are decoded as `float64` values.
+func (d *decoder) decodeAnyValue() (interface{}, error) {
+	index, err := d.readLong()
+	if err != nil {
+		return nil, err
+	}
+
+	switch index {
+	case anyNullIndex:
+		return nil, nil
+	case anyBooleanIndex:
+		var b bool
+		err = d.decode(reflect.ValueOf(&b).Elem())
+		return b, err
+	case anyDoubleIndex:
+		var f float64
+		err = d.decode(reflect.ValueOf(&f).Elem())
+		return f, err
+	case anyStringIndex:
+		b, err := d.readBytes()
+		return string(b), err
+	case anyArrayIndex:
+		list := []interface{}{}
+		err = d.readBlocks(func() error {
+			item, err := d.decodeAnyValue()
+			list = append(list, item)
+			return err
+		})
+		return list, err
+	case anyMapIndex:
+		object := map[string]interface{}{}
+		err = d.decode(reflect.ValueOf(&object).Elem())
+		return object, err
+	default:
+		return nil, fmt.Errorf("Avro decode error: unknown union branch %d", index)
+	}
+}
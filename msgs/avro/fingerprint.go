@@ -0,0 +1,25 @@
+package avro
+
+// emptyFingerprint is the CRC-64-AVRO fingerprint of the empty content, according to the Avro specification
+const emptyFingerprint uint64 = 0xc15d213aa4d7a795
+
+// fingerprintTable is the lookup table of the CRC-64-AVRO fingerprint calculation
+var fingerprintTable = func() (table [256]uint64) {
+	for i := range table {
+		fp := uint64(i)
+		for j := 0; j < 8; j++ {
+			fp = (fp >> 1) ^ (emptyFingerprint & -(fp & 1))
+		}
+		table[i] = fp
+	}
+	return table
+}()
+
+// Fingerprint64 returns with the CRC-64-AVRO (Rabin) fingerprint of the `content`
+func Fingerprint64(content []byte) uint64 {
+	fp := emptyFingerprint
+	for _, b := range content {
+		fp = (fp >> 8) ^ fingerprintTable[byte(fp)^b]
+	}
+	return fp
+}
@@ -0,0 +1,204 @@
+package avro
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// anyValueName is the full name of the recursive record, that holds the `interface{}` values
+const anyValueName = "avro.AnyValue"
+
+// The indexes of the branches of the `AnyValue` union
+const (
+	anyNullIndex = iota
+	anyBooleanIndex
+	anyDoubleIndex
+	anyStringIndex
+	anyArrayIndex
+	anyMapIndex
+)
+
+// Schema is the Avro schema generated from a Go type
+type Schema struct {
+	canonical   []byte
+	fingerprint uint64
+}
+
+// schemas caches the schemas of the Go types
+var schemas sync.Map
+
+// SchemaOf returns with the Avro schema of the type of `v`.
+//
+// The schema is generated from the Go type the following way:
+//   - `bool` is `boolean`, the integers are `long`, `float32` is `float`, `float64` is `double`,
+//   - `string` is `string` and `[]byte` is `bytes`,
+//   - the slices and arrays are `array`, the maps with `string` keys are `map`,
+//   - the structs are `record`, named by the package and the type name, e.g. `common.Header`,
+//     the fields are named by the exported fields of the struct,
+//   - `interface{}` is the recursive `avro.AnyValue` record, that holds a JSON-like value.
+//
+// The pointer is allowed only on the top level.
+func SchemaOf(v interface{}) (*Schema, error) {
+	t := reflect.TypeOf(v)
+	if t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return schemaOfType(t)
+}
+
+// schemaOfType returns with the cached schema of the `t` type, or generates it
+func schemaOfType(t reflect.Type) (*Schema, error) {
+	if t == nil {
+		return nil, fmt.Errorf("Avro schema error: unsupported nil type")
+	}
+	if s, ok := schemas.Load(t); ok {
+		return s.(*Schema), nil
+	}
+
+	g := schemaGenerator{defined: map[string]bool{}}
+	if err := g.generate(t); err != nil {
+		return nil, err
+	}
+	s := &Schema{canonical: g.buf.Bytes()}
+	s.fingerprint = Fingerprint64(s.canonical)
+	schemas.Store(t, s)
+	return s, nil
+}
+
+// Canonical returns with the schema in Parsing Canonical Form
+func (s *Schema) Canonical() []byte {
+	return s.canonical
+}
+
+// JSON returns with the schema in indented JSON format
+func (s *Schema) JSON() []byte {
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, s.canonical, "", "  "); err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
+// String returns with the schema in Parsing Canonical Form
+func (s *Schema) String() string {
+	return string(s.canonical)
+}
+
+// Fingerprint returns with the CRC-64-AVRO fingerprint of the Parsing Canonical Form of the schema
+func (s *Schema) Fingerprint() uint64 {
+	return s.fingerprint
+}
+
+// schemaGenerator writes the schema of a Go type in Parsing Canonical Form.
+// The named types are defined at their first occurrence, and referred by their names later on.
+type schemaGenerator struct {
+	buf     bytes.Buffer
+	defined map[string]bool
+}
+
+// generate writes the schema of the `t` type
+func (g *schemaGenerator) generate(t reflect.Type) error {
+	switch t.Kind() {
+	case reflect.Bool:
+		g.buf.WriteString(`"boolean"`)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		g.buf.WriteString(`"long"`)
+	case reflect.Float32:
+		g.buf.WriteString(`"float"`)
+	case reflect.Float64:
+		g.buf.WriteString(`"double"`)
+	case reflect.String:
+		g.buf.WriteString(`"string"`)
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			g.buf.WriteString(`"bytes"`)
+			return nil
+		}
+		g.buf.WriteString(`{"type":"array","items":`)
+		if err := g.generate(t.Elem()); err != nil {
+			return err
+		}
+		g.buf.WriteString(`}`)
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String {
+			return fmt.Errorf("Avro schema error: unsupported map key type '%s'", t.Key())
+		}
+		g.buf.WriteString(`{"type":"map","values":`)
+		if err := g.generate(t.Elem()); err != nil {
+			return err
+		}
+		g.buf.WriteString(`}`)
+	case reflect.Struct:
+		return g.generateRecord(t)
+	case reflect.Interface:
+		g.generateAnyValue()
+	default:
+		return fmt.Errorf("Avro schema error: unsupported type '%s'", t)
+	}
+	return nil
+}
+
+// generateRecord writes the `record` schema of the `t` struct type
+func (g *schemaGenerator) generateRecord(t reflect.Type) error {
+	if t.Name() == "" {
+		return fmt.Errorf("Avro schema error: unsupported anonymous struct '%s'", t)
+	}
+	name := path.Base(t.PkgPath()) + "." + t.Name()
+	if g.defined[name] {
+		g.buf.WriteString(strconv.Quote(name))
+		return nil
+	}
+	g.defined[name] = true
+
+	g.buf.WriteString(`{"name":` + strconv.Quote(name) + `,"type":"record","fields":[`)
+	for i, field := range exportedFields(t) {
+		if i > 0 {
+			g.buf.WriteString(`,`)
+		}
+		g.buf.WriteString(`{"name":` + strconv.Quote(field.Name) + `,"type":`)
+		if err := g.generate(field.Type); err != nil {
+			return err
+		}
+		g.buf.WriteString(`}`)
+	}
+	g.buf.WriteString(`]}`)
+	return nil
+}
+
+// generateAnyValue writes the schema of the recursive record that holds the `interface{}` values
+func (g *schemaGenerator) generateAnyValue() {
+	name := strconv.Quote(anyValueName)
+	if g.defined[anyValueName] {
+		g.buf.WriteString(name)
+		return
+	}
+	g.defined[anyValueName] = true
+
+	branches := []string{
+		anyNullIndex:    `"null"`,
+		anyBooleanIndex: `"boolean"`,
+		anyDoubleIndex:  `"double"`,
+		anyStringIndex:  `"string"`,
+		anyArrayIndex:   `{"type":"array","items":` + name + `}`,
+		anyMapIndex:     `{"type":"map","values":` + name + `}`,
+	}
+	g.buf.WriteString(`{"name":` + name + `,"type":"record","fields":[{"name":"value","type":[` + strings.Join(branches, ",") + `]}]}`)
+}
+
+// exportedFields returns with the exported fields of the `t` struct type in the order of their declaration
+func exportedFields(t reflect.Type) []reflect.StructField {
+	fields := []reflect.StructField{}
+	for i := 0; i < t.NumField(); i++ {
+		if field := t.Field(i); field.PkgPath == "" {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
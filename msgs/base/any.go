@@ -1,9 +1,19 @@
 package base
 
 import (
+	"bytes"
+	"encoding/gob"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
+	"github.com/fxamacker/cbor/v2"
 	"github.com/tombenke/axon-go-common/msgs"
+	"github.com/tombenke/axon-go-common/msgs/avro"
+	"github.com/vmihailenco/msgpack/v5"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"gopkg.in/yaml.v2"
+	"reflect"
 )
 
 const (
@@ -12,11 +22,25 @@ const (
 )
 
 func init() {
-	msgs.RegisterMessageType(AnyTypeName, []msgs.Representation{msgs.JSONRepresentation}, func() msgs.Message {
+	// The nested objects and arrays have to be registered to Gob, because the values of `Any` are interfaces
+	gob.Register(map[string]interface{}{})
+	gob.Register([]interface{}{})
+
+	msgs.RegisterMessageType(AnyTypeName, []msgs.Representation{msgs.JSONRepresentation, msgs.YAMLRepresentation, msgs.GobRepresentation, msgs.ProtobufRepresentation, msgs.XMLRepresentation, msgs.AvroRepresentation, msgs.MsgpackRepresentation, msgs.CBORRepresentation}, func() msgs.Message {
 		return NewAnyMessage(map[string]interface{}{})
 	})
+	msgs.SetMessageTypeDescription(AnyTypeName, "Generic message that may contain anything")
 }
 
+// anyCBORDecMode decodes the nested CBOR maps into `map[string]interface{}` values, like the JSON decoder does
+var anyCBORDecMode = func() cbor.DecMode {
+	decMode, err := cbor.DecOptions{DefaultMapType: reflect.TypeOf(map[string]interface{}{})}.DecMode()
+	if err != nil {
+		panic(err)
+	}
+	return decMode
+}()
+
 // Any represents the structure of a generic message that may contain anything
 type Any map[string]interface{}
 
@@ -34,6 +58,20 @@ func (msg *Any) Encode(representation msgs.Representation) (results []byte) {
 		if err != nil {
 			panic(err)
 		}
+	case msgs.YAMLRepresentation:
+		results = msg.YAML()
+	case msgs.GobRepresentation:
+		results = msg.EncodeGob()
+	case msgs.ProtobufRepresentation:
+		results = msg.Protobuf()
+	case msgs.XMLRepresentation:
+		results = msg.XML()
+	case msgs.AvroRepresentation:
+		results = msg.Avro()
+	case msgs.MsgpackRepresentation:
+		results = msg.Msgpack()
+	case msgs.CBORRepresentation:
+		results = msg.CBOR()
 	default:
 		panic(fmt.Errorf("Encode error: unknown representational format '%s'", representation))
 	}
@@ -45,6 +83,20 @@ func (msg *Any) Decode(representation msgs.Representation, content []byte) error
 	switch representation {
 	case msgs.JSONRepresentation:
 		return json.Unmarshal(content, msg)
+	case msgs.YAMLRepresentation:
+		return msg.ParseYAML(content)
+	case msgs.GobRepresentation:
+		return msg.DecodeGob(content)
+	case msgs.ProtobufRepresentation:
+		return msg.ParseProtobuf(content)
+	case msgs.XMLRepresentation:
+		return msg.ParseXML(content)
+	case msgs.AvroRepresentation:
+		return msg.ParseAvro(content)
+	case msgs.MsgpackRepresentation:
+		return msg.ParseMsgpack(content)
+	case msgs.CBORRepresentation:
+		return msg.ParseCBOR(content)
 	default:
 		panic(fmt.Errorf("Decode error: unknown representational format '%s'", representation))
 	}
@@ -73,6 +125,140 @@ func (msg *Any) ParseJSON(jsonBytes []byte) error {
 	return json.Unmarshal(jsonBytes, msg)
 }
 
+// Protobuf returns with the `Any` message content in protobuf representation format,
+// that is encoded as a `google.protobuf.Struct` well-known type.
+func (msg *Any) Protobuf() []byte {
+	s, err := structpb.NewStruct(*msg)
+	if err != nil {
+		panic(err)
+	}
+	protobufBytes, err := proto.Marshal(s)
+	if err != nil {
+		panic(err)
+	}
+	return protobufBytes
+}
+
+// ParseProtobuf parses the protobuf representation of a `Any` messages from the `protobufBytes` argument.
+func (msg *Any) ParseProtobuf(protobufBytes []byte) error {
+	var s structpb.Struct
+	if err := proto.Unmarshal(protobufBytes, &s); err != nil {
+		return err
+	}
+	*msg = s.AsMap()
+	return nil
+}
+
+// YAML returns with the `Any` message content in YAML representation format
+func (msg *Any) YAML() []byte {
+	yamlBytes, err := yaml.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return yamlBytes
+}
+
+// ParseYAML parses the YAML representation of a `Any` messages from the `yamlBytes` argument.
+func (msg *Any) ParseYAML(yamlBytes []byte) error {
+	return yaml.Unmarshal(yamlBytes, msg)
+}
+
+// EncodeGob returns with the `Any` message content in Gob representation format
+func (msg *Any) EncodeGob() []byte {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(*msg); err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
+// DecodeGob parses the Gob representation of a `Any` messages from the `gobBytes` argument.
+// Gob omits the zero values, so the message is replaced as a whole by the decoded one.
+func (msg *Any) DecodeGob(gobBytes []byte) error {
+	var decoded Any
+	if err := gob.NewDecoder(bytes.NewReader(gobBytes)).Decode(&decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// XML returns with the `Any` message content in XML representation format
+func (msg *Any) XML() []byte {
+	xmlBytes, err := xml.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return xmlBytes
+}
+
+// ParseXML parses the XML representation of a `Any` messages from the `xmlBytes` argument.
+func (msg *Any) ParseXML(xmlBytes []byte) error {
+	var decoded Any
+	if err := xml.Unmarshal(xmlBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// Avro returns with the `Any` message content in Avro representation format
+func (msg *Any) Avro() []byte {
+	avroBytes, err := avro.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return avroBytes
+}
+
+// ParseAvro parses the Avro representation of a `Any` messages from the `avroBytes` argument.
+func (msg *Any) ParseAvro(avroBytes []byte) error {
+	var decoded Any
+	if err := avro.Unmarshal(avroBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// Msgpack returns with the `Any` message content in MessagePack representation format
+func (msg *Any) Msgpack() []byte {
+	msgpackBytes, err := msgpack.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return msgpackBytes
+}
+
+// ParseMsgpack parses the MessagePack representation of a `Any` messages from the `msgpackBytes` argument.
+func (msg *Any) ParseMsgpack(msgpackBytes []byte) error {
+	var decoded Any
+	if err := msgpack.Unmarshal(msgpackBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// CBOR returns with the `Any` message content in CBOR representation format
+func (msg *Any) CBOR() []byte {
+	cborBytes, err := cbor.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return cborBytes
+}
+
+// ParseCBOR parses the CBOR representation of a `Any` messages from the `cborBytes` argument.
+func (msg *Any) ParseCBOR(cborBytes []byte) error {
+	var decoded Any
+	if err := anyCBORDecMode.Unmarshal(cborBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
 // NewAnyMessage returns with a new `Any` message. The header will contain the current time in `Nanoseconds` precision.
 func NewAnyMessage(data map[string]interface{}) msgs.Message {
 	var msg Any = data
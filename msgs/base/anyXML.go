@@ -0,0 +1,219 @@
+package base
+
+import (
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// This file implements the XML representation of the `Any` message.
+// The content is a tree of value elements, similar to the JSON values:
+//
+//	<Any>
+//	  <Entry Key="name"><String>sensor</String></Entry>
+//	  <Entry Key="value"><Number>42.5</Number></Entry>
+//	  <Entry Key="tags"><List><String>a</String><Bool>true</Bool><Null/></List></Entry>
+//	  <Entry Key="position"><Object><Entry Key="x"><Number>1</Number></Entry></Object></Entry>
+//	</Any>
+//
+// The numbers are decoded as `float64` values, like in case of the JSON representation.
+
+// The names of the XML elements of the `Any` message
+const (
+	anyXMLRoot   = "Any"
+	anyXMLEntry  = "Entry"
+	anyXMLKey    = "Key"
+	anyXMLString = "String"
+	anyXMLNumber = "Number"
+	anyXMLBool   = "Bool"
+	anyXMLNull   = "Null"
+	anyXMLObject = "Object"
+	anyXMLList   = "List"
+)
+
+// MarshalXML encodes the `Any` message into XML. The entries are written in the order of their keys.
+func (msg Any) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{Local: anyXMLRoot}
+	if err := encodeAnyXMLObject(e, start, map[string]interface{}(msg)); err != nil {
+		return err
+	}
+	return e.Flush()
+}
+
+// UnmarshalXML decodes the `Any` message from XML
+func (msg *Any) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	object, err := decodeAnyXMLObject(d)
+	if err != nil {
+		return err
+	}
+	*msg = object
+	return nil
+}
+
+// encodeAnyXMLObject writes the entries of the `object` into the `start` element
+func encodeAnyXMLObject(e *xml.Encoder, start xml.StartElement, object map[string]interface{}) error {
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, key := range keys {
+		entry := xml.StartElement{
+			Name: xml.Name{Local: anyXMLEntry},
+			Attr: []xml.Attr{{Name: xml.Name{Local: anyXMLKey}, Value: key}},
+		}
+		if err := e.EncodeToken(entry); err != nil {
+			return err
+		}
+		if err := encodeAnyXMLValue(e, object[key]); err != nil {
+			return err
+		}
+		if err := e.EncodeToken(entry.End()); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// encodeAnyXMLValue writes the `value` as a value element
+func encodeAnyXMLValue(e *xml.Encoder, value interface{}) error {
+	element := func(name string) xml.StartElement {
+		return xml.StartElement{Name: xml.Name{Local: name}}
+	}
+
+	switch v := value.(type) {
+	case nil:
+		return e.EncodeElement("", element(anyXMLNull))
+	case string:
+		return e.EncodeElement(v, element(anyXMLString))
+	case bool:
+		return e.EncodeElement(strconv.FormatBool(v), element(anyXMLBool))
+	case float64:
+		return e.EncodeElement(strconv.FormatFloat(v, 'g', -1, 64), element(anyXMLNumber))
+	case float32:
+		return e.EncodeElement(strconv.FormatFloat(float64(v), 'g', -1, 32), element(anyXMLNumber))
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return e.EncodeElement(fmt.Sprint(v), element(anyXMLNumber))
+	case map[string]interface{}:
+		return encodeAnyXMLObject(e, element(anyXMLObject), v)
+	case Any:
+		return encodeAnyXMLObject(e, element(anyXMLObject), v)
+	case map[interface{}]interface{}:
+		// The YAML decoder produces this kind of maps for the nested objects
+		object := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			object[fmt.Sprint(key)] = item
+		}
+		return encodeAnyXMLObject(e, element(anyXMLObject), object)
+	case []interface{}:
+		list := element(anyXMLList)
+		if err := e.EncodeToken(list); err != nil {
+			return err
+		}
+		for _, item := range v {
+			if err := encodeAnyXMLValue(e, item); err != nil {
+				return err
+			}
+		}
+		return e.EncodeToken(list.End())
+	default:
+		return fmt.Errorf("XML encode error: unsupported value type '%T'", value)
+	}
+}
+
+// decodeAnyXMLObject reads the `Entry` elements of an object until the end of the enclosing element
+func decodeAnyXMLObject(d *xml.Decoder) (map[string]interface{}, error) {
+	object := map[string]interface{}{}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			if t.Name.Local != anyXMLEntry {
+				return nil, fmt.Errorf("XML decode error: unexpected element '%s' in object", t.Name.Local)
+			}
+			key, found := xmlAttr(t, anyXMLKey)
+			if !found {
+				return nil, fmt.Errorf("XML decode error: entry without '%s' attribute", anyXMLKey)
+			}
+			values, err := decodeAnyXMLValues(d)
+			if err != nil {
+				return nil, err
+			}
+			if len(values) != 1 {
+				return nil, fmt.Errorf("XML decode error: the '%s' entry must hold exactly one value, but it holds %d", key, len(values))
+			}
+			object[key] = values[0]
+		case xml.EndElement:
+			return object, nil
+		}
+	}
+}
+
+// decodeAnyXMLValues reads the value elements until the end of the enclosing element
+func decodeAnyXMLValues(d *xml.Decoder) ([]interface{}, error) {
+	values := []interface{}{}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			value, err := decodeAnyXMLValue(d, t)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+		case xml.EndElement:
+			return values, nil
+		}
+	}
+}
+
+// decodeAnyXMLValue reads the value of the `start` element
+func decodeAnyXMLValue(d *xml.Decoder, start xml.StartElement) (interface{}, error) {
+	switch start.Name.Local {
+	case anyXMLObject:
+		return decodeAnyXMLObject(d)
+	case anyXMLList:
+		return decodeAnyXMLValues(d)
+	}
+
+	var text string
+	if err := d.DecodeElement(&text, &start); err != nil {
+		return nil, err
+	}
+
+	switch start.Name.Local {
+	case anyXMLNull:
+		return nil, nil
+	case anyXMLString:
+		return text, nil
+	case anyXMLBool:
+		return strconv.ParseBool(text)
+	case anyXMLNumber:
+		return strconv.ParseFloat(text, 64)
+	default:
+		return nil, fmt.Errorf("XML decode error: unknown value element '%s'", start.Name.Local)
+	}
+}
+
+// xmlAttr returns with the value of the `name` attribute of the `element`
+func xmlAttr(element xml.StartElement, name string) (string, bool) {
+	for _, attr := range element.Attr {
+		if attr.Name.Local == name {
+			return attr.Value, true
+		}
+	}
+	return "", false
+}
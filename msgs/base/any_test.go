@@ -32,6 +32,71 @@ func TestAnyMessageCodec(t *testing.T) {
 	assert.Equal(t, m, &n)
 }
 
+func TestAnyMessageProtobufCodec(t *testing.T) {
+	m := NewAnyMessage(map[string]interface{}{"text": "some text", "object": map[string]interface{}{"list": []interface{}{1.5, "two", nil}}})
+	var n Any
+	err := n.Decode(msgs.ProtobufRepresentation, m.Encode(msgs.ProtobufRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestAnyMessageYAMLCodec(t *testing.T) {
+	m := NewAnyMessage(map[string]interface{}{"text": "some text", "flag": true})
+	var n Any
+	err := n.Decode(msgs.YAMLRepresentation, m.Encode(msgs.YAMLRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestAnyMessageGobCodec(t *testing.T) {
+	m := NewAnyMessage(map[string]interface{}{"text": "some text", "object": map[string]interface{}{"list": []interface{}{1.5, "two"}}})
+	var n Any
+	err := n.Decode(msgs.GobRepresentation, m.Encode(msgs.GobRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestAnyMessageAvroCodec(t *testing.T) {
+	m := NewAnyMessage(map[string]interface{}{"text": "some text", "object": map[string]interface{}{"list": []interface{}{1.5, "two", nil, false}}})
+	var n Any
+	err := n.Decode(msgs.AvroRepresentation, m.Encode(msgs.AvroRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestAnyMessageMsgpackCodec(t *testing.T) {
+	m := NewAnyMessage(map[string]interface{}{"text": "some text", "object": map[string]interface{}{"list": []interface{}{1.5, "two", nil, false}}})
+	var n Any
+	err := n.Decode(msgs.MsgpackRepresentation, m.Encode(msgs.MsgpackRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestAnyMessageCBORCodec(t *testing.T) {
+	m := NewAnyMessage(map[string]interface{}{"text": "some text", "object": map[string]interface{}{"list": []interface{}{1.5, "two", nil, false}}})
+	var n Any
+	err := n.Decode(msgs.CBORRepresentation, m.Encode(msgs.CBORRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestAnyMessageXMLCodec(t *testing.T) {
+	m := NewAnyMessage(map[string]interface{}{"text": "some <text>", "flag": true, "object": map[string]interface{}{"list": []interface{}{1.5, "two", nil, []interface{}{}}, "empty": map[string]interface{}{}}})
+	var n Any
+	err := n.Decode(msgs.XMLRepresentation, m.Encode(msgs.XMLRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestAnyMessageXML(t *testing.T) {
+	m := NewAnyMessage(map[string]interface{}{"value": 42.5, "tags": []interface{}{"a", true, nil}})
+	assert.Equal(t, `<Any><Entry Key="tags"><List><String>a</String><Bool>true</Bool><Null></Null></List></Entry><Entry Key="value"><Number>42.5</Number></Entry></Any>`, string(m.Encode(msgs.XMLRepresentation)))
+
+	var n Any
+	assert.NotNil(t, n.ParseXML([]byte(`<Any><Entry Key="value"><Unknown>1</Unknown></Entry></Any>`)))
+	assert.NotNil(t, n.ParseXML([]byte(`<Any><Entry><Number>1</Number></Entry></Any>`)))
+}
+
 func TestAnyMessageCodecPanic(t *testing.T) {
 	data := new(map[string]interface{})
 	m := NewAnyMessage(*data)
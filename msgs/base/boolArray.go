@@ -0,0 +1,291 @@
+package base
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"github.com/fxamacker/cbor/v2"
+	"github.com/tombenke/axon-go-common/msgs"
+	"github.com/tombenke/axon-go-common/msgs/avro"
+	"github.com/tombenke/axon-go-common/msgs/common"
+	"github.com/vmihailenco/msgpack/v5"
+	"gopkg.in/yaml.v2"
+	"time"
+)
+
+const (
+	// BoolArrayTypeName is the printable name of the `BoolArray` message-type
+	BoolArrayTypeName = "base/BoolArray"
+)
+
+func init() {
+	msgs.RegisterMessageType(BoolArrayTypeName, []msgs.Representation{msgs.JSONRepresentation, msgs.YAMLRepresentation, msgs.GobRepresentation, msgs.ProtobufRepresentation, msgs.TextRepresentation, msgs.XMLRepresentation, msgs.AvroRepresentation, msgs.MsgpackRepresentation, msgs.CBORRepresentation}, func() msgs.Message {
+		return NewBoolArrayMessage([]bool{})
+	})
+	msgs.SetMessageTypeDescription(BoolArrayTypeName, "List of boolean values")
+}
+
+// BoolArray represents the structure of the messages that hold a list of bool values.
+type BoolArray struct {
+	Header common.Header
+	Body   common.BoolArrayBody
+}
+
+// GetType returns with the printable name of the `BoolArray` message-type
+func (msg *BoolArray) GetType() string {
+	return BoolArrayTypeName
+}
+
+// Encode returns with the `BoolArray` message content in a representation format selected by `representation`
+func (msg *BoolArray) Encode(representation msgs.Representation) (results []byte) {
+	switch representation {
+	case msgs.JSONRepresentation:
+		var err error
+		results, err = json.Marshal(*msg)
+		if err != nil {
+			panic(err)
+		}
+	case msgs.YAMLRepresentation:
+		results = msg.YAML()
+	case msgs.GobRepresentation:
+		results = msg.EncodeGob()
+	case msgs.ProtobufRepresentation:
+		results = msg.Protobuf()
+	case msgs.TextRepresentation:
+		results = msg.Text()
+	case msgs.XMLRepresentation:
+		results = msg.XML()
+	case msgs.AvroRepresentation:
+		results = msg.Avro()
+	case msgs.MsgpackRepresentation:
+		results = msg.Msgpack()
+	case msgs.CBORRepresentation:
+		results = msg.CBOR()
+	default:
+		panic(fmt.Errorf("Encode error: unknown representational format '%s'", representation))
+	}
+	return results
+}
+
+// Decode parses the `content` using the selected `representation` format.
+// The messages of older versions are upgraded to the current version of the message-type, if the `representation` is upgradable.
+func (msg *BoolArray) Decode(representation msgs.Representation, content []byte) error {
+	content, err := msgs.Upgrade(BoolArrayTypeName, representation, content)
+	if err != nil {
+		return err
+	}
+
+	switch representation {
+	case msgs.JSONRepresentation:
+		return json.Unmarshal(content, msg)
+	case msgs.YAMLRepresentation:
+		return msg.ParseYAML(content)
+	case msgs.GobRepresentation:
+		return msg.DecodeGob(content)
+	case msgs.ProtobufRepresentation:
+		return msg.ParseProtobuf(content)
+	case msgs.TextRepresentation:
+		return msg.ParseText(content)
+	case msgs.XMLRepresentation:
+		return msg.ParseXML(content)
+	case msgs.AvroRepresentation:
+		return msg.ParseAvro(content)
+	case msgs.MsgpackRepresentation:
+		return msg.ParseMsgpack(content)
+	case msgs.CBORRepresentation:
+		return msg.ParseCBOR(content)
+	default:
+		panic(fmt.Errorf("Decode error: unknown representational format '%s'", representation))
+	}
+}
+
+// JSON returns with the `BoolArray` message content in JSON representation format
+func (msg *BoolArray) JSON() []byte {
+	jsonBytes, err := json.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return jsonBytes
+}
+
+// String returns with the `BoolArray` message content in JSON format string
+func (msg *BoolArray) String() string {
+	jsonBytes, err := json.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return string(jsonBytes)
+}
+
+// ParseJSON parses the JSON representation of a `BoolArray` messages from the `jsonBytes` argument.
+func (msg *BoolArray) ParseJSON(jsonBytes []byte) error {
+	return json.Unmarshal(jsonBytes, msg)
+}
+
+// Msgpack returns with the `BoolArray` message content in MessagePack representation format
+func (msg *BoolArray) Msgpack() []byte {
+	msgpackBytes, err := msgpack.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return msgpackBytes
+}
+
+// ParseMsgpack parses the MessagePack representation of a `BoolArray` messages from the `msgpackBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *BoolArray) ParseMsgpack(msgpackBytes []byte) error {
+	var decoded BoolArray
+	if err := msgpack.Unmarshal(msgpackBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// CBOR returns with the `BoolArray` message content in CBOR representation format
+func (msg *BoolArray) CBOR() []byte {
+	cborBytes, err := cbor.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return cborBytes
+}
+
+// ParseCBOR parses the CBOR representation of a `BoolArray` messages from the `cborBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *BoolArray) ParseCBOR(cborBytes []byte) error {
+	var decoded BoolArray
+	if err := cbor.Unmarshal(cborBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// Avro returns with the `BoolArray` message content in Avro representation format
+func (msg *BoolArray) Avro() []byte {
+	avroBytes, err := avro.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return avroBytes
+}
+
+// ParseAvro parses the Avro representation of a `BoolArray` messages from the `avroBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *BoolArray) ParseAvro(avroBytes []byte) error {
+	var decoded BoolArray
+	if err := avro.Unmarshal(avroBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// XML returns with the `BoolArray` message content in XML representation format
+func (msg *BoolArray) XML() []byte {
+	xmlBytes, err := xml.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return xmlBytes
+}
+
+// ParseXML parses the XML representation of a `BoolArray` messages from the `xmlBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *BoolArray) ParseXML(xmlBytes []byte) error {
+	var decoded BoolArray
+	if err := xml.Unmarshal(xmlBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// Text returns with the body of the `BoolArray` message in plain text representation format
+func (msg *BoolArray) Text() []byte {
+	return []byte(msg.Body.Text())
+}
+
+// ParseText parses the plain text representation of a `BoolArray` messages from the `textBytes` argument.
+// The plain text holds no timestamp, so the header gets the current time in the current precision of the message,
+// or in the default precision if the message has no precision.
+func (msg *BoolArray) ParseText(textBytes []byte) error {
+	var decoded BoolArray
+	if err := decoded.Body.ParseText(string(textBytes)); err != nil {
+		return err
+	}
+	precision := msg.Header.TimePrecision
+	if precision == "" {
+		precision = common.DefaultTimePrecision
+	}
+	decoded.Header = common.NewHeaderAt(common.NowAsUnixWithPrecision(precision), precision)
+	*msg = decoded
+	return nil
+}
+
+// Protobuf returns with the `BoolArray` message content in protobuf representation format
+func (msg *BoolArray) Protobuf() []byte {
+	return common.MarshalProtobufMessage(msg.Header, msg.Body)
+}
+
+// ParseProtobuf parses the protobuf representation of a `BoolArray` messages from the `protobufBytes` argument.
+// Protobuf omits the zero values, so the message is replaced as a whole by the decoded one.
+func (msg *BoolArray) ParseProtobuf(protobufBytes []byte) error {
+	var decoded BoolArray
+	if err := common.UnmarshalProtobufMessage(protobufBytes, &decoded.Header, &decoded.Body); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// YAML returns with the `BoolArray` message content in YAML representation format
+func (msg *BoolArray) YAML() []byte {
+	yamlBytes, err := yaml.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return yamlBytes
+}
+
+// ParseYAML parses the YAML representation of a `BoolArray` messages from the `yamlBytes` argument.
+func (msg *BoolArray) ParseYAML(yamlBytes []byte) error {
+	return yaml.Unmarshal(yamlBytes, msg)
+}
+
+// EncodeGob returns with the `BoolArray` message content in Gob representation format
+func (msg *BoolArray) EncodeGob() []byte {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(*msg); err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
+// DecodeGob parses the Gob representation of a `BoolArray` messages from the `gobBytes` argument.
+// Gob omits the zero values, so the message is replaced as a whole by the decoded one.
+func (msg *BoolArray) DecodeGob(gobBytes []byte) error {
+	var decoded BoolArray
+	if err := gob.NewDecoder(bytes.NewReader(gobBytes)).Decode(&decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// NewBoolArrayMessage returns with a new `BoolArray` message. The header will contain the current time in `Nanoseconds` precision.
+func NewBoolArrayMessage(data []bool) msgs.Message {
+	return NewBoolArrayMessageAt(data, time.Now().UnixNano(), "ns")
+}
+
+// NewBoolArrayMessageAt returns with a new `BoolArray` message. The header will contain the `at` time in `withPrecision` precision.
+func NewBoolArrayMessageAt(data []bool, at int64, withPrecision common.TimePrecision) msgs.Message {
+	var msg BoolArray
+	msg.Header = common.NewHeaderAt(at, withPrecision)
+	msg.Header.Version = msgs.GetMessageTypeVersion(BoolArrayTypeName)
+	msg.Body.Data = data
+	return &msg
+}
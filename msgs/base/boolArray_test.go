@@ -0,0 +1,45 @@
+package base
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/tombenke/axon-go-common/msgs"
+	"github.com/tombenke/axon-go-common/msgs/common"
+	"testing"
+)
+
+func TestBoolArrayGetType(t *testing.T) {
+	assert.Equal(t, NewBoolArrayMessage([]bool{}).GetType(), BoolArrayTypeName)
+}
+
+func TestBoolArrayMessageCodecs(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewBoolArrayMessageAt([]bool{true, false, true}, at, prec)
+
+	for _, representation := range []msgs.Representation{
+		msgs.JSONRepresentation,
+		msgs.YAMLRepresentation,
+		msgs.GobRepresentation,
+		msgs.ProtobufRepresentation,
+		msgs.XMLRepresentation,
+		msgs.AvroRepresentation,
+		msgs.MsgpackRepresentation,
+		msgs.CBORRepresentation,
+	} {
+		t.Run(string(representation), func(t *testing.T) {
+			var n BoolArray
+			err := n.Decode(representation, m.Encode(representation))
+			assert.Nil(t, err)
+			assert.Equal(t, m, &n)
+		})
+	}
+}
+
+func TestBoolArrayMessageTextCodec(t *testing.T) {
+	m := NewBoolArrayMessage([]bool{true, false, true})
+	assert.Equal(t, []byte("true false true"), m.Encode(msgs.TextRepresentation))
+	var n BoolArray
+	assert.Nil(t, n.Decode(msgs.TextRepresentation, []byte(" true false true ")))
+	assert.Equal(t, m.(*BoolArray).Body, n.Body)
+	assert.NotNil(t, n.Decode(msgs.TextRepresentation, []byte("true yes")))
+}
@@ -34,6 +34,96 @@ func TestBoolMessageCodec(t *testing.T) {
 	assert.Equal(t, m, &n)
 }
 
+func TestBoolMessageMsgpackCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewBoolMessageAt(true, at, prec)
+	var n Bool
+	err := n.Decode(msgs.MsgpackRepresentation, m.Encode(msgs.MsgpackRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestBoolMessageCBORCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewBoolMessageAt(true, at, prec)
+	var n Bool
+	err := n.Decode(msgs.CBORRepresentation, m.Encode(msgs.CBORRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestBoolMessageAvroCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewBoolMessageAt(true, at, prec)
+	var n Bool
+	err := n.Decode(msgs.AvroRepresentation, m.Encode(msgs.AvroRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestBoolMessageXMLCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewBoolMessageAt(true, at, prec)
+	var n Bool
+	err := n.Decode(msgs.XMLRepresentation, m.Encode(msgs.XMLRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestBoolMessageCSVCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewBoolMessageAt(true, at, prec)
+	var n Bool
+	err := n.Decode(msgs.CSVRepresentation, m.Encode(msgs.CSVRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestBoolMessageCSVHeaderCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewBoolMessageAt(true, at, prec)
+	var n Bool
+	err := n.Decode(msgs.CSVHeaderRepresentation, m.Encode(msgs.CSVHeaderRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestBoolMessageProtobufCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewBoolMessageAt(true, at, prec)
+	var n Bool
+	err := n.Decode(msgs.ProtobufRepresentation, m.Encode(msgs.ProtobufRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestBoolMessageYAMLCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewBoolMessageAt(true, at, prec)
+	var n Bool
+	err := n.Decode(msgs.YAMLRepresentation, m.Encode(msgs.YAMLRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestBoolMessageGobCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewBoolMessageAt(true, at, prec)
+	var n Bool
+	err := n.Decode(msgs.GobRepresentation, m.Encode(msgs.GobRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
 func TestBoolMessageCodecPanic(t *testing.T) {
 	at := int64(1608732048980057025)
 	prec := common.TimePrecision("ns")
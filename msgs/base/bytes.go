@@ -1,9 +1,20 @@
 package base
 
 import (
+	"bytes"
+	"encoding/base64"
+	"encoding/gob"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
+	"github.com/fxamacker/cbor/v2"
 	"github.com/tombenke/axon-go-common/msgs"
+	"github.com/tombenke/axon-go-common/msgs/avro"
+	"github.com/tombenke/axon-go-common/msgs/common"
+	"github.com/tombenke/axon-go-common/msgs/ros1"
+	"github.com/vmihailenco/msgpack/v5"
+	"gopkg.in/yaml.v2"
+	"strings"
 )
 
 const (
@@ -12,9 +23,11 @@ const (
 )
 
 func init() {
-	msgs.RegisterMessageType(BytesTypeName, []msgs.Representation{msgs.TextRepresentation}, func() msgs.Message {
+	msgs.RegisterMessageType(BytesTypeName, []msgs.Representation{msgs.TextRepresentation, msgs.YAMLRepresentation, msgs.GobRepresentation, msgs.ProtobufRepresentation, msgs.XMLRepresentation, msgs.AvroRepresentation, msgs.MsgpackRepresentation, msgs.CBORRepresentation, msgs.ROS1Representation}, func() msgs.Message {
 		return NewBytesMessage([]byte{})
 	})
+	msgs.SetMessageTypeDescription(BytesTypeName, "Generic message that is a plain byte array")
+	msgs.SetMessageTypeROSType(BytesTypeName, "std_msgs/UInt8MultiArray")
 }
 
 // Bytes represents the structure of a generic message that is actually a plain byte array
@@ -27,21 +40,55 @@ func (msg *Bytes) GetType() string {
 
 // Encode returns with the `Bytes` message content in a representation format selected by `representation`
 func (msg *Bytes) Encode(representation msgs.Representation) []byte {
-	if representation != msgs.TextRepresentation &&
-		representation != msgs.OctetstreamRepresentation {
+	switch representation {
+	case msgs.TextRepresentation, msgs.OctetstreamRepresentation:
+		return []byte(*msg)
+	case msgs.YAMLRepresentation:
+		return msg.YAML()
+	case msgs.GobRepresentation:
+		return msg.EncodeGob()
+	case msgs.ProtobufRepresentation:
+		return msg.Protobuf()
+	case msgs.XMLRepresentation:
+		return msg.XML()
+	case msgs.AvroRepresentation:
+		return msg.Avro()
+	case msgs.MsgpackRepresentation:
+		return msg.Msgpack()
+	case msgs.CBORRepresentation:
+		return msg.CBOR()
+	case msgs.ROS1Representation:
+		return msg.ROS1()
+	default:
 		panic(fmt.Errorf("Encode error: unknown representational format '%s'", representation))
 	}
-	return []byte(*msg)
 }
 
 // Decode parses the `content` using the selected `representation` format
 func (msg *Bytes) Decode(representation msgs.Representation, content []byte) error {
-	if representation != msgs.TextRepresentation &&
-		representation != msgs.OctetstreamRepresentation {
+	switch representation {
+	case msgs.TextRepresentation, msgs.OctetstreamRepresentation:
+		*msg = content
+		return nil
+	case msgs.YAMLRepresentation:
+		return msg.ParseYAML(content)
+	case msgs.GobRepresentation:
+		return msg.DecodeGob(content)
+	case msgs.ProtobufRepresentation:
+		return msg.ParseProtobuf(content)
+	case msgs.XMLRepresentation:
+		return msg.ParseXML(content)
+	case msgs.AvroRepresentation:
+		return msg.ParseAvro(content)
+	case msgs.MsgpackRepresentation:
+		return msg.ParseMsgpack(content)
+	case msgs.CBORRepresentation:
+		return msg.ParseCBOR(content)
+	case msgs.ROS1Representation:
+		return msg.ParseROS1(content)
+	default:
 		panic(fmt.Errorf("Decode error: unknown representational format '%s'", representation))
 	}
-	*msg = content
-	return nil
 }
 
 // String returns with the `Bytes` message content in JSON format string
@@ -67,6 +114,174 @@ func (msg *Bytes) ParseJSON(jsonBytes []byte) error {
 	return json.Unmarshal(jsonBytes, msg)
 }
 
+// YAML returns with the `Bytes` message content in YAML representation format
+func (msg *Bytes) YAML() []byte {
+	yamlBytes, err := yaml.Marshal(string(*msg))
+	if err != nil {
+		panic(err)
+	}
+	return yamlBytes
+}
+
+// ParseYAML parses the YAML representation of a `Bytes` messages from the `yamlBytes` argument.
+func (msg *Bytes) ParseYAML(yamlBytes []byte) error {
+	var content string
+	if err := yaml.Unmarshal(yamlBytes, &content); err != nil {
+		return err
+	}
+	*msg = Bytes(content)
+	return nil
+}
+
+// EncodeGob returns with the `Bytes` message content in Gob representation format
+func (msg *Bytes) EncodeGob() []byte {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode([]byte(*msg)); err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
+// DecodeGob parses the Gob representation of a `Bytes` messages from the `gobBytes` argument.
+func (msg *Bytes) DecodeGob(gobBytes []byte) error {
+	var content []byte
+	if err := gob.NewDecoder(bytes.NewReader(gobBytes)).Decode(&content); err != nil {
+		return err
+	}
+	*msg = content
+	return nil
+}
+
+// ROS1 returns with the `Bytes` message content in the ROS1 serialization format of the `std_msgs/UInt8MultiArray` ROS message
+func (msg *Bytes) ROS1() []byte {
+	e := ros1.NewEncoder()
+	e.MultiArrayLayout(nil)
+	e.Uint8Slice(*msg)
+	return e.Bytes()
+}
+
+// ParseROS1 parses the ROS1 serialization of a `std_msgs/UInt8MultiArray` ROS message from the `ros1Bytes` argument
+func (msg *Bytes) ParseROS1(ros1Bytes []byte) error {
+	d := ros1.NewDecoder(ros1Bytes)
+	_, offset := d.MultiArrayLayout()
+	data := d.Uint8Slice()
+	if err := d.Close(); err != nil {
+		return err
+	}
+	if offset > uint32(len(data)) {
+		return fmt.Errorf("the %d data offset is out of the %d values", offset, len(data))
+	}
+	*msg = data[offset:]
+	return nil
+}
+
+// Protobuf returns with the `Bytes` message content in protobuf representation format
+func (msg *Bytes) Protobuf() []byte {
+	return common.AppendProtobufBytes(nil, 1, []byte(*msg))
+}
+
+// ParseProtobuf parses the protobuf representation of a `Bytes` messages from the `protobufBytes` argument.
+func (msg *Bytes) ParseProtobuf(protobufBytes []byte) error {
+	fields, err := common.ParseProtobufFields(protobufBytes)
+	if err != nil {
+		return err
+	}
+
+	*msg = Bytes{}
+	for _, f := range fields {
+		if f.Num == 1 {
+			*msg = f.Bytes
+		}
+	}
+	return nil
+}
+
+// bytesXML is the XML representation of the `Bytes` message, that holds the content in base64 encoded format
+type bytesXML struct {
+	XMLName xml.Name `xml:"Bytes"`
+	Content string   `xml:",chardata"`
+}
+
+// XML returns with the `Bytes` message content in XML representation format
+func (msg *Bytes) XML() []byte {
+	xmlBytes, err := xml.Marshal(bytesXML{Content: base64.StdEncoding.EncodeToString(*msg)})
+	if err != nil {
+		panic(err)
+	}
+	return xmlBytes
+}
+
+// ParseXML parses the XML representation of a `Bytes` messages from the `xmlBytes` argument.
+func (msg *Bytes) ParseXML(xmlBytes []byte) error {
+	var decoded bytesXML
+	if err := xml.Unmarshal(xmlBytes, &decoded); err != nil {
+		return err
+	}
+	content, err := base64.StdEncoding.DecodeString(strings.TrimSpace(decoded.Content))
+	if err != nil {
+		return err
+	}
+	*msg = content
+	return nil
+}
+
+// Avro returns with the `Bytes` message content in Avro representation format
+func (msg *Bytes) Avro() []byte {
+	avroBytes, err := avro.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return avroBytes
+}
+
+// ParseAvro parses the Avro representation of a `Bytes` messages from the `avroBytes` argument.
+func (msg *Bytes) ParseAvro(avroBytes []byte) error {
+	var decoded Bytes
+	if err := avro.Unmarshal(avroBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// Msgpack returns with the `Bytes` message content in MessagePack representation format
+func (msg *Bytes) Msgpack() []byte {
+	msgpackBytes, err := msgpack.Marshal([]byte(*msg))
+	if err != nil {
+		panic(err)
+	}
+	return msgpackBytes
+}
+
+// ParseMsgpack parses the MessagePack representation of a `Bytes` messages from the `msgpackBytes` argument.
+func (msg *Bytes) ParseMsgpack(msgpackBytes []byte) error {
+	var decoded Bytes
+	if err := msgpack.Unmarshal(msgpackBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// CBOR returns with the `Bytes` message content in CBOR representation format
+func (msg *Bytes) CBOR() []byte {
+	cborBytes, err := cbor.Marshal([]byte(*msg))
+	if err != nil {
+		panic(err)
+	}
+	return cborBytes
+}
+
+// ParseCBOR parses the CBOR representation of a `Bytes` messages from the `cborBytes` argument.
+func (msg *Bytes) ParseCBOR(cborBytes []byte) error {
+	var decoded Bytes
+	if err := cbor.Unmarshal(cborBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
 // NewBytesMessage returns with a new `Bytes` message. The header will contain the current time in `Nanoseconds` precision.
 func NewBytesMessage(data []byte) msgs.Message {
 	var msg Bytes = data
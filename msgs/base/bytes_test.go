@@ -35,6 +35,69 @@ func TestBytesMessageCodec(t *testing.T) {
 	assert.Equal(t, m, &n)
 }
 
+func TestBytesMessageYAMLCodec(t *testing.T) {
+	data := []byte(`some bytes...`)
+	m := NewBytesMessage(data)
+	n := Bytes{}
+	err := n.Decode(msgs.YAMLRepresentation, m.Encode(msgs.YAMLRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestBytesMessageProtobufCodec(t *testing.T) {
+	data := []byte(`some bytes...`)
+	m := NewBytesMessage(data)
+	n := Bytes{}
+	err := n.Decode(msgs.ProtobufRepresentation, m.Encode(msgs.ProtobufRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestBytesMessageGobCodec(t *testing.T) {
+	data := []byte(`some bytes...`)
+	m := NewBytesMessage(data)
+	n := Bytes{}
+	err := n.Decode(msgs.GobRepresentation, m.Encode(msgs.GobRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestBytesMessageAvroCodec(t *testing.T) {
+	data := []byte(`some bytes...`)
+	m := NewBytesMessage(data)
+	n := Bytes{}
+	err := n.Decode(msgs.AvroRepresentation, m.Encode(msgs.AvroRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestBytesMessageMsgpackCodec(t *testing.T) {
+	data := []byte(`some bytes...`)
+	m := NewBytesMessage(data)
+	n := Bytes{}
+	err := n.Decode(msgs.MsgpackRepresentation, m.Encode(msgs.MsgpackRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestBytesMessageCBORCodec(t *testing.T) {
+	data := []byte(`some bytes...`)
+	m := NewBytesMessage(data)
+	n := Bytes{}
+	err := n.Decode(msgs.CBORRepresentation, m.Encode(msgs.CBORRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestBytesMessageXMLCodec(t *testing.T) {
+	data := []byte("some <bytes>...\x00\xff")
+	m := NewBytesMessage(data)
+	n := Bytes{}
+	err := n.Decode(msgs.XMLRepresentation, m.Encode(msgs.XMLRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
 func TestBytesMessageCodecPanic(t *testing.T) {
 	data := []byte(`some text...`)
 	m := NewBytesMessage(data)
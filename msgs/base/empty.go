@@ -1,10 +1,18 @@
 package base
 
 import (
+	"bytes"
+	"encoding/gob"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
+	"github.com/fxamacker/cbor/v2"
 	"github.com/tombenke/axon-go-common/msgs"
+	"github.com/tombenke/axon-go-common/msgs/avro"
 	"github.com/tombenke/axon-go-common/msgs/common"
+	"github.com/tombenke/axon-go-common/msgs/ros1"
+	"github.com/vmihailenco/msgpack/v5"
+	"gopkg.in/yaml.v2"
 	"time"
 )
 
@@ -14,9 +22,11 @@ const (
 )
 
 func init() {
-	msgs.RegisterMessageType(EmptyTypeName, []msgs.Representation{msgs.JSONRepresentation}, func() msgs.Message {
+	msgs.RegisterMessageType(EmptyTypeName, []msgs.Representation{msgs.JSONRepresentation, msgs.YAMLRepresentation, msgs.GobRepresentation, msgs.ProtobufRepresentation, msgs.XMLRepresentation, msgs.AvroRepresentation, msgs.MsgpackRepresentation, msgs.CBORRepresentation, msgs.ROS1Representation}, func() msgs.Message {
 		return NewEmptyMessage()
 	})
+	msgs.SetMessageTypeDescription(EmptyTypeName, "Empty message that holds only the header")
+	msgs.SetMessageTypeROSType(EmptyTypeName, "std_msgs/Empty")
 }
 
 // Empty represents the structure of the empty message.
@@ -39,17 +49,55 @@ func (msg *Empty) Encode(representation msgs.Representation) (results []byte) {
 		if err != nil {
 			panic(err)
 		}
+	case msgs.YAMLRepresentation:
+		results = msg.YAML()
+	case msgs.GobRepresentation:
+		results = msg.EncodeGob()
+	case msgs.ProtobufRepresentation:
+		results = msg.Protobuf()
+	case msgs.XMLRepresentation:
+		results = msg.XML()
+	case msgs.AvroRepresentation:
+		results = msg.Avro()
+	case msgs.MsgpackRepresentation:
+		results = msg.Msgpack()
+	case msgs.CBORRepresentation:
+		results = msg.CBOR()
+	case msgs.ROS1Representation:
+		results = msg.ROS1()
 	default:
 		panic(fmt.Errorf("Encode error: unknown representational format '%s'", representation))
 	}
 	return results
 }
 
-// Decode parses the `content` using the selected `representation` format
+// Decode parses the `content` using the selected `representation` format.
+// The messages of older versions are upgraded to the current version of the message-type, if the `representation` is upgradable.
 func (msg *Empty) Decode(representation msgs.Representation, content []byte) error {
+	content, err := msgs.Upgrade(EmptyTypeName, representation, content)
+	if err != nil {
+		return err
+	}
+
 	switch representation {
 	case msgs.JSONRepresentation:
 		return json.Unmarshal(content, msg)
+	case msgs.YAMLRepresentation:
+		return msg.ParseYAML(content)
+	case msgs.GobRepresentation:
+		return msg.DecodeGob(content)
+	case msgs.ProtobufRepresentation:
+		return msg.ParseProtobuf(content)
+	case msgs.XMLRepresentation:
+		return msg.ParseXML(content)
+	case msgs.AvroRepresentation:
+		return msg.ParseAvro(content)
+	case msgs.MsgpackRepresentation:
+		return msg.ParseMsgpack(content)
+	case msgs.CBORRepresentation:
+		return msg.ParseCBOR(content)
+	case msgs.ROS1Representation:
+		return msg.ParseROS1(content)
 	default:
 		panic(fmt.Errorf("Decode error: unknown representational format '%s'", representation))
 	}
@@ -78,6 +126,162 @@ func (msg *Empty) ParseJSON(jsonBytes []byte) error {
 	return json.Unmarshal(jsonBytes, msg)
 }
 
+// Msgpack returns with the `Empty` message content in MessagePack representation format
+func (msg *Empty) Msgpack() []byte {
+	msgpackBytes, err := msgpack.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return msgpackBytes
+}
+
+// ParseMsgpack parses the MessagePack representation of a `Empty` messages from the `msgpackBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *Empty) ParseMsgpack(msgpackBytes []byte) error {
+	var decoded Empty
+	if err := msgpack.Unmarshal(msgpackBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// CBOR returns with the `Empty` message content in CBOR representation format
+func (msg *Empty) CBOR() []byte {
+	cborBytes, err := cbor.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return cborBytes
+}
+
+// ParseCBOR parses the CBOR representation of a `Empty` messages from the `cborBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *Empty) ParseCBOR(cborBytes []byte) error {
+	var decoded Empty
+	if err := cbor.Unmarshal(cborBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// Avro returns with the `Empty` message content in Avro representation format
+func (msg *Empty) Avro() []byte {
+	avroBytes, err := avro.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return avroBytes
+}
+
+// ParseAvro parses the Avro representation of a `Empty` messages from the `avroBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *Empty) ParseAvro(avroBytes []byte) error {
+	var decoded Empty
+	if err := avro.Unmarshal(avroBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// XML returns with the `Empty` message content in XML representation format
+func (msg *Empty) XML() []byte {
+	xmlBytes, err := xml.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return xmlBytes
+}
+
+// ParseXML parses the XML representation of a `Empty` messages from the `xmlBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *Empty) ParseXML(xmlBytes []byte) error {
+	var decoded Empty
+	if err := xml.Unmarshal(xmlBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// ROS1 returns with the `Empty` message content in the ROS1 serialization format of the `std_msgs/Empty` ROS message.
+// The ROS message has no header, so the timestamp of the message is not serialized.
+func (msg *Empty) ROS1() []byte {
+	e := ros1.NewEncoder()
+	return e.Bytes()
+}
+
+// ParseROS1 parses the ROS1 serialization of a `std_msgs/Empty` ROS message from the `ros1Bytes` argument.
+// The ROS message holds no timestamp, so the header gets the current time in the current precision of the message,
+// or in the default precision if the message has no precision. The message is replaced as a whole by the decoded one.
+func (msg *Empty) ParseROS1(ros1Bytes []byte) error {
+	var decoded Empty
+	d := ros1.NewDecoder(ros1Bytes)
+	if err := d.Close(); err != nil {
+		return err
+	}
+	precision := msg.Header.TimePrecision
+	if precision == "" {
+		precision = common.DefaultTimePrecision
+	}
+	decoded.Header = common.NewHeaderAt(common.NowAsUnixWithPrecision(precision), precision)
+	decoded.Header.Version = msgs.GetMessageTypeVersion(EmptyTypeName)
+	*msg = decoded
+	return nil
+}
+
+// Protobuf returns with the `Empty` message content in protobuf representation format
+func (msg *Empty) Protobuf() []byte {
+	return common.MarshalProtobufMessage(msg.Header, msg.Body)
+}
+
+// ParseProtobuf parses the protobuf representation of a `Empty` messages from the `protobufBytes` argument.
+// Protobuf omits the zero values, so the message is replaced as a whole by the decoded one.
+func (msg *Empty) ParseProtobuf(protobufBytes []byte) error {
+	var decoded Empty
+	if err := common.UnmarshalProtobufMessage(protobufBytes, &decoded.Header, &decoded.Body); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// YAML returns with the `Empty` message content in YAML representation format
+func (msg *Empty) YAML() []byte {
+	yamlBytes, err := yaml.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return yamlBytes
+}
+
+// ParseYAML parses the YAML representation of a `Empty` messages from the `yamlBytes` argument.
+func (msg *Empty) ParseYAML(yamlBytes []byte) error {
+	return yaml.Unmarshal(yamlBytes, msg)
+}
+
+// EncodeGob returns with the `Empty` message content in Gob representation format
+func (msg *Empty) EncodeGob() []byte {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(*msg); err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
+// DecodeGob parses the Gob representation of a `Empty` messages from the `gobBytes` argument.
+// Gob omits the zero values, so the message is replaced as a whole by the decoded one.
+func (msg *Empty) DecodeGob(gobBytes []byte) error {
+	var decoded Empty
+	if err := gob.NewDecoder(bytes.NewReader(gobBytes)).Decode(&decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
 // NewEmptyMessage returns with a new `Empty` message. The header will contain the current time in `Nanoseconds` precision.
 func NewEmptyMessage() msgs.Message {
 	return NewEmptyMessageAt(time.Now().UnixNano(), "ns")
@@ -87,5 +291,6 @@ func NewEmptyMessage() msgs.Message {
 func NewEmptyMessageAt(at int64, withPrecision common.TimePrecision) msgs.Message {
 	var msg Empty
 	msg.Header = common.NewHeaderAt(at, withPrecision)
+	msg.Header.Version = msgs.GetMessageTypeVersion(EmptyTypeName)
 	return &msg
 }
@@ -34,6 +34,76 @@ func TestEmptyMessageCodec(t *testing.T) {
 	assert.Equal(t, m, &n)
 }
 
+func TestEmptyMessageMsgpackCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewEmptyMessageAt(at, prec)
+	var n Empty
+	err := n.Decode(msgs.MsgpackRepresentation, m.Encode(msgs.MsgpackRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestEmptyMessageCBORCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewEmptyMessageAt(at, prec)
+	var n Empty
+	err := n.Decode(msgs.CBORRepresentation, m.Encode(msgs.CBORRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestEmptyMessageAvroCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewEmptyMessageAt(at, prec)
+	var n Empty
+	err := n.Decode(msgs.AvroRepresentation, m.Encode(msgs.AvroRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestEmptyMessageXMLCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewEmptyMessageAt(at, prec)
+	var n Empty
+	err := n.Decode(msgs.XMLRepresentation, m.Encode(msgs.XMLRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestEmptyMessageProtobufCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewEmptyMessageAt(at, prec)
+	var n Empty
+	err := n.Decode(msgs.ProtobufRepresentation, m.Encode(msgs.ProtobufRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestEmptyMessageYAMLCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewEmptyMessageAt(at, prec)
+	var n Empty
+	err := n.Decode(msgs.YAMLRepresentation, m.Encode(msgs.YAMLRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestEmptyMessageGobCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewEmptyMessageAt(at, prec)
+	var n Empty
+	err := n.Decode(msgs.GobRepresentation, m.Encode(msgs.GobRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
 func TestEmptyMessageCodecPanic(t *testing.T) {
 	at := int64(1608732048980057025)
 	prec := common.TimePrecision("ns")
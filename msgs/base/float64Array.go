@@ -0,0 +1,331 @@
+package base
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"github.com/fxamacker/cbor/v2"
+	"github.com/tombenke/axon-go-common/msgs"
+	"github.com/tombenke/axon-go-common/msgs/avro"
+	"github.com/tombenke/axon-go-common/msgs/common"
+	"github.com/tombenke/axon-go-common/msgs/ros1"
+	"github.com/vmihailenco/msgpack/v5"
+	"gopkg.in/yaml.v2"
+	"time"
+)
+
+const (
+	// Float64ArrayTypeName is the printable name of the `Float64Array` message-type
+	Float64ArrayTypeName = "base/Float64Array"
+)
+
+func init() {
+	msgs.RegisterMessageType(Float64ArrayTypeName, []msgs.Representation{msgs.JSONRepresentation, msgs.YAMLRepresentation, msgs.GobRepresentation, msgs.ProtobufRepresentation, msgs.TextRepresentation, msgs.XMLRepresentation, msgs.AvroRepresentation, msgs.MsgpackRepresentation, msgs.CBORRepresentation, msgs.ROS1Representation}, func() msgs.Message {
+		return NewFloat64ArrayMessage([]float64{})
+	})
+	msgs.SetMessageTypeDescription(Float64ArrayTypeName, "List of float64 values, e.g. a block of samples or a feature vector")
+	msgs.SetMessageTypeROSType(Float64ArrayTypeName, "std_msgs/Float64MultiArray")
+}
+
+// Float64Array represents the structure of the messages that hold a list of float64 values.
+type Float64Array struct {
+	Header common.Header
+	Body   common.Float64ArrayBody
+}
+
+// GetType returns with the printable name of the `Float64Array` message-type
+func (msg *Float64Array) GetType() string {
+	return Float64ArrayTypeName
+}
+
+// Encode returns with the `Float64Array` message content in a representation format selected by `representation`
+func (msg *Float64Array) Encode(representation msgs.Representation) (results []byte) {
+	switch representation {
+	case msgs.JSONRepresentation:
+		var err error
+		results, err = json.Marshal(*msg)
+		if err != nil {
+			panic(err)
+		}
+	case msgs.YAMLRepresentation:
+		results = msg.YAML()
+	case msgs.GobRepresentation:
+		results = msg.EncodeGob()
+	case msgs.ProtobufRepresentation:
+		results = msg.Protobuf()
+	case msgs.TextRepresentation:
+		results = msg.Text()
+	case msgs.XMLRepresentation:
+		results = msg.XML()
+	case msgs.AvroRepresentation:
+		results = msg.Avro()
+	case msgs.MsgpackRepresentation:
+		results = msg.Msgpack()
+	case msgs.CBORRepresentation:
+		results = msg.CBOR()
+	case msgs.ROS1Representation:
+		results = msg.ROS1()
+	default:
+		panic(fmt.Errorf("Encode error: unknown representational format '%s'", representation))
+	}
+	return results
+}
+
+// Decode parses the `content` using the selected `representation` format.
+// The messages of older versions are upgraded to the current version of the message-type, if the `representation` is upgradable.
+func (msg *Float64Array) Decode(representation msgs.Representation, content []byte) error {
+	content, err := msgs.Upgrade(Float64ArrayTypeName, representation, content)
+	if err != nil {
+		return err
+	}
+
+	switch representation {
+	case msgs.JSONRepresentation:
+		return json.Unmarshal(content, msg)
+	case msgs.YAMLRepresentation:
+		return msg.ParseYAML(content)
+	case msgs.GobRepresentation:
+		return msg.DecodeGob(content)
+	case msgs.ProtobufRepresentation:
+		return msg.ParseProtobuf(content)
+	case msgs.TextRepresentation:
+		return msg.ParseText(content)
+	case msgs.XMLRepresentation:
+		return msg.ParseXML(content)
+	case msgs.AvroRepresentation:
+		return msg.ParseAvro(content)
+	case msgs.MsgpackRepresentation:
+		return msg.ParseMsgpack(content)
+	case msgs.CBORRepresentation:
+		return msg.ParseCBOR(content)
+	case msgs.ROS1Representation:
+		return msg.ParseROS1(content)
+	default:
+		panic(fmt.Errorf("Decode error: unknown representational format '%s'", representation))
+	}
+}
+
+// JSON returns with the `Float64Array` message content in JSON representation format
+func (msg *Float64Array) JSON() []byte {
+	jsonBytes, err := json.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return jsonBytes
+}
+
+// String returns with the `Float64Array` message content in JSON format string
+func (msg *Float64Array) String() string {
+	jsonBytes, err := json.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return string(jsonBytes)
+}
+
+// ParseJSON parses the JSON representation of a `Float64Array` messages from the `jsonBytes` argument.
+func (msg *Float64Array) ParseJSON(jsonBytes []byte) error {
+	return json.Unmarshal(jsonBytes, msg)
+}
+
+// Msgpack returns with the `Float64Array` message content in MessagePack representation format
+func (msg *Float64Array) Msgpack() []byte {
+	msgpackBytes, err := msgpack.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return msgpackBytes
+}
+
+// ParseMsgpack parses the MessagePack representation of a `Float64Array` messages from the `msgpackBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *Float64Array) ParseMsgpack(msgpackBytes []byte) error {
+	var decoded Float64Array
+	if err := msgpack.Unmarshal(msgpackBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// CBOR returns with the `Float64Array` message content in CBOR representation format
+func (msg *Float64Array) CBOR() []byte {
+	cborBytes, err := cbor.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return cborBytes
+}
+
+// ParseCBOR parses the CBOR representation of a `Float64Array` messages from the `cborBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *Float64Array) ParseCBOR(cborBytes []byte) error {
+	var decoded Float64Array
+	if err := cbor.Unmarshal(cborBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// Avro returns with the `Float64Array` message content in Avro representation format
+func (msg *Float64Array) Avro() []byte {
+	avroBytes, err := avro.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return avroBytes
+}
+
+// ParseAvro parses the Avro representation of a `Float64Array` messages from the `avroBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *Float64Array) ParseAvro(avroBytes []byte) error {
+	var decoded Float64Array
+	if err := avro.Unmarshal(avroBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// XML returns with the `Float64Array` message content in XML representation format
+func (msg *Float64Array) XML() []byte {
+	xmlBytes, err := xml.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return xmlBytes
+}
+
+// ParseXML parses the XML representation of a `Float64Array` messages from the `xmlBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *Float64Array) ParseXML(xmlBytes []byte) error {
+	var decoded Float64Array
+	if err := xml.Unmarshal(xmlBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// Text returns with the body of the `Float64Array` message in plain text representation format
+func (msg *Float64Array) Text() []byte {
+	return []byte(msg.Body.Text())
+}
+
+// ParseText parses the plain text representation of a `Float64Array` messages from the `textBytes` argument.
+// The plain text holds no timestamp, so the header gets the current time in the current precision of the message,
+// or in the default precision if the message has no precision.
+func (msg *Float64Array) ParseText(textBytes []byte) error {
+	var decoded Float64Array
+	if err := decoded.Body.ParseText(string(textBytes)); err != nil {
+		return err
+	}
+	precision := msg.Header.TimePrecision
+	if precision == "" {
+		precision = common.DefaultTimePrecision
+	}
+	decoded.Header = common.NewHeaderAt(common.NowAsUnixWithPrecision(precision), precision)
+	*msg = decoded
+	return nil
+}
+
+// ROS1 returns with the `Float64Array` message content in the ROS1 serialization format of the `std_msgs/Float64MultiArray` ROS message.
+// The ROS message has no header, so the timestamp of the message is not serialized.
+func (msg *Float64Array) ROS1() []byte {
+	e := ros1.NewEncoder()
+	e.MultiArrayLayout(nil)
+	e.Float64Slice(msg.Body.Data)
+	return e.Bytes()
+}
+
+// ParseROS1 parses the ROS1 serialization of a `std_msgs/Float64MultiArray` ROS message from the `ros1Bytes` argument.
+// The ROS message holds no timestamp, so the header gets the current time in the current precision of the message,
+// or in the default precision if the message has no precision. The message is replaced as a whole by the decoded one.
+func (msg *Float64Array) ParseROS1(ros1Bytes []byte) error {
+	var decoded Float64Array
+	d := ros1.NewDecoder(ros1Bytes)
+	_, offset := d.MultiArrayLayout()
+	data := d.Float64Slice()
+	if err := d.Close(); err != nil {
+		return err
+	}
+	if offset > uint32(len(data)) {
+		return fmt.Errorf("the %d data offset is out of the %d values", offset, len(data))
+	}
+	decoded.Body.Data = data[offset:]
+	precision := msg.Header.TimePrecision
+	if precision == "" {
+		precision = common.DefaultTimePrecision
+	}
+	decoded.Header = common.NewHeaderAt(common.NowAsUnixWithPrecision(precision), precision)
+	decoded.Header.Version = msgs.GetMessageTypeVersion(Float64ArrayTypeName)
+	*msg = decoded
+	return nil
+}
+
+// Protobuf returns with the `Float64Array` message content in protobuf representation format
+func (msg *Float64Array) Protobuf() []byte {
+	return common.MarshalProtobufMessage(msg.Header, msg.Body)
+}
+
+// ParseProtobuf parses the protobuf representation of a `Float64Array` messages from the `protobufBytes` argument.
+// Protobuf omits the zero values, so the message is replaced as a whole by the decoded one.
+func (msg *Float64Array) ParseProtobuf(protobufBytes []byte) error {
+	var decoded Float64Array
+	if err := common.UnmarshalProtobufMessage(protobufBytes, &decoded.Header, &decoded.Body); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// YAML returns with the `Float64Array` message content in YAML representation format
+func (msg *Float64Array) YAML() []byte {
+	yamlBytes, err := yaml.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return yamlBytes
+}
+
+// ParseYAML parses the YAML representation of a `Float64Array` messages from the `yamlBytes` argument.
+func (msg *Float64Array) ParseYAML(yamlBytes []byte) error {
+	return yaml.Unmarshal(yamlBytes, msg)
+}
+
+// EncodeGob returns with the `Float64Array` message content in Gob representation format
+func (msg *Float64Array) EncodeGob() []byte {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(*msg); err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
+// DecodeGob parses the Gob representation of a `Float64Array` messages from the `gobBytes` argument.
+// Gob omits the zero values, so the message is replaced as a whole by the decoded one.
+func (msg *Float64Array) DecodeGob(gobBytes []byte) error {
+	var decoded Float64Array
+	if err := gob.NewDecoder(bytes.NewReader(gobBytes)).Decode(&decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// NewFloat64ArrayMessage returns with a new `Float64Array` message. The header will contain the current time in `Nanoseconds` precision.
+func NewFloat64ArrayMessage(data []float64) msgs.Message {
+	return NewFloat64ArrayMessageAt(data, time.Now().UnixNano(), "ns")
+}
+
+// NewFloat64ArrayMessageAt returns with a new `Float64Array` message. The header will contain the `at` time in `withPrecision` precision.
+func NewFloat64ArrayMessageAt(data []float64, at int64, withPrecision common.TimePrecision) msgs.Message {
+	var msg Float64Array
+	msg.Header = common.NewHeaderAt(at, withPrecision)
+	msg.Header.Version = msgs.GetMessageTypeVersion(Float64ArrayTypeName)
+	msg.Body.Data = data
+	return &msg
+}
@@ -0,0 +1,81 @@
+package base
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/tombenke/axon-go-common/msgs"
+	"github.com/tombenke/axon-go-common/msgs/common"
+	"testing"
+)
+
+func TestFloat64ArrayGetType(t *testing.T) {
+	assert.Equal(t, NewFloat64ArrayMessage([]float64{}).GetType(), Float64ArrayTypeName)
+}
+
+func TestFloat64ArrayMessageCodecs(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewFloat64ArrayMessageAt([]float64{1.5, -2, 0, 42}, at, prec)
+
+	for _, representation := range []msgs.Representation{
+		msgs.JSONRepresentation,
+		msgs.YAMLRepresentation,
+		msgs.GobRepresentation,
+		msgs.ProtobufRepresentation,
+		msgs.XMLRepresentation,
+		msgs.AvroRepresentation,
+		msgs.MsgpackRepresentation,
+		msgs.CBORRepresentation,
+	} {
+		t.Run(string(representation), func(t *testing.T) {
+			var n Float64Array
+			err := n.Decode(representation, m.Encode(representation))
+			assert.Nil(t, err)
+			assert.Equal(t, m, &n)
+		})
+	}
+}
+
+func TestFloat64ArrayMessageTextCodec(t *testing.T) {
+	m := NewFloat64ArrayMessage([]float64{1.5, -2, 0, 42})
+	assert.Equal(t, []byte("1.5 -2 0 42"), m.Encode(msgs.TextRepresentation))
+	var n Float64Array
+	assert.Nil(t, n.Decode(msgs.TextRepresentation, []byte(" 1.5 -2 0 42 ")))
+	assert.Equal(t, m.(*Float64Array).Body, n.Body)
+	assert.NotNil(t, n.Decode(msgs.TextRepresentation, []byte("1.5 x")))
+}
+
+func TestFloat64ArrayBinaryRepresentationSize(t *testing.T) {
+	data := make([]float64, 1024)
+	for i := range data {
+		data[i] = float64(i) / 3
+	}
+	m := NewFloat64ArrayMessage(data)
+	jsonSize := len(m.Encode(msgs.JSONRepresentation))
+	for _, representation := range []msgs.Representation{msgs.ProtobufRepresentation, msgs.MsgpackRepresentation, msgs.CBORRepresentation, msgs.AvroRepresentation} {
+		assert.Less(t, len(m.Encode(representation)), jsonSize, string(representation))
+	}
+}
+
+func benchmarkFloat64ArrayEncode(b *testing.B, representation msgs.Representation) {
+	data := make([]float64, 1024)
+	for i := range data {
+		data[i] = float64(i) / 3
+	}
+	m := NewFloat64ArrayMessage(data)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m.Encode(representation)
+	}
+}
+
+func BenchmarkFloat64ArrayEncodeJSON(b *testing.B) {
+	benchmarkFloat64ArrayEncode(b, msgs.JSONRepresentation)
+}
+
+func BenchmarkFloat64ArrayEncodeProtobuf(b *testing.B) {
+	benchmarkFloat64ArrayEncode(b, msgs.ProtobufRepresentation)
+}
+
+func BenchmarkFloat64ArrayEncodeCBOR(b *testing.B) {
+	benchmarkFloat64ArrayEncode(b, msgs.CBORRepresentation)
+}
@@ -34,6 +34,96 @@ func TestFloat64MessageCodec(t *testing.T) {
 	assert.Equal(t, m, &n)
 }
 
+func TestFloat64MessageMsgpackCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewFloat64MessageAt(42, at, prec)
+	var n Float64
+	err := n.Decode(msgs.MsgpackRepresentation, m.Encode(msgs.MsgpackRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestFloat64MessageCBORCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewFloat64MessageAt(42, at, prec)
+	var n Float64
+	err := n.Decode(msgs.CBORRepresentation, m.Encode(msgs.CBORRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestFloat64MessageAvroCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewFloat64MessageAt(42, at, prec)
+	var n Float64
+	err := n.Decode(msgs.AvroRepresentation, m.Encode(msgs.AvroRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestFloat64MessageXMLCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewFloat64MessageAt(42, at, prec)
+	var n Float64
+	err := n.Decode(msgs.XMLRepresentation, m.Encode(msgs.XMLRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestFloat64MessageCSVCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewFloat64MessageAt(42, at, prec)
+	var n Float64
+	err := n.Decode(msgs.CSVRepresentation, m.Encode(msgs.CSVRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestFloat64MessageCSVHeaderCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewFloat64MessageAt(42, at, prec)
+	var n Float64
+	err := n.Decode(msgs.CSVHeaderRepresentation, m.Encode(msgs.CSVHeaderRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestFloat64MessageProtobufCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewFloat64MessageAt(42, at, prec)
+	var n Float64
+	err := n.Decode(msgs.ProtobufRepresentation, m.Encode(msgs.ProtobufRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestFloat64MessageYAMLCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewFloat64MessageAt(42, at, prec)
+	var n Float64
+	err := n.Decode(msgs.YAMLRepresentation, m.Encode(msgs.YAMLRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestFloat64MessageGobCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewFloat64MessageAt(42, at, prec)
+	var n Float64
+	err := n.Decode(msgs.GobRepresentation, m.Encode(msgs.GobRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
 func TestFloat64MessageCodecPanic(t *testing.T) {
 	at := int64(1608732048980057025)
 	prec := common.TimePrecision("ns")
@@ -59,9 +149,105 @@ func TestFloat64MessageCodecPanic(t *testing.T) {
 	}()
 }
 
+func TestFloat64MessageErrorCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewFloat64MessageAt(42, at, prec)
+
+	content, err := msgs.EncodeE(m, msgs.JSONRepresentation)
+	assert.Nil(t, err)
+	var n Float64
+	assert.Nil(t, msgs.DecodeE(&n, msgs.JSONRepresentation, content))
+	assert.Equal(t, m, &n)
+
+	_, err = msgs.EncodeE(m, msgs.Representation("wrong-representation"))
+	assert.True(t, errors.Is(err, msgs.ErrUnsupportedRepresentation))
+	err = msgs.DecodeE(&n, msgs.Representation("wrong-representation"), content)
+	assert.True(t, errors.Is(err, msgs.ErrUnsupportedRepresentation))
+	assert.NotNil(t, msgs.DecodeE(&n, msgs.JSONRepresentation, []byte("{not-a-json")))
+	assert.NotNil(t, msgs.DecodeE(&n, msgs.ProtobufRepresentation, []byte{0xff, 0xff}))
+
+	codec := msgs.NewErrorCodec(&n)
+	content, err = codec.EncodeE(msgs.CBORRepresentation)
+	assert.Nil(t, err)
+	assert.Nil(t, codec.DecodeE(msgs.CBORRepresentation, content))
+	assert.Equal(t, m, &n)
+}
+
 func TestParseDefaultJSONValue(t *testing.T) {
 	var m Float64
 	err := m.ParseJSON([]byte(`{"Body": { "Data": 42 }}`))
 	assert.Nil(t, err)
 	assert.Equal(t, float64(42), m.Body.Data)
 }
+
+func TestFloat64MessageUpgrade(t *testing.T) {
+	defer msgs.SetDefaultRegistry(msgs.SetDefaultRegistry(msgs.DefaultRegistry().Clone()))
+	msgs.RegisterMigration(Float64TypeName, 0, func(doc map[string]interface{}) error {
+		body := doc["Body"].(map[string]interface{})
+		body["Data"] = body["Value"]
+		delete(body, "Value")
+		return nil
+	})
+
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewFloat64MessageAt(42, at, prec)
+	assert.Equal(t, 1, m.(*Float64).Header.Version)
+
+	var n Float64
+	err := n.Decode(msgs.JSONRepresentation, []byte(`{"Header":{"TimePrecision":"ns","Timestamp":1608732048980057025},"Body":{"Value":42}}`))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+
+	err = n.Decode(msgs.JSONRepresentation, []byte(`{"Header":{"TimePrecision":"ns","Timestamp":1608732048980057025,"Version":2},"Body":{"Data":42}}`))
+	assert.NotNil(t, err)
+}
+
+// The benchmarks below compare the binary representations to the JSON one, that is used by default.
+// Run them with: `go test -run=^$ -bench=Float64 -benchmem ./msgs/base/`
+
+func benchmarkFloat64Encode(b *testing.B, representation msgs.Representation) {
+	m := NewFloat64MessageAt(42.195, 1608732048980057025, common.TimePrecision("ns"))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m.Encode(representation)
+	}
+}
+
+func benchmarkFloat64Decode(b *testing.B, representation msgs.Representation) {
+	content := NewFloat64MessageAt(42.195, 1608732048980057025, common.TimePrecision("ns")).Encode(representation)
+	var n Float64
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := n.Decode(representation, content); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkFloat64EncodeJSON(b *testing.B) {
+	benchmarkFloat64Encode(b, msgs.JSONRepresentation)
+}
+
+func BenchmarkFloat64EncodeMsgpack(b *testing.B) {
+	benchmarkFloat64Encode(b, msgs.MsgpackRepresentation)
+}
+
+func BenchmarkFloat64EncodeCBOR(b *testing.B) {
+	benchmarkFloat64Encode(b, msgs.CBORRepresentation)
+}
+
+func BenchmarkFloat64DecodeJSON(b *testing.B) {
+	benchmarkFloat64Decode(b, msgs.JSONRepresentation)
+}
+
+func BenchmarkFloat64DecodeMsgpack(b *testing.B) {
+	benchmarkFloat64Decode(b, msgs.MsgpackRepresentation)
+}
+
+func BenchmarkFloat64DecodeCBOR(b *testing.B) {
+	benchmarkFloat64Decode(b, msgs.CBORRepresentation)
+}
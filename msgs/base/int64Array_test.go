@@ -0,0 +1,45 @@
+package base
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/tombenke/axon-go-common/msgs"
+	"github.com/tombenke/axon-go-common/msgs/common"
+	"testing"
+)
+
+func TestInt64ArrayGetType(t *testing.T) {
+	assert.Equal(t, NewInt64ArrayMessage([]int64{}).GetType(), Int64ArrayTypeName)
+}
+
+func TestInt64ArrayMessageCodecs(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewInt64ArrayMessageAt([]int64{1, -300, 0, 42}, at, prec)
+
+	for _, representation := range []msgs.Representation{
+		msgs.JSONRepresentation,
+		msgs.YAMLRepresentation,
+		msgs.GobRepresentation,
+		msgs.ProtobufRepresentation,
+		msgs.XMLRepresentation,
+		msgs.AvroRepresentation,
+		msgs.MsgpackRepresentation,
+		msgs.CBORRepresentation,
+	} {
+		t.Run(string(representation), func(t *testing.T) {
+			var n Int64Array
+			err := n.Decode(representation, m.Encode(representation))
+			assert.Nil(t, err)
+			assert.Equal(t, m, &n)
+		})
+	}
+}
+
+func TestInt64ArrayMessageTextCodec(t *testing.T) {
+	m := NewInt64ArrayMessage([]int64{1, -300, 0, 42})
+	assert.Equal(t, []byte("1 -300 0 42"), m.Encode(msgs.TextRepresentation))
+	var n Int64Array
+	assert.Nil(t, n.Decode(msgs.TextRepresentation, []byte(" 1 -300 0 42 ")))
+	assert.Equal(t, m.(*Int64Array).Body, n.Body)
+	assert.NotNil(t, n.Decode(msgs.TextRepresentation, []byte("1 1.5")))
+}
@@ -0,0 +1,326 @@
+package base
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"github.com/fxamacker/cbor/v2"
+	"github.com/tombenke/axon-go-common/msgs"
+	"github.com/tombenke/axon-go-common/msgs/avro"
+	"github.com/tombenke/axon-go-common/msgs/common"
+	"github.com/tombenke/axon-go-common/msgs/ros1"
+	"github.com/vmihailenco/msgpack/v5"
+	"gopkg.in/yaml.v2"
+	"time"
+)
+
+const (
+	// MatrixTypeName is the printable name of the `Matrix` message-type
+	MatrixTypeName = "base/Matrix"
+)
+
+func init() {
+	msgs.RegisterMessageType(MatrixTypeName, []msgs.Representation{msgs.JSONRepresentation, msgs.YAMLRepresentation, msgs.GobRepresentation, msgs.ProtobufRepresentation, msgs.XMLRepresentation, msgs.AvroRepresentation, msgs.MsgpackRepresentation, msgs.CBORRepresentation, msgs.ROS1Representation}, func() msgs.Message {
+		return NewMatrixMessage(0, 0, []float64{})
+	})
+	msgs.SetMessageTypeDescription(MatrixTypeName, "Row-major matrix of float64 values with its shape")
+	msgs.SetMessageTypeROSType(MatrixTypeName, "std_msgs/Float64MultiArray")
+}
+
+// Matrix represents the structure of the messages that hold a matrix of float64 values in row-major order.
+type Matrix struct {
+	Header common.Header
+	Body   common.MatrixBody
+}
+
+// GetType returns with the printable name of the `Matrix` message-type
+func (msg *Matrix) GetType() string {
+	return MatrixTypeName
+}
+
+// Encode returns with the `Matrix` message content in a representation format selected by `representation`
+func (msg *Matrix) Encode(representation msgs.Representation) (results []byte) {
+	switch representation {
+	case msgs.JSONRepresentation:
+		var err error
+		results, err = json.Marshal(*msg)
+		if err != nil {
+			panic(err)
+		}
+	case msgs.YAMLRepresentation:
+		results = msg.YAML()
+	case msgs.GobRepresentation:
+		results = msg.EncodeGob()
+	case msgs.ProtobufRepresentation:
+		results = msg.Protobuf()
+	case msgs.XMLRepresentation:
+		results = msg.XML()
+	case msgs.AvroRepresentation:
+		results = msg.Avro()
+	case msgs.MsgpackRepresentation:
+		results = msg.Msgpack()
+	case msgs.CBORRepresentation:
+		results = msg.CBOR()
+	case msgs.ROS1Representation:
+		results = msg.ROS1()
+	default:
+		panic(fmt.Errorf("Encode error: unknown representational format '%s'", representation))
+	}
+	return results
+}
+
+// Decode parses the `content` using the selected `representation` format,
+// and returns with error if the shape of the matrix does not match to the number of its values.
+// The messages of older versions are upgraded to the current version of the message-type, if the `representation` is upgradable.
+func (msg *Matrix) Decode(representation msgs.Representation, content []byte) error {
+	content, err := msgs.Upgrade(MatrixTypeName, representation, content)
+	if err != nil {
+		return err
+	}
+
+	switch representation {
+	case msgs.JSONRepresentation:
+		err = json.Unmarshal(content, msg)
+	case msgs.YAMLRepresentation:
+		err = msg.ParseYAML(content)
+	case msgs.GobRepresentation:
+		err = msg.DecodeGob(content)
+	case msgs.ProtobufRepresentation:
+		err = msg.ParseProtobuf(content)
+	case msgs.XMLRepresentation:
+		err = msg.ParseXML(content)
+	case msgs.AvroRepresentation:
+		err = msg.ParseAvro(content)
+	case msgs.MsgpackRepresentation:
+		err = msg.ParseMsgpack(content)
+	case msgs.CBORRepresentation:
+		err = msg.ParseCBOR(content)
+	case msgs.ROS1Representation:
+		err = msg.ParseROS1(content)
+	default:
+		panic(fmt.Errorf("Decode error: unknown representational format '%s'", representation))
+	}
+	if err != nil {
+		return err
+	}
+	return msg.Body.Validate()
+}
+
+// JSON returns with the `Matrix` message content in JSON representation format
+func (msg *Matrix) JSON() []byte {
+	jsonBytes, err := json.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return jsonBytes
+}
+
+// String returns with the `Matrix` message content in JSON format string
+func (msg *Matrix) String() string {
+	jsonBytes, err := json.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return string(jsonBytes)
+}
+
+// ParseJSON parses the JSON representation of a `Matrix` messages from the `jsonBytes` argument.
+func (msg *Matrix) ParseJSON(jsonBytes []byte) error {
+	return json.Unmarshal(jsonBytes, msg)
+}
+
+// Msgpack returns with the `Matrix` message content in MessagePack representation format
+func (msg *Matrix) Msgpack() []byte {
+	msgpackBytes, err := msgpack.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return msgpackBytes
+}
+
+// ParseMsgpack parses the MessagePack representation of a `Matrix` messages from the `msgpackBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *Matrix) ParseMsgpack(msgpackBytes []byte) error {
+	var decoded Matrix
+	if err := msgpack.Unmarshal(msgpackBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// CBOR returns with the `Matrix` message content in CBOR representation format
+func (msg *Matrix) CBOR() []byte {
+	cborBytes, err := cbor.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return cborBytes
+}
+
+// ParseCBOR parses the CBOR representation of a `Matrix` messages from the `cborBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *Matrix) ParseCBOR(cborBytes []byte) error {
+	var decoded Matrix
+	if err := cbor.Unmarshal(cborBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// Avro returns with the `Matrix` message content in Avro representation format
+func (msg *Matrix) Avro() []byte {
+	avroBytes, err := avro.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return avroBytes
+}
+
+// ParseAvro parses the Avro representation of a `Matrix` messages from the `avroBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *Matrix) ParseAvro(avroBytes []byte) error {
+	var decoded Matrix
+	if err := avro.Unmarshal(avroBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// XML returns with the `Matrix` message content in XML representation format
+func (msg *Matrix) XML() []byte {
+	xmlBytes, err := xml.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return xmlBytes
+}
+
+// ParseXML parses the XML representation of a `Matrix` messages from the `xmlBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *Matrix) ParseXML(xmlBytes []byte) error {
+	var decoded Matrix
+	if err := xml.Unmarshal(xmlBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// ROS1 returns with the `Matrix` message content in the ROS1 serialization format of the `std_msgs/Float64MultiArray` ROS message.
+// The ROS message has no header, so the timestamp of the message is not serialized.
+func (msg *Matrix) ROS1() []byte {
+	e := ros1.NewEncoder()
+	e.MultiArrayLayout([]ros1.MultiArrayDimension{
+		{Label: "rows", Size: uint32(msg.Body.Rows), Stride: uint32(msg.Body.Rows * msg.Body.Cols)},
+		{Label: "cols", Size: uint32(msg.Body.Cols), Stride: uint32(msg.Body.Cols)},
+	})
+	e.Float64Slice(msg.Body.Data)
+	return e.Bytes()
+}
+
+// ParseROS1 parses the ROS1 serialization of a `std_msgs/Float64MultiArray` ROS message from the `ros1Bytes` argument.
+// The ROS message holds no timestamp, so the header gets the current time in the current precision of the message,
+// or in the default precision if the message has no precision. The message is replaced as a whole by the decoded one.
+func (msg *Matrix) ParseROS1(ros1Bytes []byte) error {
+	var decoded Matrix
+	d := ros1.NewDecoder(ros1Bytes)
+	dims, offset := d.MultiArrayLayout()
+	data := d.Float64Slice()
+	if err := d.Close(); err != nil {
+		return err
+	}
+	if len(dims) != 2 {
+		return fmt.Errorf("the matrix has %d dimensions instead of 2", len(dims))
+	}
+	if offset > uint32(len(data)) {
+		return fmt.Errorf("the %d data offset is out of the %d values", offset, len(data))
+	}
+	decoded.Body = common.MatrixBody{Rows: int(dims[0].Size), Cols: int(dims[1].Size), Data: data[offset:]}
+	precision := msg.Header.TimePrecision
+	if precision == "" {
+		precision = common.DefaultTimePrecision
+	}
+	decoded.Header = common.NewHeaderAt(common.NowAsUnixWithPrecision(precision), precision)
+	decoded.Header.Version = msgs.GetMessageTypeVersion(MatrixTypeName)
+	if err := decoded.Body.Validate(); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// Protobuf returns with the `Matrix` message content in protobuf representation format
+func (msg *Matrix) Protobuf() []byte {
+	return common.MarshalProtobufMessage(msg.Header, msg.Body)
+}
+
+// ParseProtobuf parses the protobuf representation of a `Matrix` messages from the `protobufBytes` argument.
+// Protobuf omits the zero values, so the message is replaced as a whole by the decoded one.
+func (msg *Matrix) ParseProtobuf(protobufBytes []byte) error {
+	var decoded Matrix
+	if err := common.UnmarshalProtobufMessage(protobufBytes, &decoded.Header, &decoded.Body); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// YAML returns with the `Matrix` message content in YAML representation format
+func (msg *Matrix) YAML() []byte {
+	yamlBytes, err := yaml.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return yamlBytes
+}
+
+// ParseYAML parses the YAML representation of a `Matrix` messages from the `yamlBytes` argument.
+func (msg *Matrix) ParseYAML(yamlBytes []byte) error {
+	return yaml.Unmarshal(yamlBytes, msg)
+}
+
+// EncodeGob returns with the `Matrix` message content in Gob representation format
+func (msg *Matrix) EncodeGob() []byte {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(*msg); err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
+// DecodeGob parses the Gob representation of a `Matrix` messages from the `gobBytes` argument.
+// Gob omits the zero values, so the message is replaced as a whole by the decoded one.
+func (msg *Matrix) DecodeGob(gobBytes []byte) error {
+	var decoded Matrix
+	if err := gob.NewDecoder(bytes.NewReader(gobBytes)).Decode(&decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// NewMatrixMessage returns with a new `Matrix` message of `rows` x `cols` shape holding the `data` values in row-major order.
+// The header will contain the current time in `Nanoseconds` precision.
+// It panics if the shape does not match to the number of values.
+func NewMatrixMessage(rows int, cols int, data []float64) msgs.Message {
+	return NewMatrixMessageAt(rows, cols, data, time.Now().UnixNano(), "ns")
+}
+
+// NewMatrixMessageAt returns with a new `Matrix` message of `rows` x `cols` shape holding the `data` values in row-major order.
+// The header will contain the `at` time in `withPrecision` precision.
+// It panics if the shape does not match to the number of values.
+func NewMatrixMessageAt(rows int, cols int, data []float64, at int64, withPrecision common.TimePrecision) msgs.Message {
+	var msg Matrix
+	msg.Header = common.NewHeaderAt(at, withPrecision)
+	msg.Header.Version = msgs.GetMessageTypeVersion(MatrixTypeName)
+	msg.Body = common.MatrixBody{Rows: rows, Cols: cols, Data: data}
+	if err := msg.Body.Validate(); err != nil {
+		panic(err)
+	}
+	return &msg
+}
@@ -0,0 +1,60 @@
+package base
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/tombenke/axon-go-common/msgs"
+	"github.com/tombenke/axon-go-common/msgs/common"
+	"testing"
+)
+
+func TestMatrixGetType(t *testing.T) {
+	assert.Equal(t, NewMatrixMessage(0, 0, []float64{}).GetType(), MatrixTypeName)
+}
+
+func TestMatrixMessageCodecs(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewMatrixMessageAt(2, 3, []float64{1, 2, 3, 4, 5, 6}, at, prec)
+
+	for _, representation := range []msgs.Representation{
+		msgs.JSONRepresentation,
+		msgs.YAMLRepresentation,
+		msgs.GobRepresentation,
+		msgs.ProtobufRepresentation,
+		msgs.XMLRepresentation,
+		msgs.AvroRepresentation,
+		msgs.MsgpackRepresentation,
+		msgs.CBORRepresentation,
+	} {
+		t.Run(string(representation), func(t *testing.T) {
+			var n Matrix
+			err := n.Decode(representation, m.Encode(representation))
+			assert.Nil(t, err)
+			assert.Equal(t, m, &n)
+		})
+	}
+}
+
+func TestMatrixElements(t *testing.T) {
+	m := NewMatrixMessage(2, 3, []float64{1, 2, 3, 4, 5, 6}).(*Matrix)
+	rows, cols := m.Body.Shape()
+	assert.Equal(t, 2, rows)
+	assert.Equal(t, 3, cols)
+	assert.Equal(t, 6., m.Body.At(1, 2))
+	m.Body.Set(1, 0, 42)
+	assert.Equal(t, []float64{42, 5, 6}, m.Body.Row(1))
+	assert.Panics(t, func() { m.Body.At(2, 0) })
+	assert.Panics(t, func() { m.Body.Row(-1) })
+
+	z := common.NewMatrixBody(3, 2)
+	assert.Equal(t, make([]float64, 6), z.Data)
+}
+
+func TestMatrixShapeValidation(t *testing.T) {
+	assert.Panics(t, func() { NewMatrixMessage(2, 2, []float64{1, 2, 3}) })
+	assert.Panics(t, func() { NewMatrixMessage(-1, 0, []float64{}) })
+
+	var n Matrix
+	err := n.Decode(msgs.JSONRepresentation, []byte(`{"Header": {"TimePrecision": "ns", "Timestamp": 0}, "Body": {"Rows": 2, "Cols": 2, "Data": [1, 2, 3]}}`))
+	assert.NotNil(t, err)
+}
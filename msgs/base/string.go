@@ -1,10 +1,18 @@
 package base
 
 import (
+	"bytes"
+	"encoding/gob"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
+	"github.com/fxamacker/cbor/v2"
 	"github.com/tombenke/axon-go-common/msgs"
+	"github.com/tombenke/axon-go-common/msgs/avro"
 	"github.com/tombenke/axon-go-common/msgs/common"
+	"github.com/tombenke/axon-go-common/msgs/ros1"
+	"github.com/vmihailenco/msgpack/v5"
+	"gopkg.in/yaml.v2"
 	"time"
 )
 
@@ -14,9 +22,11 @@ const (
 )
 
 func init() {
-	msgs.RegisterMessageType(StringTypeName, []msgs.Representation{msgs.JSONRepresentation}, func() msgs.Message {
+	msgs.RegisterMessageType(StringTypeName, []msgs.Representation{msgs.JSONRepresentation, msgs.YAMLRepresentation, msgs.GobRepresentation, msgs.ProtobufRepresentation, msgs.CSVRepresentation, msgs.CSVHeaderRepresentation, msgs.TextRepresentation, msgs.XMLRepresentation, msgs.AvroRepresentation, msgs.MsgpackRepresentation, msgs.CBORRepresentation, msgs.ROS1Representation}, func() msgs.Message {
 		return NewStringMessage("")
 	})
+	msgs.SetMessageTypeDescription(StringTypeName, "String value of the sensors and actuators")
+	msgs.SetMessageTypeROSType(StringTypeName, "std_msgs/String")
 }
 
 // String represents the structure of the messages emitted or consumed by the string-type sensors and actuators.
@@ -39,17 +49,63 @@ func (msg *String) Encode(representation msgs.Representation) (results []byte) {
 		if err != nil {
 			panic(err)
 		}
+	case msgs.YAMLRepresentation:
+		results = msg.YAML()
+	case msgs.GobRepresentation:
+		results = msg.EncodeGob()
+	case msgs.ProtobufRepresentation:
+		results = msg.Protobuf()
+	case msgs.CSVRepresentation, msgs.CSVHeaderRepresentation:
+		results = msg.CSV(representation == msgs.CSVHeaderRepresentation)
+	case msgs.TextRepresentation:
+		results = msg.Text()
+	case msgs.XMLRepresentation:
+		results = msg.XML()
+	case msgs.AvroRepresentation:
+		results = msg.Avro()
+	case msgs.MsgpackRepresentation:
+		results = msg.Msgpack()
+	case msgs.CBORRepresentation:
+		results = msg.CBOR()
+	case msgs.ROS1Representation:
+		results = msg.ROS1()
 	default:
 		panic(fmt.Errorf("Encode error: unknown representational format '%s'", representation))
 	}
 	return results
 }
 
-// Decode parses the `content` using the selected `representation` format
+// Decode parses the `content` using the selected `representation` format.
+// The messages of older versions are upgraded to the current version of the message-type, if the `representation` is upgradable.
 func (msg *String) Decode(representation msgs.Representation, content []byte) error {
+	content, err := msgs.Upgrade(StringTypeName, representation, content)
+	if err != nil {
+		return err
+	}
+
 	switch representation {
 	case msgs.JSONRepresentation:
 		return json.Unmarshal(content, msg)
+	case msgs.YAMLRepresentation:
+		return msg.ParseYAML(content)
+	case msgs.GobRepresentation:
+		return msg.DecodeGob(content)
+	case msgs.ProtobufRepresentation:
+		return msg.ParseProtobuf(content)
+	case msgs.CSVRepresentation, msgs.CSVHeaderRepresentation:
+		return msg.ParseCSV(content)
+	case msgs.TextRepresentation:
+		return msg.ParseText(content)
+	case msgs.XMLRepresentation:
+		return msg.ParseXML(content)
+	case msgs.AvroRepresentation:
+		return msg.ParseAvro(content)
+	case msgs.MsgpackRepresentation:
+		return msg.ParseMsgpack(content)
+	case msgs.CBORRepresentation:
+		return msg.ParseCBOR(content)
+	case msgs.ROS1Representation:
+		return msg.ParseROS1(content)
 	default:
 		panic(fmt.Errorf("Decode error: unknown representational format '%s'", representation))
 	}
@@ -78,6 +134,203 @@ func (msg *String) ParseJSON(jsonBytes []byte) error {
 	return json.Unmarshal(jsonBytes, msg)
 }
 
+// Msgpack returns with the `String` message content in MessagePack representation format
+func (msg *String) Msgpack() []byte {
+	msgpackBytes, err := msgpack.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return msgpackBytes
+}
+
+// ParseMsgpack parses the MessagePack representation of a `String` messages from the `msgpackBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *String) ParseMsgpack(msgpackBytes []byte) error {
+	var decoded String
+	if err := msgpack.Unmarshal(msgpackBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// CBOR returns with the `String` message content in CBOR representation format
+func (msg *String) CBOR() []byte {
+	cborBytes, err := cbor.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return cborBytes
+}
+
+// ParseCBOR parses the CBOR representation of a `String` messages from the `cborBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *String) ParseCBOR(cborBytes []byte) error {
+	var decoded String
+	if err := cbor.Unmarshal(cborBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// Avro returns with the `String` message content in Avro representation format
+func (msg *String) Avro() []byte {
+	avroBytes, err := avro.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return avroBytes
+}
+
+// ParseAvro parses the Avro representation of a `String` messages from the `avroBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *String) ParseAvro(avroBytes []byte) error {
+	var decoded String
+	if err := avro.Unmarshal(avroBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// XML returns with the `String` message content in XML representation format
+func (msg *String) XML() []byte {
+	xmlBytes, err := xml.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return xmlBytes
+}
+
+// ParseXML parses the XML representation of a `String` messages from the `xmlBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *String) ParseXML(xmlBytes []byte) error {
+	var decoded String
+	if err := xml.Unmarshal(xmlBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// CSV returns with the `String` message content in CSV representation format, as a `timestamp,values...` record.
+// The record is preceded by a header row, if `withHeaderRow` is true.
+func (msg *String) CSV(withHeaderRow bool) []byte {
+	return common.MarshalCSVMessage(msg.Header, msg.Body, withHeaderRow)
+}
+
+// ParseCSV parses the CSV representation of a `String` messages from the `csvBytes` argument.
+// If the content has no header row that tells the time precision, the current precision of the message is kept.
+func (msg *String) ParseCSV(csvBytes []byte) error {
+	decoded := String{Header: msg.Header}
+	if err := common.UnmarshalCSVMessage(csvBytes, &decoded.Header, &decoded.Body); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// Text returns with the body of the `String` message in plain text representation format
+func (msg *String) Text() []byte {
+	return []byte(msg.Body.Text())
+}
+
+// ParseText parses the plain text representation of a `String` messages from the `textBytes` argument.
+// The plain text holds no timestamp, so the header gets the current time in the current precision of the message,
+// or in the default precision if the message has no precision.
+func (msg *String) ParseText(textBytes []byte) error {
+	var decoded String
+	if err := decoded.Body.ParseText(string(textBytes)); err != nil {
+		return err
+	}
+	precision := msg.Header.TimePrecision
+	if precision == "" {
+		precision = common.DefaultTimePrecision
+	}
+	decoded.Header = common.NewHeaderAt(common.NowAsUnixWithPrecision(precision), precision)
+	*msg = decoded
+	return nil
+}
+
+// ROS1 returns with the `String` message content in the ROS1 serialization format of the `std_msgs/String` ROS message.
+// The ROS message has no header, so the timestamp of the message is not serialized.
+func (msg *String) ROS1() []byte {
+	e := ros1.NewEncoder()
+	e.String(msg.Body.Data)
+	return e.Bytes()
+}
+
+// ParseROS1 parses the ROS1 serialization of a `std_msgs/String` ROS message from the `ros1Bytes` argument.
+// The ROS message holds no timestamp, so the header gets the current time in the current precision of the message,
+// or in the default precision if the message has no precision. The message is replaced as a whole by the decoded one.
+func (msg *String) ParseROS1(ros1Bytes []byte) error {
+	var decoded String
+	d := ros1.NewDecoder(ros1Bytes)
+	decoded.Body.Data = d.String()
+	if err := d.Close(); err != nil {
+		return err
+	}
+	precision := msg.Header.TimePrecision
+	if precision == "" {
+		precision = common.DefaultTimePrecision
+	}
+	decoded.Header = common.NewHeaderAt(common.NowAsUnixWithPrecision(precision), precision)
+	decoded.Header.Version = msgs.GetMessageTypeVersion(StringTypeName)
+	*msg = decoded
+	return nil
+}
+
+// Protobuf returns with the `String` message content in protobuf representation format
+func (msg *String) Protobuf() []byte {
+	return common.MarshalProtobufMessage(msg.Header, msg.Body)
+}
+
+// ParseProtobuf parses the protobuf representation of a `String` messages from the `protobufBytes` argument.
+// Protobuf omits the zero values, so the message is replaced as a whole by the decoded one.
+func (msg *String) ParseProtobuf(protobufBytes []byte) error {
+	var decoded String
+	if err := common.UnmarshalProtobufMessage(protobufBytes, &decoded.Header, &decoded.Body); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// YAML returns with the `String` message content in YAML representation format
+func (msg *String) YAML() []byte {
+	yamlBytes, err := yaml.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return yamlBytes
+}
+
+// ParseYAML parses the YAML representation of a `String` messages from the `yamlBytes` argument.
+func (msg *String) ParseYAML(yamlBytes []byte) error {
+	return yaml.Unmarshal(yamlBytes, msg)
+}
+
+// EncodeGob returns with the `String` message content in Gob representation format
+func (msg *String) EncodeGob() []byte {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(*msg); err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
+// DecodeGob parses the Gob representation of a `String` messages from the `gobBytes` argument.
+// Gob omits the zero values, so the message is replaced as a whole by the decoded one.
+func (msg *String) DecodeGob(gobBytes []byte) error {
+	var decoded String
+	if err := gob.NewDecoder(bytes.NewReader(gobBytes)).Decode(&decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
 // NewStringMessage returns with a new `String` message. The header will contain the current time in `Nanoseconds` precision.
 func NewStringMessage(data string) msgs.Message {
 	return NewStringMessageAt(data, time.Now().UnixNano(), "ns")
@@ -87,6 +340,7 @@ func NewStringMessage(data string) msgs.Message {
 func NewStringMessageAt(data string, at int64, withPrecision common.TimePrecision) msgs.Message {
 	var msg String
 	msg.Header = common.NewHeaderAt(at, withPrecision)
+	msg.Header.Version = msgs.GetMessageTypeVersion(StringTypeName)
 	msg.Body.Data = data
 	return &msg
 }
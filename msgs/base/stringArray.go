@@ -0,0 +1,265 @@
+package base
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"github.com/fxamacker/cbor/v2"
+	"github.com/tombenke/axon-go-common/msgs"
+	"github.com/tombenke/axon-go-common/msgs/avro"
+	"github.com/tombenke/axon-go-common/msgs/common"
+	"github.com/vmihailenco/msgpack/v5"
+	"gopkg.in/yaml.v2"
+	"time"
+)
+
+const (
+	// StringArrayTypeName is the printable name of the `StringArray` message-type
+	StringArrayTypeName = "base/StringArray"
+)
+
+func init() {
+	msgs.RegisterMessageType(StringArrayTypeName, []msgs.Representation{msgs.JSONRepresentation, msgs.YAMLRepresentation, msgs.GobRepresentation, msgs.ProtobufRepresentation, msgs.XMLRepresentation, msgs.AvroRepresentation, msgs.MsgpackRepresentation, msgs.CBORRepresentation}, func() msgs.Message {
+		return NewStringArrayMessage([]string{})
+	})
+	msgs.SetMessageTypeDescription(StringArrayTypeName, "List of string values")
+}
+
+// StringArray represents the structure of the messages that hold a list of string values.
+type StringArray struct {
+	Header common.Header
+	Body   common.StringArrayBody
+}
+
+// GetType returns with the printable name of the `StringArray` message-type
+func (msg *StringArray) GetType() string {
+	return StringArrayTypeName
+}
+
+// Encode returns with the `StringArray` message content in a representation format selected by `representation`
+func (msg *StringArray) Encode(representation msgs.Representation) (results []byte) {
+	switch representation {
+	case msgs.JSONRepresentation:
+		var err error
+		results, err = json.Marshal(*msg)
+		if err != nil {
+			panic(err)
+		}
+	case msgs.YAMLRepresentation:
+		results = msg.YAML()
+	case msgs.GobRepresentation:
+		results = msg.EncodeGob()
+	case msgs.ProtobufRepresentation:
+		results = msg.Protobuf()
+	case msgs.XMLRepresentation:
+		results = msg.XML()
+	case msgs.AvroRepresentation:
+		results = msg.Avro()
+	case msgs.MsgpackRepresentation:
+		results = msg.Msgpack()
+	case msgs.CBORRepresentation:
+		results = msg.CBOR()
+	default:
+		panic(fmt.Errorf("Encode error: unknown representational format '%s'", representation))
+	}
+	return results
+}
+
+// Decode parses the `content` using the selected `representation` format.
+// The messages of older versions are upgraded to the current version of the message-type, if the `representation` is upgradable.
+func (msg *StringArray) Decode(representation msgs.Representation, content []byte) error {
+	content, err := msgs.Upgrade(StringArrayTypeName, representation, content)
+	if err != nil {
+		return err
+	}
+
+	switch representation {
+	case msgs.JSONRepresentation:
+		return json.Unmarshal(content, msg)
+	case msgs.YAMLRepresentation:
+		return msg.ParseYAML(content)
+	case msgs.GobRepresentation:
+		return msg.DecodeGob(content)
+	case msgs.ProtobufRepresentation:
+		return msg.ParseProtobuf(content)
+	case msgs.XMLRepresentation:
+		return msg.ParseXML(content)
+	case msgs.AvroRepresentation:
+		return msg.ParseAvro(content)
+	case msgs.MsgpackRepresentation:
+		return msg.ParseMsgpack(content)
+	case msgs.CBORRepresentation:
+		return msg.ParseCBOR(content)
+	default:
+		panic(fmt.Errorf("Decode error: unknown representational format '%s'", representation))
+	}
+}
+
+// JSON returns with the `StringArray` message content in JSON representation format
+func (msg *StringArray) JSON() []byte {
+	jsonBytes, err := json.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return jsonBytes
+}
+
+// String returns with the `StringArray` message content in JSON format string
+func (msg *StringArray) String() string {
+	jsonBytes, err := json.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return string(jsonBytes)
+}
+
+// ParseJSON parses the JSON representation of a `StringArray` messages from the `jsonBytes` argument.
+func (msg *StringArray) ParseJSON(jsonBytes []byte) error {
+	return json.Unmarshal(jsonBytes, msg)
+}
+
+// Msgpack returns with the `StringArray` message content in MessagePack representation format
+func (msg *StringArray) Msgpack() []byte {
+	msgpackBytes, err := msgpack.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return msgpackBytes
+}
+
+// ParseMsgpack parses the MessagePack representation of a `StringArray` messages from the `msgpackBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *StringArray) ParseMsgpack(msgpackBytes []byte) error {
+	var decoded StringArray
+	if err := msgpack.Unmarshal(msgpackBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// CBOR returns with the `StringArray` message content in CBOR representation format
+func (msg *StringArray) CBOR() []byte {
+	cborBytes, err := cbor.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return cborBytes
+}
+
+// ParseCBOR parses the CBOR representation of a `StringArray` messages from the `cborBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *StringArray) ParseCBOR(cborBytes []byte) error {
+	var decoded StringArray
+	if err := cbor.Unmarshal(cborBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// Avro returns with the `StringArray` message content in Avro representation format
+func (msg *StringArray) Avro() []byte {
+	avroBytes, err := avro.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return avroBytes
+}
+
+// ParseAvro parses the Avro representation of a `StringArray` messages from the `avroBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *StringArray) ParseAvro(avroBytes []byte) error {
+	var decoded StringArray
+	if err := avro.Unmarshal(avroBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// XML returns with the `StringArray` message content in XML representation format
+func (msg *StringArray) XML() []byte {
+	xmlBytes, err := xml.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return xmlBytes
+}
+
+// ParseXML parses the XML representation of a `StringArray` messages from the `xmlBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *StringArray) ParseXML(xmlBytes []byte) error {
+	var decoded StringArray
+	if err := xml.Unmarshal(xmlBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// Protobuf returns with the `StringArray` message content in protobuf representation format
+func (msg *StringArray) Protobuf() []byte {
+	return common.MarshalProtobufMessage(msg.Header, msg.Body)
+}
+
+// ParseProtobuf parses the protobuf representation of a `StringArray` messages from the `protobufBytes` argument.
+// Protobuf omits the zero values, so the message is replaced as a whole by the decoded one.
+func (msg *StringArray) ParseProtobuf(protobufBytes []byte) error {
+	var decoded StringArray
+	if err := common.UnmarshalProtobufMessage(protobufBytes, &decoded.Header, &decoded.Body); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// YAML returns with the `StringArray` message content in YAML representation format
+func (msg *StringArray) YAML() []byte {
+	yamlBytes, err := yaml.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return yamlBytes
+}
+
+// ParseYAML parses the YAML representation of a `StringArray` messages from the `yamlBytes` argument.
+func (msg *StringArray) ParseYAML(yamlBytes []byte) error {
+	return yaml.Unmarshal(yamlBytes, msg)
+}
+
+// EncodeGob returns with the `StringArray` message content in Gob representation format
+func (msg *StringArray) EncodeGob() []byte {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(*msg); err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
+// DecodeGob parses the Gob representation of a `StringArray` messages from the `gobBytes` argument.
+// Gob omits the zero values, so the message is replaced as a whole by the decoded one.
+func (msg *StringArray) DecodeGob(gobBytes []byte) error {
+	var decoded StringArray
+	if err := gob.NewDecoder(bytes.NewReader(gobBytes)).Decode(&decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// NewStringArrayMessage returns with a new `StringArray` message. The header will contain the current time in `Nanoseconds` precision.
+func NewStringArrayMessage(data []string) msgs.Message {
+	return NewStringArrayMessageAt(data, time.Now().UnixNano(), "ns")
+}
+
+// NewStringArrayMessageAt returns with a new `StringArray` message. The header will contain the `at` time in `withPrecision` precision.
+func NewStringArrayMessageAt(data []string, at int64, withPrecision common.TimePrecision) msgs.Message {
+	var msg StringArray
+	msg.Header = common.NewHeaderAt(at, withPrecision)
+	msg.Header.Version = msgs.GetMessageTypeVersion(StringArrayTypeName)
+	msg.Body.Data = data
+	return &msg
+}
@@ -0,0 +1,36 @@
+package base
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/tombenke/axon-go-common/msgs"
+	"github.com/tombenke/axon-go-common/msgs/common"
+	"testing"
+)
+
+func TestStringArrayGetType(t *testing.T) {
+	assert.Equal(t, NewStringArrayMessage([]string{}).GetType(), StringArrayTypeName)
+}
+
+func TestStringArrayMessageCodecs(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewStringArrayMessageAt([]string{"a", "", "b c"}, at, prec)
+
+	for _, representation := range []msgs.Representation{
+		msgs.JSONRepresentation,
+		msgs.YAMLRepresentation,
+		msgs.GobRepresentation,
+		msgs.ProtobufRepresentation,
+		msgs.XMLRepresentation,
+		msgs.AvroRepresentation,
+		msgs.MsgpackRepresentation,
+		msgs.CBORRepresentation,
+	} {
+		t.Run(string(representation), func(t *testing.T) {
+			var n StringArray
+			err := n.Decode(representation, m.Encode(representation))
+			assert.Nil(t, err)
+			assert.Equal(t, m, &n)
+		})
+	}
+}
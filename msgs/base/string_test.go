@@ -34,6 +34,96 @@ func TestStringMessageCodec(t *testing.T) {
 	assert.Equal(t, m, &n)
 }
 
+func TestStringMessageMsgpackCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewStringMessageAt("Some text...", at, prec)
+	var n String
+	err := n.Decode(msgs.MsgpackRepresentation, m.Encode(msgs.MsgpackRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestStringMessageCBORCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewStringMessageAt("Some text...", at, prec)
+	var n String
+	err := n.Decode(msgs.CBORRepresentation, m.Encode(msgs.CBORRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestStringMessageAvroCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewStringMessageAt("Some text...", at, prec)
+	var n String
+	err := n.Decode(msgs.AvroRepresentation, m.Encode(msgs.AvroRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestStringMessageXMLCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewStringMessageAt("Some text...", at, prec)
+	var n String
+	err := n.Decode(msgs.XMLRepresentation, m.Encode(msgs.XMLRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestStringMessageCSVCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewStringMessageAt("Some text...", at, prec)
+	var n String
+	err := n.Decode(msgs.CSVRepresentation, m.Encode(msgs.CSVRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestStringMessageCSVHeaderCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewStringMessageAt("Some text...", at, prec)
+	var n String
+	err := n.Decode(msgs.CSVHeaderRepresentation, m.Encode(msgs.CSVHeaderRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestStringMessageProtobufCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewStringMessageAt("Some text...", at, prec)
+	var n String
+	err := n.Decode(msgs.ProtobufRepresentation, m.Encode(msgs.ProtobufRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestStringMessageYAMLCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewStringMessageAt("Some text...", at, prec)
+	var n String
+	err := n.Decode(msgs.YAMLRepresentation, m.Encode(msgs.YAMLRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestStringMessageGobCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewStringMessageAt("Some text...", at, prec)
+	var n String
+	err := n.Decode(msgs.GobRepresentation, m.Encode(msgs.GobRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
 func TestStringMessageCodecPanic(t *testing.T) {
 	at := int64(1608732048980057025)
 	prec := common.TimePrecision("ns")
@@ -58,3 +148,13 @@ func TestStringMessageCodecPanic(t *testing.T) {
 		assert.Nil(t, err)
 	}()
 }
+
+func TestStringMessageTextCodec(t *testing.T) {
+	m := NewStringMessageAt("Some text...", int64(1608732048980057025), common.TimePrecision("ms"))
+	n := String{Header: common.NewHeaderAt(0, common.TimePrecision("ms"))}
+	err := n.Decode(msgs.TextRepresentation, m.Encode(msgs.TextRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, common.StringBody{Data: "Some text..."}, n.Body)
+	assert.Equal(t, common.TimePrecision("ms"), n.Header.TimePrecision)
+	assert.NotEqual(t, int64(0), n.Header.Timestamp)
+}
@@ -0,0 +1,67 @@
+package msgs
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrUnsupportedRepresentation is returned by EncodeE and DecodeE
+// if the message-type does not implement the requested representation format
+var ErrUnsupportedRepresentation = errors.New("unsupported representation format")
+
+// EncodeE returns with the content of `msg` in the `representation` format.
+// Unlike `msg.Encode()`, it does not panic, but returns with an error
+// if the representation format is not supported by the message-type, or the encoding fails.
+func EncodeE(msg Message, representation Representation) (content []byte, err error) {
+	if !DoesMessageTypeImplementsRepresentation(msg.GetType(), representation) {
+		return nil, fmt.Errorf("'%s' message-type can not be encoded to '%s': %w", msg.GetType(), representation, ErrUnsupportedRepresentation)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			content, err = nil, fmt.Errorf("'%s' message-type can not be encoded to '%s': %v", msg.GetType(), representation, r)
+		}
+	}()
+
+	return msg.Encode(representation), nil
+}
+
+// DecodeE loads the `content` given in the `representation` format into `msg`.
+// Unlike `msg.Decode()`, it does not panic, but returns with an error
+// if the representation format is not supported by the message-type, or the decoding fails.
+func DecodeE(msg Message, representation Representation, content []byte) (err error) {
+	if !DoesMessageTypeImplementsRepresentation(msg.GetType(), representation) {
+		return fmt.Errorf("'%s' message-type can not be decoded from '%s': %w", msg.GetType(), representation, ErrUnsupportedRepresentation)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("'%s' message-type can not be decoded from '%s': %v", msg.GetType(), representation, r)
+		}
+	}()
+
+	if err := msg.Decode(representation, content); err != nil {
+		return fmt.Errorf("'%s' message-type can not be decoded from '%s': %w", msg.GetType(), representation, err)
+	}
+	return nil
+}
+
+// errorCodec wraps a message to provide the ErrorCodec interface for it
+type errorCodec struct {
+	msg Message
+}
+
+// NewErrorCodec returns with an ErrorCodec that encodes and decodes `msg` using EncodeE and DecodeE
+func NewErrorCodec(msg Message) ErrorCodec {
+	return errorCodec{msg: msg}
+}
+
+// EncodeE returns with the content of the wrapped message in the `representation` format
+func (c errorCodec) EncodeE(representation Representation) ([]byte, error) {
+	return EncodeE(c.msg, representation)
+}
+
+// DecodeE loads the `content` given in the `representation` format into the wrapped message
+func (c errorCodec) DecodeE(representation Representation, content []byte) error {
+	return DecodeE(c.msg, representation, content)
+}
@@ -0,0 +1,61 @@
+package common
+
+import (
+	"google.golang.org/protobuf/encoding/protowire"
+	"strconv"
+	"strings"
+)
+
+// BoolArrayBody holds the body part of a message that contains a list of boolean values
+type BoolArrayBody struct {
+	Data []bool
+}
+
+// AppendProtobuf appends the protobuf encoded fields of the body to `b`
+func (body BoolArrayBody) AppendProtobuf(b []byte) []byte {
+	b = AppendProtobufPackedBools(b, 1, body.Data)
+	return b
+}
+
+// ParseProtobuf parses the protobuf encoded body from `b`
+func (body *BoolArrayBody) ParseProtobuf(b []byte) error {
+	fields, err := ParseProtobufFields(b)
+	if err != nil {
+		return err
+	}
+
+	body.Data = []bool{}
+	for _, f := range fields {
+		switch f.Num {
+		case 1:
+			for _, v := range f.Varints() {
+				body.Data = append(body.Data, protowire.DecodeBool(v))
+			}
+		}
+	}
+	return nil
+}
+
+// Text returns with the body values in plain text format, separated by space
+func (body BoolArrayBody) Text() string {
+	values := make([]string, len(body.Data))
+	for i, v := range body.Data {
+		values[i] = strconv.FormatBool(v)
+	}
+	return strings.Join(values, " ")
+}
+
+// ParseText parses the body from the `text` in plain text format.
+// The text holds the values separated by white space.
+func (body *BoolArrayBody) ParseText(text string) error {
+	values := strings.Fields(text)
+	data := make([]bool, len(values))
+	for i, v := range values {
+		var err error
+		if data[i], err = strconv.ParseBool(v); err != nil {
+			return err
+		}
+	}
+	body.Data = data
+	return nil
+}
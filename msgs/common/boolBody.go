@@ -1,6 +1,66 @@
 package common
 
+import (
+	"strconv"
+	"strings"
+)
+
 // BoolBody holds the body part of a plain boolean data message
 type BoolBody struct {
 	Data bool
 }
+
+// AppendProtobuf appends the protobuf encoded fields of the body to `b`
+func (body BoolBody) AppendProtobuf(b []byte) []byte {
+	b = AppendProtobufBool(b, 1, body.Data)
+	return b
+}
+
+// ParseProtobuf parses the protobuf encoded body from `b`
+func (body *BoolBody) ParseProtobuf(b []byte) error {
+	fields, err := ParseProtobufFields(b)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range fields {
+		switch f.Num {
+		case 1:
+			body.Data = f.Bool()
+		}
+	}
+	return nil
+}
+
+// CSVColumns returns with the names of the CSV columns of the body values
+func (body BoolBody) CSVColumns() []string {
+	return []string{"data"}
+}
+
+// CSVValues returns with the body values in text format
+func (body BoolBody) CSVValues() []string {
+	return []string{strconv.FormatBool(body.Data)}
+}
+
+// ParseCSVValues parses the body from the `values` in text format
+func (body *BoolBody) ParseCSVValues(values []string) error {
+	if err := checkCSVValues(values, body.CSVColumns()); err != nil {
+		return err
+	}
+
+	var err error
+	if body.Data, err = strconv.ParseBool(strings.TrimSpace(values[0])); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Text returns with the body value in plain text format
+func (body BoolBody) Text() string {
+	return body.CSVValues()[0]
+}
+
+// ParseText parses the body from the `text` in plain text format
+func (body *BoolBody) ParseText(text string) error {
+	return body.ParseCSVValues([]string{text})
+}
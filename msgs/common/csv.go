@@ -0,0 +1,98 @@
+package common
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// timestampColumn is the name of the first column of the CSV records, that holds the timestamp of the message.
+// In the header row the name is extended with the time precision, e.g. `timestamp_ms`.
+const timestampColumn = "timestamp"
+
+// CSVMarshaler is implemented by the body parts of the messages that can be encoded into CSV format
+type CSVMarshaler interface {
+	// CSVColumns returns with the names of the columns of the body values
+	CSVColumns() []string
+
+	// CSVValues returns with the body values in text format
+	CSVValues() []string
+}
+
+// CSVUnmarshaler is implemented by the body parts of the messages that can be decoded from CSV format
+type CSVUnmarshaler interface {
+	// ParseCSVValues parses the body from the `values` in text format
+	ParseCSVValues(values []string) error
+}
+
+// MarshalCSVMessage returns with the CSV record of the message made of the `header` and the `body`.
+// The record starts with the timestamp of the message, that is followed by the values of the body.
+// If `withHeaderRow` is true, the record is preceded by a header row that holds the names of the columns,
+// and the name of the timestamp column tells the time precision, e.g. `timestamp_ms,data`.
+func MarshalCSVMessage(header Header, body CSVMarshaler, withHeaderRow bool) []byte {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	if withHeaderRow {
+		columns := append([]string{timestampColumn + "_" + string(header.TimePrecision)}, body.CSVColumns()...)
+		if err := w.Write(columns); err != nil {
+			panic(err)
+		}
+	}
+
+	record := append([]string{strconv.FormatInt(header.Timestamp, 10)}, body.CSVValues()...)
+	if err := w.Write(record); err != nil {
+		panic(err)
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
+// UnmarshalCSVMessage parses the CSV record of a message from `content` into the `header` and the `body`.
+// The content may start with a header row. If the name of the timestamp column holds the time precision,
+// e.g. `timestamp_ms`, then it is used, otherwise the current precision of the `header` is kept,
+// or the `DefaultTimePrecision` is used if the `header` has no precision.
+// The content must hold exactly one record besides the optional header row.
+func UnmarshalCSVMessage(content []byte, header *Header, body CSVUnmarshaler) error {
+	records, err := csv.NewReader(bytes.NewReader(content)).ReadAll()
+	if err != nil {
+		return err
+	}
+
+	if header.TimePrecision == "" {
+		header.TimePrecision = DefaultTimePrecision
+	}
+	if len(records) > 0 && strings.HasPrefix(records[0][0], timestampColumn) {
+		precision := strings.TrimPrefix(strings.TrimPrefix(records[0][0], timestampColumn), "_")
+		if precision != "" {
+			header.TimePrecision = TimePrecision(precision)
+		}
+		records = records[1:]
+	}
+
+	if len(records) != 1 {
+		return fmt.Errorf("CSV content must hold exactly one record, but it holds %d", len(records))
+	}
+
+	record := records[0]
+	header.Timestamp, err = strconv.ParseInt(strings.TrimSpace(record[0]), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	return body.ParseCSVValues(record[1:])
+}
+
+// checkCSVValues returns error if the number of `values` differs from the number of `columns`
+func checkCSVValues(values []string, columns []string) error {
+	if len(values) != len(columns) {
+		return fmt.Errorf("wrong number of CSV values: expected %d, got %d", len(columns), len(values))
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package common
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestMarshalCSVMessage(t *testing.T) {
+	header := NewHeaderAt(1608732048980, Milliseconds)
+	body := Float64VarBody{Data: 21.5, Variance: 0.1}
+	assert.Equal(t, "1608732048980,21.5,0.1\n", string(MarshalCSVMessage(header, body, false)))
+	assert.Equal(t, "timestamp_ms,data,variance\n1608732048980,21.5,0.1\n", string(MarshalCSVMessage(header, body, true)))
+
+	// The values are quoted if needed
+	assert.Equal(t, "1608732048980,\"Hello, World\"\n", string(MarshalCSVMessage(header, StringBody{Data: "Hello, World"}, false)))
+}
+
+func TestUnmarshalCSVMessage(t *testing.T) {
+	var body Float64VarBody
+
+	// The precision comes from the header row
+	header := NewHeaderAt(0, Nanoseconds)
+	assert.Nil(t, UnmarshalCSVMessage([]byte("timestamp_ms,data,variance\n1608732048980,21.5,0.1\n"), &header, &body))
+	assert.Equal(t, NewHeaderAt(1608732048980, Milliseconds), header)
+	assert.Equal(t, Float64VarBody{Data: 21.5, Variance: 0.1}, body)
+
+	// The precision of the header is kept without header row, or if the header row does not tell it
+	header = NewHeaderAt(0, Seconds)
+	assert.Nil(t, UnmarshalCSVMessage([]byte("1608732048,21.5,0.1"), &header, &body))
+	assert.Equal(t, NewHeaderAt(1608732048, Seconds), header)
+	assert.Nil(t, UnmarshalCSVMessage([]byte("timestamp,data,variance\n1608732049,21.5,0.1"), &header, &body))
+	assert.Equal(t, NewHeaderAt(1608732049, Seconds), header)
+
+	// The default precision is used if the header has no precision
+	header = Header{}
+	assert.Nil(t, UnmarshalCSVMessage([]byte("1608732048980057025,21.5,0.1"), &header, &body))
+	assert.Equal(t, NewHeaderAt(1608732048980057025, DefaultTimePrecision), header)
+
+	// Wrong contents
+	assert.Equal(t, "CSV content must hold exactly one record, but it holds 2", UnmarshalCSVMessage([]byte("1,21.5,0.1\n2,21.6,0.1\n"), &header, &body).Error())
+	assert.Equal(t, "CSV content must hold exactly one record, but it holds 0", UnmarshalCSVMessage([]byte(""), &header, &body).Error())
+	assert.Equal(t, "wrong number of CSV values: expected 2, got 1", UnmarshalCSVMessage([]byte("1,21.5"), &header, &body).Error())
+	assert.NotNil(t, UnmarshalCSVMessage([]byte("now,21.5,0.1"), &header, &body))
+	assert.NotNil(t, UnmarshalCSVMessage([]byte("1,hot,0.1"), &header, &body))
+}
+
+func TestParseText(t *testing.T) {
+	var float64VarBody Float64VarBody
+	assert.Nil(t, float64VarBody.ParseText("21.5"))
+	assert.Equal(t, Float64VarBody{Data: 21.5}, float64VarBody)
+	assert.Nil(t, float64VarBody.ParseText(" 21.5 0.1\n"))
+	assert.Equal(t, Float64VarBody{Data: 21.5, Variance: 0.1}, float64VarBody)
+	assert.Equal(t, "21.5 0.1", float64VarBody.Text())
+
+	var boolBody BoolBody
+	assert.Nil(t, boolBody.ParseText("1\n"))
+	assert.Equal(t, BoolBody{Data: true}, boolBody)
+	assert.NotNil(t, boolBody.ParseText("yes"))
+
+	var int64Body Int64Body
+	assert.Nil(t, int64Body.ParseText("-42"))
+	assert.Equal(t, "-42", int64Body.Text())
+}
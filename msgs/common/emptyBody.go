@@ -3,3 +3,14 @@ package common
 // EmptyBody represents the body part of an empty message
 type EmptyBody struct {
 }
+
+// AppendProtobuf appends the protobuf encoded fields of the body to `b`. The empty body has no fields.
+func (body EmptyBody) AppendProtobuf(b []byte) []byte {
+	return b
+}
+
+// ParseProtobuf parses the protobuf encoded body from `b`
+func (body *EmptyBody) ParseProtobuf(b []byte) error {
+	_, err := ParseProtobufFields(b)
+	return err
+}
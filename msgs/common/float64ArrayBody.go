@@ -0,0 +1,59 @@
+package common
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Float64ArrayBody holds the body part of a message that contains a list of float64 values,
+// e.g. a block of samples or a feature vector
+type Float64ArrayBody struct {
+	Data []float64
+}
+
+// AppendProtobuf appends the protobuf encoded fields of the body to `b`
+func (body Float64ArrayBody) AppendProtobuf(b []byte) []byte {
+	b = AppendProtobufPackedFloat64s(b, 1, body.Data)
+	return b
+}
+
+// ParseProtobuf parses the protobuf encoded body from `b`
+func (body *Float64ArrayBody) ParseProtobuf(b []byte) error {
+	fields, err := ParseProtobufFields(b)
+	if err != nil {
+		return err
+	}
+
+	body.Data = []float64{}
+	for _, f := range fields {
+		switch f.Num {
+		case 1:
+			body.Data = append(body.Data, f.Float64s()...)
+		}
+	}
+	return nil
+}
+
+// Text returns with the body values in plain text format, separated by space
+func (body Float64ArrayBody) Text() string {
+	values := make([]string, len(body.Data))
+	for i, v := range body.Data {
+		values[i] = strconv.FormatFloat(v, 'g', -1, 64)
+	}
+	return strings.Join(values, " ")
+}
+
+// ParseText parses the body from the `text` in plain text format.
+// The text holds the values separated by white space.
+func (body *Float64ArrayBody) ParseText(text string) error {
+	values := strings.Fields(text)
+	data := make([]float64, len(values))
+	for i, v := range values {
+		var err error
+		if data[i], err = strconv.ParseFloat(v, 64); err != nil {
+			return err
+		}
+	}
+	body.Data = data
+	return nil
+}
@@ -1,6 +1,66 @@
 package common
 
+import (
+	"strconv"
+	"strings"
+)
+
 // Float64Body holds the body part of a plain float64 data message
 type Float64Body struct {
 	Data float64
 }
+
+// AppendProtobuf appends the protobuf encoded fields of the body to `b`
+func (body Float64Body) AppendProtobuf(b []byte) []byte {
+	b = AppendProtobufFloat64(b, 1, body.Data)
+	return b
+}
+
+// ParseProtobuf parses the protobuf encoded body from `b`
+func (body *Float64Body) ParseProtobuf(b []byte) error {
+	fields, err := ParseProtobufFields(b)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range fields {
+		switch f.Num {
+		case 1:
+			body.Data = f.Float64()
+		}
+	}
+	return nil
+}
+
+// CSVColumns returns with the names of the CSV columns of the body values
+func (body Float64Body) CSVColumns() []string {
+	return []string{"data"}
+}
+
+// CSVValues returns with the body values in text format
+func (body Float64Body) CSVValues() []string {
+	return []string{strconv.FormatFloat(body.Data, 'g', -1, 64)}
+}
+
+// ParseCSVValues parses the body from the `values` in text format
+func (body *Float64Body) ParseCSVValues(values []string) error {
+	if err := checkCSVValues(values, body.CSVColumns()); err != nil {
+		return err
+	}
+
+	var err error
+	if body.Data, err = strconv.ParseFloat(strings.TrimSpace(values[0]), 64); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Text returns with the body value in plain text format
+func (body Float64Body) Text() string {
+	return body.CSVValues()[0]
+}
+
+// ParseText parses the body from the `text` in plain text format
+func (body *Float64Body) ParseText(text string) error {
+	return body.ParseCSVValues([]string{text})
+}
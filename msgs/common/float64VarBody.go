@@ -1,7 +1,78 @@
 package common
 
+import (
+	"strconv"
+	"strings"
+)
+
 // Float64VarBody holds the body part of a message that contains a float64 `Data` value and a float64 `Variance` value
 type Float64VarBody struct {
 	Data     float64
 	Variance float64
 }
+
+// AppendProtobuf appends the protobuf encoded fields of the body to `b`
+func (body Float64VarBody) AppendProtobuf(b []byte) []byte {
+	b = AppendProtobufFloat64(b, 1, body.Data)
+	b = AppendProtobufFloat64(b, 2, body.Variance)
+	return b
+}
+
+// ParseProtobuf parses the protobuf encoded body from `b`
+func (body *Float64VarBody) ParseProtobuf(b []byte) error {
+	fields, err := ParseProtobufFields(b)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range fields {
+		switch f.Num {
+		case 1:
+			body.Data = f.Float64()
+		case 2:
+			body.Variance = f.Float64()
+		}
+	}
+	return nil
+}
+
+// CSVColumns returns with the names of the CSV columns of the body values
+func (body Float64VarBody) CSVColumns() []string {
+	return []string{"data", "variance"}
+}
+
+// CSVValues returns with the body values in text format
+func (body Float64VarBody) CSVValues() []string {
+	return []string{strconv.FormatFloat(body.Data, 'g', -1, 64), strconv.FormatFloat(body.Variance, 'g', -1, 64)}
+}
+
+// ParseCSVValues parses the body from the `values` in text format
+func (body *Float64VarBody) ParseCSVValues(values []string) error {
+	if err := checkCSVValues(values, body.CSVColumns()); err != nil {
+		return err
+	}
+
+	var err error
+	if body.Data, err = strconv.ParseFloat(strings.TrimSpace(values[0]), 64); err != nil {
+		return err
+	}
+	if body.Variance, err = strconv.ParseFloat(strings.TrimSpace(values[1]), 64); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Text returns with the body values in plain text format, separated by space
+func (body Float64VarBody) Text() string {
+	return strings.Join(body.CSVValues(), " ")
+}
+
+// ParseText parses the body from the `text` in plain text format.
+// The text holds the data, optionally followed by the variance, separated by white space.
+func (body *Float64VarBody) ParseText(text string) error {
+	values := strings.Fields(text)
+	if len(values) == 1 {
+		values = append(values, "0")
+	}
+	return body.ParseCSVValues(values)
+}
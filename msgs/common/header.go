@@ -21,9 +21,13 @@ const (
 )
 
 // Header is the generic message header structure
+// In XML representation the `TimePrecision` and the `Version` are attributes of the `Header` element.
 type Header struct {
-	TimePrecision TimePrecision
+	TimePrecision TimePrecision `xml:",attr"`
 	Timestamp     int64
+	// Version is the version of the message-type the message was made by.
+	// The zero value is the initial version, that is omitted from the encoded messages.
+	Version int `json:",omitempty" yaml:",omitempty" xml:",attr,omitempty" msgpack:",omitempty" cbor:",omitempty"`
 }
 
 // NewHeader creates a new generic message header with the timestamp of the current time in "ns" precision
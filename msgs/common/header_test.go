@@ -1,6 +1,7 @@
 package common
 
 import (
+	"encoding/xml"
 	"github.com/stretchr/testify/assert"
 	"testing"
 	"time"
@@ -21,10 +22,21 @@ func TestNewHeaderAt(t *testing.T) {
 
 	for _, c := range cases {
 		h := NewHeaderAt(c.Time, c.Precision)
-		assert.Equal(t, h, Header{c.Precision, c.Time})
+		assert.Equal(t, h, Header{TimePrecision: c.Precision, Timestamp: c.Time})
 	}
 }
 
+func TestHeaderXML(t *testing.T) {
+	h := NewHeaderAt(1608732048980, "ms")
+	xmlBytes, err := xml.Marshal(h)
+	assert.Nil(t, err)
+	assert.Equal(t, `<Header TimePrecision="ms"><Timestamp>1608732048980</Timestamp></Header>`, string(xmlBytes))
+
+	var decoded Header
+	assert.Nil(t, xml.Unmarshal(xmlBytes, &decoded))
+	assert.Equal(t, h, decoded)
+}
+
 func TestNewHeader(t *testing.T) {
 	nowNs := time.Now().UnixNano()
 	h := NewHeader()
@@ -0,0 +1,60 @@
+package common
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Int64ArrayBody holds the body part of a message that contains a list of int64 values
+type Int64ArrayBody struct {
+	Data []int64
+}
+
+// AppendProtobuf appends the protobuf encoded fields of the body to `b`
+func (body Int64ArrayBody) AppendProtobuf(b []byte) []byte {
+	b = AppendProtobufPackedInt64s(b, 1, body.Data)
+	return b
+}
+
+// ParseProtobuf parses the protobuf encoded body from `b`
+func (body *Int64ArrayBody) ParseProtobuf(b []byte) error {
+	fields, err := ParseProtobufFields(b)
+	if err != nil {
+		return err
+	}
+
+	body.Data = []int64{}
+	for _, f := range fields {
+		switch f.Num {
+		case 1:
+			for _, v := range f.Varints() {
+				body.Data = append(body.Data, int64(v))
+			}
+		}
+	}
+	return nil
+}
+
+// Text returns with the body values in plain text format, separated by space
+func (body Int64ArrayBody) Text() string {
+	values := make([]string, len(body.Data))
+	for i, v := range body.Data {
+		values[i] = strconv.FormatInt(v, 10)
+	}
+	return strings.Join(values, " ")
+}
+
+// ParseText parses the body from the `text` in plain text format.
+// The text holds the values separated by white space.
+func (body *Int64ArrayBody) ParseText(text string) error {
+	values := strings.Fields(text)
+	data := make([]int64, len(values))
+	for i, v := range values {
+		var err error
+		if data[i], err = strconv.ParseInt(v, 10, 64); err != nil {
+			return err
+		}
+	}
+	body.Data = data
+	return nil
+}
@@ -1,6 +1,66 @@
 package common
 
+import (
+	"strconv"
+	"strings"
+)
+
 // Int64Body holds the body part of a plain int64 data message
 type Int64Body struct {
 	Data int64
 }
+
+// AppendProtobuf appends the protobuf encoded fields of the body to `b`
+func (body Int64Body) AppendProtobuf(b []byte) []byte {
+	b = AppendProtobufInt64(b, 1, body.Data)
+	return b
+}
+
+// ParseProtobuf parses the protobuf encoded body from `b`
+func (body *Int64Body) ParseProtobuf(b []byte) error {
+	fields, err := ParseProtobufFields(b)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range fields {
+		switch f.Num {
+		case 1:
+			body.Data = f.Int64()
+		}
+	}
+	return nil
+}
+
+// CSVColumns returns with the names of the CSV columns of the body values
+func (body Int64Body) CSVColumns() []string {
+	return []string{"data"}
+}
+
+// CSVValues returns with the body values in text format
+func (body Int64Body) CSVValues() []string {
+	return []string{strconv.FormatInt(body.Data, 10)}
+}
+
+// ParseCSVValues parses the body from the `values` in text format
+func (body *Int64Body) ParseCSVValues(values []string) error {
+	if err := checkCSVValues(values, body.CSVColumns()); err != nil {
+		return err
+	}
+
+	var err error
+	if body.Data, err = strconv.ParseInt(strings.TrimSpace(values[0]), 10, 64); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Text returns with the body value in plain text format
+func (body Int64Body) Text() string {
+	return body.CSVValues()[0]
+}
+
+// ParseText parses the body from the `text` in plain text format
+func (body *Int64Body) ParseText(text string) error {
+	return body.ParseCSVValues([]string{text})
+}
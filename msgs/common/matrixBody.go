@@ -0,0 +1,91 @@
+package common
+
+import (
+	"fmt"
+)
+
+// MatrixBody holds the body part of a message that contains a matrix of float64 values.
+// The values are stored in row-major order, so the element of the `r` row and `c` column is `Data[r*Cols+c]`.
+type MatrixBody struct {
+	Rows int
+	Cols int
+	Data []float64
+}
+
+// NewMatrixBody returns with a `rows` x `cols` sized matrix body filled with zeros
+func NewMatrixBody(rows, cols int) MatrixBody {
+	return MatrixBody{Rows: rows, Cols: cols, Data: make([]float64, rows*cols)}
+}
+
+// Shape returns with the number of rows and columns of the matrix
+func (body MatrixBody) Shape() (int, int) {
+	return body.Rows, body.Cols
+}
+
+// At returns with the element of the `r` row and `c` column. It panics if the indices are out of range.
+func (body MatrixBody) At(r, c int) float64 {
+	return body.Data[body.index(r, c)]
+}
+
+// Set sets the element of the `r` row and `c` column to `v`. It panics if the indices are out of range.
+func (body *MatrixBody) Set(r, c int, v float64) {
+	body.Data[body.index(r, c)] = v
+}
+
+// Row returns with a copy of the `r` row of the matrix. It panics if the index is out of range.
+func (body MatrixBody) Row(r int) []float64 {
+	if r < 0 || r >= body.Rows {
+		panic(fmt.Errorf("matrix row index %d is out of the [%d, %d] shape", r, body.Rows, body.Cols))
+	}
+	row := make([]float64, body.Cols)
+	copy(row, body.Data[r*body.Cols:(r+1)*body.Cols])
+	return row
+}
+
+// index returns with the position of the element of the `r` row and `c` column in `Data`
+func (body MatrixBody) index(r, c int) int {
+	if r < 0 || r >= body.Rows || c < 0 || c >= body.Cols {
+		panic(fmt.Errorf("matrix index [%d, %d] is out of the [%d, %d] shape", r, c, body.Rows, body.Cols))
+	}
+	return r*body.Cols + c
+}
+
+// Validate returns with error if the shape is negative or does not match to the number of values
+func (body MatrixBody) Validate() error {
+	if body.Rows < 0 || body.Cols < 0 {
+		return fmt.Errorf("invalid matrix shape [%d, %d]", body.Rows, body.Cols)
+	}
+	if len(body.Data) != body.Rows*body.Cols {
+		return fmt.Errorf("the [%d, %d] shaped matrix has %d values instead of %d", body.Rows, body.Cols, len(body.Data), body.Rows*body.Cols)
+	}
+	return nil
+}
+
+// AppendProtobuf appends the protobuf encoded fields of the body to `b`
+func (body MatrixBody) AppendProtobuf(b []byte) []byte {
+	b = AppendProtobufInt64(b, 1, int64(body.Rows))
+	b = AppendProtobufInt64(b, 2, int64(body.Cols))
+	b = AppendProtobufPackedFloat64s(b, 3, body.Data)
+	return b
+}
+
+// ParseProtobuf parses the protobuf encoded body from `b`
+func (body *MatrixBody) ParseProtobuf(b []byte) error {
+	fields, err := ParseProtobufFields(b)
+	if err != nil {
+		return err
+	}
+
+	body.Data = []float64{}
+	for _, f := range fields {
+		switch f.Num {
+		case 1:
+			body.Rows = int(f.Int64())
+		case 2:
+			body.Cols = int(f.Int64())
+		case 3:
+			body.Data = append(body.Data, f.Float64s()...)
+		}
+	}
+	return nil
+}
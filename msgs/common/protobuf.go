@@ -0,0 +1,307 @@
+package common
+
+import (
+	"google.golang.org/protobuf/encoding/protowire"
+	"math"
+)
+
+// The field numbers of the messages made of a header and a body, according to the `.proto` definitions
+const (
+	headerFieldNum protowire.Number = 1
+	bodyFieldNum   protowire.Number = 2
+)
+
+// ProtobufMarshaler is implemented by the parts of the messages that can be encoded into protobuf format
+type ProtobufMarshaler interface {
+	// AppendProtobuf appends the fields of the protobuf encoded content to `b`
+	AppendProtobuf(b []byte) []byte
+}
+
+// ProtobufUnmarshaler is implemented by the parts of the messages that can be decoded from protobuf format
+type ProtobufUnmarshaler interface {
+	// ParseProtobuf parses the protobuf encoded content from `b`
+	ParseProtobuf(b []byte) error
+}
+
+// MarshalProtobufMessage returns with the protobuf encoded message made of the `header` and the `body`
+func MarshalProtobufMessage(header Header, body ProtobufMarshaler) []byte {
+	var b []byte
+	b = AppendProtobufMessage(b, headerFieldNum, header)
+	b = AppendProtobufMessage(b, bodyFieldNum, body)
+	return b
+}
+
+// UnmarshalProtobufMessage parses the protobuf encoded message from `b` into the `header` and the `body`
+func UnmarshalProtobufMessage(b []byte, header *Header, body ProtobufUnmarshaler) error {
+	fields, err := ParseProtobufFields(b)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range fields {
+		switch f.Num {
+		case headerFieldNum:
+			err = header.ParseProtobuf(f.Bytes)
+		case bodyFieldNum:
+			err = body.ParseProtobuf(f.Bytes)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AppendProtobuf appends the protobuf encoded fields of the header to `b`
+func (h Header) AppendProtobuf(b []byte) []byte {
+	b = AppendProtobufString(b, 1, string(h.TimePrecision))
+	b = AppendProtobufInt64(b, 2, h.Timestamp)
+	b = AppendProtobufInt64(b, 3, int64(h.Version))
+	return b
+}
+
+// ParseProtobuf parses the protobuf encoded header from `b`
+func (h *Header) ParseProtobuf(b []byte) error {
+	fields, err := ParseProtobufFields(b)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range fields {
+		switch f.Num {
+		case 1:
+			h.TimePrecision = TimePrecision(f.String())
+		case 2:
+			h.Timestamp = f.Int64()
+		case 3:
+			h.Version = int(f.Int64())
+		}
+	}
+	return nil
+}
+
+// ProtobufField holds one field of a protobuf encoded message
+type ProtobufField struct {
+	// Num is the field number
+	Num protowire.Number
+
+	// Type is the wire type of the field
+	Type protowire.Type
+
+	// Value holds the value of the varint and fixed size fields
+	Value uint64
+
+	// Bytes holds the content of the length-delimited fields
+	Bytes []byte
+}
+
+// ParseProtobufFields splits the protobuf encoded `b` message into its fields.
+// The repeated fields appear as many times as they occur in the message.
+func ParseProtobufFields(b []byte) ([]ProtobufField, error) {
+	fields := []ProtobufField{}
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		b = b[n:]
+
+		f := ProtobufField{Num: num, Type: typ}
+		switch typ {
+		case protowire.VarintType:
+			f.Value, n = protowire.ConsumeVarint(b)
+		case protowire.Fixed32Type:
+			var v uint32
+			v, n = protowire.ConsumeFixed32(b)
+			f.Value = uint64(v)
+		case protowire.Fixed64Type:
+			f.Value, n = protowire.ConsumeFixed64(b)
+		case protowire.BytesType:
+			f.Bytes, n = protowire.ConsumeBytes(b)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		b = b[n:]
+		fields = append(fields, f)
+	}
+	return fields, nil
+}
+
+// The getters below return with the zero value if the field has a mismatching wire type.
+
+// Int64 returns with the value of an `int64` field
+func (f ProtobufField) Int64() int64 {
+	if f.Type != protowire.VarintType {
+		return 0
+	}
+	return int64(f.Value)
+}
+
+// Bool returns with the value of a `bool` field
+func (f ProtobufField) Bool() bool {
+	if f.Type != protowire.VarintType {
+		return false
+	}
+	return protowire.DecodeBool(f.Value)
+}
+
+// Float64 returns with the value of a `double` field
+func (f ProtobufField) Float64() float64 {
+	if f.Type != protowire.Fixed64Type {
+		return 0
+	}
+	return math.Float64frombits(f.Value)
+}
+
+// String returns with the value of a `string` field
+func (f ProtobufField) String() string {
+	return string(f.Bytes)
+}
+
+// AppendProtobufInt64 appends an `int64` field to `b`. The zero value is omitted.
+func AppendProtobufInt64(b []byte, num protowire.Number, v int64) []byte {
+	if v == 0 {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.VarintType)
+	return protowire.AppendVarint(b, uint64(v))
+}
+
+// AppendProtobufBool appends a `bool` field to `b`. The zero value is omitted.
+func AppendProtobufBool(b []byte, num protowire.Number, v bool) []byte {
+	if !v {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.VarintType)
+	return protowire.AppendVarint(b, protowire.EncodeBool(v))
+}
+
+// AppendProtobufFloat64 appends a `double` field to `b`. The zero value is omitted.
+func AppendProtobufFloat64(b []byte, num protowire.Number, v float64) []byte {
+	if v == 0 && !math.Signbit(v) {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.Fixed64Type)
+	return protowire.AppendFixed64(b, math.Float64bits(v))
+}
+
+// AppendProtobufString appends a `string` field to `b`. The zero value is omitted.
+func AppendProtobufString(b []byte, num protowire.Number, v string) []byte {
+	if v == "" {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendString(b, v)
+}
+
+// AppendProtobufBytes appends a `bytes` field to `b`. The zero value is omitted.
+func AppendProtobufBytes(b []byte, num protowire.Number, v []byte) []byte {
+	if len(v) == 0 {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, v)
+}
+
+// AppendProtobufMessage appends an embedded message field to `b`.
+// The field is appended even if the message is empty, so it can be used for repeated fields too.
+func AppendProtobufMessage(b []byte, num protowire.Number, m ProtobufMarshaler) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, m.AppendProtobuf(nil))
+}
+
+// Float64s returns with the values of a repeated `double` field, either packed or not
+func (f ProtobufField) Float64s() []float64 {
+	switch f.Type {
+	case protowire.Fixed64Type:
+		return []float64{math.Float64frombits(f.Value)}
+	case protowire.BytesType:
+		values := make([]float64, 0, len(f.Bytes)/8)
+		for b := f.Bytes; len(b) > 0; {
+			v, n := protowire.ConsumeFixed64(b)
+			if n < 0 {
+				return values
+			}
+			values = append(values, math.Float64frombits(v))
+			b = b[n:]
+		}
+		return values
+	}
+	return nil
+}
+
+// Varints returns with the raw values of a repeated varint field, either packed or not
+func (f ProtobufField) Varints() []uint64 {
+	switch f.Type {
+	case protowire.VarintType:
+		return []uint64{f.Value}
+	case protowire.BytesType:
+		values := []uint64{}
+		for b := f.Bytes; len(b) > 0; {
+			v, n := protowire.ConsumeVarint(b)
+			if n < 0 {
+				return values
+			}
+			values = append(values, v)
+			b = b[n:]
+		}
+		return values
+	}
+	return nil
+}
+
+// AppendProtobufPackedFloat64s appends a packed repeated `double` field to `b`. The empty list is omitted.
+func AppendProtobufPackedFloat64s(b []byte, num protowire.Number, v []float64) []byte {
+	if len(v) == 0 {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	b = protowire.AppendVarint(b, uint64(8*len(v)))
+	for _, x := range v {
+		b = protowire.AppendFixed64(b, math.Float64bits(x))
+	}
+	return b
+}
+
+// AppendProtobufPackedInt64s appends a packed repeated `int64` field to `b`. The empty list is omitted.
+func AppendProtobufPackedInt64s(b []byte, num protowire.Number, v []int64) []byte {
+	if len(v) == 0 {
+		return b
+	}
+	size := 0
+	for _, x := range v {
+		size += protowire.SizeVarint(uint64(x))
+	}
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	b = protowire.AppendVarint(b, uint64(size))
+	for _, x := range v {
+		b = protowire.AppendVarint(b, uint64(x))
+	}
+	return b
+}
+
+// AppendProtobufPackedBools appends a packed repeated `bool` field to `b`. The empty list is omitted.
+func AppendProtobufPackedBools(b []byte, num protowire.Number, v []bool) []byte {
+	if len(v) == 0 {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	b = protowire.AppendVarint(b, uint64(len(v)))
+	for _, x := range v {
+		b = protowire.AppendVarint(b, protowire.EncodeBool(x))
+	}
+	return b
+}
+
+// AppendProtobufStrings appends a repeated `string` field to `b`.
+// Every item is appended, including the empty strings, to keep the positions of the items.
+func AppendProtobufStrings(b []byte, num protowire.Number, v []string) []byte {
+	for _, x := range v {
+		b = protowire.AppendTag(b, num, protowire.BytesType)
+		b = protowire.AppendString(b, x)
+	}
+	return b
+}
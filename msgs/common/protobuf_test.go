@@ -0,0 +1,70 @@
+package common
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// protobufFixture is the protobuf encoded `Header{"ns", 1608732048980057025}` and `Float64VarBody{42, 0.5}` message,
+// according to the definitions of `common.proto`.
+var protobufFixture = []byte{
+	0x0a, 0x0e, 0x0a, 0x02, 0x6e, 0x73, 0x10, 0xc1, 0xb7, 0xf8, 0xf1, 0xe6, 0xa8, 0xd7, 0xa9, 0x16,
+	0x12, 0x12, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x45, 0x40, 0x11, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xe0, 0x3f,
+}
+
+func TestMarshalProtobufMessage(t *testing.T) {
+	header := NewHeaderAt(1608732048980057025, Nanoseconds)
+	body := Float64VarBody{Data: 42, Variance: 0.5}
+	assert.Equal(t, protobufFixture, MarshalProtobufMessage(header, body))
+}
+
+func TestUnmarshalProtobufMessage(t *testing.T) {
+	var header Header
+	var body Float64VarBody
+	assert.Nil(t, UnmarshalProtobufMessage(protobufFixture, &header, &body))
+	assert.Equal(t, NewHeaderAt(1608732048980057025, Nanoseconds), header)
+	assert.Equal(t, Float64VarBody{Data: 42, Variance: 0.5}, body)
+
+	// Unknown fields are skipped, and the fields of mismatching wire type are read as zero values
+	var stringBody StringBody
+	assert.Nil(t, UnmarshalProtobufMessage(protobufFixture, &header, &stringBody))
+	assert.Equal(t, StringBody{}, stringBody)
+
+	// Truncated message
+	assert.NotNil(t, UnmarshalProtobufMessage(protobufFixture[:20], &header, &body))
+}
+
+func TestArrayBodiesProtobuf(t *testing.T) {
+	// The repeated scalar fields are encoded in packed format
+	float64s := Float64ArrayBody{Data: []float64{1, -2.5}}
+	assert.Equal(t, []byte{
+		0x0a, 0x10, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04, 0xc0,
+	}, float64s.AppendProtobuf(nil))
+	int64s := Int64ArrayBody{Data: []int64{1, 300, -1}}
+	assert.Equal(t, []byte{
+		0x0a, 0x0d, 0x01, 0xac, 0x02, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01,
+	}, int64s.AppendProtobuf(nil))
+	bools := BoolArrayBody{Data: []bool{true, false, true}}
+	assert.Equal(t, []byte{0x0a, 0x03, 0x01, 0x00, 0x01}, bools.AppendProtobuf(nil))
+	strs := StringArrayBody{Data: []string{"a", ""}}
+	assert.Equal(t, []byte{0x0a, 0x01, 0x61, 0x0a, 0x00}, strs.AppendProtobuf(nil))
+
+	var float64sDecoded Float64ArrayBody
+	assert.Nil(t, float64sDecoded.ParseProtobuf(float64s.AppendProtobuf(nil)))
+	assert.Equal(t, float64s, float64sDecoded)
+	var int64sDecoded Int64ArrayBody
+	assert.Nil(t, int64sDecoded.ParseProtobuf(int64s.AppendProtobuf(nil)))
+	assert.Equal(t, int64s, int64sDecoded)
+	var boolsDecoded BoolArrayBody
+	assert.Nil(t, boolsDecoded.ParseProtobuf(bools.AppendProtobuf(nil)))
+	assert.Equal(t, bools, boolsDecoded)
+	var strsDecoded StringArrayBody
+	assert.Nil(t, strsDecoded.ParseProtobuf(strs.AppendProtobuf(nil)))
+	assert.Equal(t, strs, strsDecoded)
+
+	// The non-packed encoding of the repeated scalar fields is accepted too
+	assert.Nil(t, int64sDecoded.ParseProtobuf([]byte{0x08, 0x01, 0x08, 0xac, 0x02}))
+	assert.Equal(t, []int64{1, 300}, int64sDecoded.Data)
+	assert.Nil(t, float64sDecoded.ParseProtobuf([]byte{0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f}))
+	assert.Equal(t, []float64{1}, float64sDecoded.Data)
+}
@@ -0,0 +1,29 @@
+package common
+
+// StringArrayBody holds the body part of a message that contains a list of string values
+type StringArrayBody struct {
+	Data []string
+}
+
+// AppendProtobuf appends the protobuf encoded fields of the body to `b`
+func (body StringArrayBody) AppendProtobuf(b []byte) []byte {
+	b = AppendProtobufStrings(b, 1, body.Data)
+	return b
+}
+
+// ParseProtobuf parses the protobuf encoded body from `b`
+func (body *StringArrayBody) ParseProtobuf(b []byte) error {
+	fields, err := ParseProtobufFields(b)
+	if err != nil {
+		return err
+	}
+
+	body.Data = []string{}
+	for _, f := range fields {
+		switch f.Num {
+		case 1:
+			body.Data = append(body.Data, f.String())
+		}
+	}
+	return nil
+}
@@ -4,3 +4,56 @@ package common
 type StringBody struct {
 	Data string
 }
+
+// AppendProtobuf appends the protobuf encoded fields of the body to `b`
+func (body StringBody) AppendProtobuf(b []byte) []byte {
+	b = AppendProtobufString(b, 1, body.Data)
+	return b
+}
+
+// ParseProtobuf parses the protobuf encoded body from `b`
+func (body *StringBody) ParseProtobuf(b []byte) error {
+	fields, err := ParseProtobufFields(b)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range fields {
+		switch f.Num {
+		case 1:
+			body.Data = f.String()
+		}
+	}
+	return nil
+}
+
+// CSVColumns returns with the names of the CSV columns of the body values
+func (body StringBody) CSVColumns() []string {
+	return []string{"data"}
+}
+
+// CSVValues returns with the body values in text format
+func (body StringBody) CSVValues() []string {
+	return []string{body.Data}
+}
+
+// ParseCSVValues parses the body from the `values` in text format
+func (body *StringBody) ParseCSVValues(values []string) error {
+	if err := checkCSVValues(values, body.CSVColumns()); err != nil {
+		return err
+	}
+
+	body.Data = values[0]
+	return nil
+}
+
+// Text returns with the body value in plain text format
+func (body StringBody) Text() string {
+	return body.Data
+}
+
+// ParseText parses the body from the `text` in plain text format
+func (body *StringBody) ParseText(text string) error {
+	body.Data = text
+	return nil
+}
@@ -0,0 +1,372 @@
+package geometry
+
+import (
+	"github.com/tombenke/axon-go-common/msgs/common"
+	"github.com/tombenke/axon-go-common/msgs/ros1"
+)
+
+// PointBody holds the body part of the `geometry/Point` message, that is a point with the frame it is given in,
+// like the `geometry_msgs/PointStamped` ROS message without its header
+type PointBody struct {
+	FrameID string     `json:"frame_id" yaml:"frame_id"`
+	Point   PointValue `json:"point" yaml:"point"`
+}
+
+// AppendProtobuf appends the protobuf encoded fields of the body to `b`
+func (body PointBody) AppendProtobuf(b []byte) []byte {
+	b = common.AppendProtobufString(b, 1, body.FrameID)
+	b = common.AppendProtobufMessage(b, 2, body.Point)
+	return b
+}
+
+// ParseProtobuf parses the protobuf encoded body from `b`
+func (body *PointBody) ParseProtobuf(b []byte) error {
+	fields, err := common.ParseProtobufFields(b)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range fields {
+		switch f.Num {
+		case 1:
+			body.FrameID = f.String()
+		case 2:
+			err = body.Point.ParseProtobuf(f.Bytes)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalROS1 writes the ROS1 serialization of the fields that follow the header of the stamped ROS message
+func (body PointBody) MarshalROS1(e *ros1.Encoder) {
+	body.Point.MarshalROS1(e)
+}
+
+// UnmarshalROS1 reads the ROS1 serialization of the fields that follow the header of the stamped ROS message
+func (body *PointBody) UnmarshalROS1(d *ros1.Decoder) {
+	body.Point.UnmarshalROS1(d)
+}
+
+// Vector3Body holds the body part of the `geometry/Vector3` message, that is a vector with the frame it is given in,
+// like the `geometry_msgs/Vector3Stamped` ROS message without its header
+type Vector3Body struct {
+	FrameID string       `json:"frame_id" yaml:"frame_id"`
+	Vector  Vector3Value `json:"vector" yaml:"vector"`
+}
+
+// AppendProtobuf appends the protobuf encoded fields of the body to `b`
+func (body Vector3Body) AppendProtobuf(b []byte) []byte {
+	b = common.AppendProtobufString(b, 1, body.FrameID)
+	b = common.AppendProtobufMessage(b, 2, body.Vector)
+	return b
+}
+
+// ParseProtobuf parses the protobuf encoded body from `b`
+func (body *Vector3Body) ParseProtobuf(b []byte) error {
+	fields, err := common.ParseProtobufFields(b)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range fields {
+		switch f.Num {
+		case 1:
+			body.FrameID = f.String()
+		case 2:
+			err = body.Vector.ParseProtobuf(f.Bytes)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalROS1 writes the ROS1 serialization of the fields that follow the header of the stamped ROS message
+func (body Vector3Body) MarshalROS1(e *ros1.Encoder) {
+	body.Vector.MarshalROS1(e)
+}
+
+// UnmarshalROS1 reads the ROS1 serialization of the fields that follow the header of the stamped ROS message
+func (body *Vector3Body) UnmarshalROS1(d *ros1.Decoder) {
+	body.Vector.UnmarshalROS1(d)
+}
+
+// QuaternionBody holds the body part of the `geometry/Quaternion` message, that is an orientation with the frame it is given in,
+// like the `geometry_msgs/QuaternionStamped` ROS message without its header
+type QuaternionBody struct {
+	FrameID    string          `json:"frame_id" yaml:"frame_id"`
+	Quaternion QuaternionValue `json:"quaternion" yaml:"quaternion"`
+}
+
+// AppendProtobuf appends the protobuf encoded fields of the body to `b`
+func (body QuaternionBody) AppendProtobuf(b []byte) []byte {
+	b = common.AppendProtobufString(b, 1, body.FrameID)
+	b = common.AppendProtobufMessage(b, 2, body.Quaternion)
+	return b
+}
+
+// ParseProtobuf parses the protobuf encoded body from `b`
+func (body *QuaternionBody) ParseProtobuf(b []byte) error {
+	fields, err := common.ParseProtobufFields(b)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range fields {
+		switch f.Num {
+		case 1:
+			body.FrameID = f.String()
+		case 2:
+			err = body.Quaternion.ParseProtobuf(f.Bytes)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalROS1 writes the ROS1 serialization of the fields that follow the header of the stamped ROS message
+func (body QuaternionBody) MarshalROS1(e *ros1.Encoder) {
+	body.Quaternion.MarshalROS1(e)
+}
+
+// UnmarshalROS1 reads the ROS1 serialization of the fields that follow the header of the stamped ROS message
+func (body *QuaternionBody) UnmarshalROS1(d *ros1.Decoder) {
+	body.Quaternion.UnmarshalROS1(d)
+}
+
+// PoseBody holds the body part of the `geometry/Pose` message, that is a pose with the frame it is given in,
+// like the `geometry_msgs/PoseStamped` ROS message without its header
+type PoseBody struct {
+	FrameID string    `json:"frame_id" yaml:"frame_id"`
+	Pose    PoseValue `json:"pose" yaml:"pose"`
+}
+
+// AppendProtobuf appends the protobuf encoded fields of the body to `b`
+func (body PoseBody) AppendProtobuf(b []byte) []byte {
+	b = common.AppendProtobufString(b, 1, body.FrameID)
+	b = common.AppendProtobufMessage(b, 2, body.Pose)
+	return b
+}
+
+// ParseProtobuf parses the protobuf encoded body from `b`
+func (body *PoseBody) ParseProtobuf(b []byte) error {
+	fields, err := common.ParseProtobufFields(b)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range fields {
+		switch f.Num {
+		case 1:
+			body.FrameID = f.String()
+		case 2:
+			err = body.Pose.ParseProtobuf(f.Bytes)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalROS1 writes the ROS1 serialization of the fields that follow the header of the stamped ROS message
+func (body PoseBody) MarshalROS1(e *ros1.Encoder) {
+	body.Pose.MarshalROS1(e)
+}
+
+// UnmarshalROS1 reads the ROS1 serialization of the fields that follow the header of the stamped ROS message
+func (body *PoseBody) UnmarshalROS1(d *ros1.Decoder) {
+	body.Pose.UnmarshalROS1(d)
+}
+
+// TwistBody holds the body part of the `geometry/Twist` message, that is a velocity with the frame it is given in,
+// like the `geometry_msgs/TwistStamped` ROS message without its header
+type TwistBody struct {
+	FrameID string     `json:"frame_id" yaml:"frame_id"`
+	Twist   TwistValue `json:"twist" yaml:"twist"`
+}
+
+// AppendProtobuf appends the protobuf encoded fields of the body to `b`
+func (body TwistBody) AppendProtobuf(b []byte) []byte {
+	b = common.AppendProtobufString(b, 1, body.FrameID)
+	b = common.AppendProtobufMessage(b, 2, body.Twist)
+	return b
+}
+
+// ParseProtobuf parses the protobuf encoded body from `b`
+func (body *TwistBody) ParseProtobuf(b []byte) error {
+	fields, err := common.ParseProtobufFields(b)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range fields {
+		switch f.Num {
+		case 1:
+			body.FrameID = f.String()
+		case 2:
+			err = body.Twist.ParseProtobuf(f.Bytes)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalROS1 writes the ROS1 serialization of the fields that follow the header of the stamped ROS message
+func (body TwistBody) MarshalROS1(e *ros1.Encoder) {
+	body.Twist.MarshalROS1(e)
+}
+
+// UnmarshalROS1 reads the ROS1 serialization of the fields that follow the header of the stamped ROS message
+func (body *TwistBody) UnmarshalROS1(d *ros1.Decoder) {
+	body.Twist.UnmarshalROS1(d)
+}
+
+// TransformBody holds the body part of the `geometry/Transform` message, that is the transform from the `FrameID` coordinate frame to the `ChildFrameID` frame,
+// like the `geometry_msgs/TransformStamped` ROS message without its header
+type TransformBody struct {
+	FrameID      string         `json:"frame_id" yaml:"frame_id"`
+	ChildFrameID string         `json:"child_frame_id" yaml:"child_frame_id"`
+	Transform    TransformValue `json:"transform" yaml:"transform"`
+}
+
+// AppendProtobuf appends the protobuf encoded fields of the body to `b`
+func (body TransformBody) AppendProtobuf(b []byte) []byte {
+	b = common.AppendProtobufString(b, 1, body.FrameID)
+	b = common.AppendProtobufString(b, 2, body.ChildFrameID)
+	b = common.AppendProtobufMessage(b, 3, body.Transform)
+	return b
+}
+
+// ParseProtobuf parses the protobuf encoded body from `b`
+func (body *TransformBody) ParseProtobuf(b []byte) error {
+	fields, err := common.ParseProtobufFields(b)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range fields {
+		switch f.Num {
+		case 1:
+			body.FrameID = f.String()
+		case 2:
+			body.ChildFrameID = f.String()
+		case 3:
+			err = body.Transform.ParseProtobuf(f.Bytes)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalROS1 writes the ROS1 serialization of the fields that follow the header of the stamped ROS message
+func (body TransformBody) MarshalROS1(e *ros1.Encoder) {
+	e.String(body.ChildFrameID)
+	body.Transform.MarshalROS1(e)
+}
+
+// UnmarshalROS1 reads the ROS1 serialization of the fields that follow the header of the stamped ROS message
+func (body *TransformBody) UnmarshalROS1(d *ros1.Decoder) {
+	body.ChildFrameID = d.String()
+	body.Transform.UnmarshalROS1(d)
+}
+
+// PoseWithCovarianceBody holds the body part of the `geometry/PoseWithCovariance` message, that is an estimated pose with the frame it is given in,
+// like the `geometry_msgs/PoseWithCovarianceStamped` ROS message without its header
+type PoseWithCovarianceBody struct {
+	FrameID string                  `json:"frame_id" yaml:"frame_id"`
+	Pose    PoseWithCovarianceValue `json:"pose" yaml:"pose"`
+}
+
+// AppendProtobuf appends the protobuf encoded fields of the body to `b`
+func (body PoseWithCovarianceBody) AppendProtobuf(b []byte) []byte {
+	b = common.AppendProtobufString(b, 1, body.FrameID)
+	b = common.AppendProtobufMessage(b, 2, body.Pose)
+	return b
+}
+
+// ParseProtobuf parses the protobuf encoded body from `b`
+func (body *PoseWithCovarianceBody) ParseProtobuf(b []byte) error {
+	fields, err := common.ParseProtobufFields(b)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range fields {
+		switch f.Num {
+		case 1:
+			body.FrameID = f.String()
+		case 2:
+			err = body.Pose.ParseProtobuf(f.Bytes)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalROS1 writes the ROS1 serialization of the fields that follow the header of the stamped ROS message
+func (body PoseWithCovarianceBody) MarshalROS1(e *ros1.Encoder) {
+	body.Pose.MarshalROS1(e)
+}
+
+// UnmarshalROS1 reads the ROS1 serialization of the fields that follow the header of the stamped ROS message
+func (body *PoseWithCovarianceBody) UnmarshalROS1(d *ros1.Decoder) {
+	body.Pose.UnmarshalROS1(d)
+}
+
+// TwistWithCovarianceBody holds the body part of the `geometry/TwistWithCovariance` message, that is an estimated velocity with the frame it is given in,
+// like the `geometry_msgs/TwistWithCovarianceStamped` ROS message without its header
+type TwistWithCovarianceBody struct {
+	FrameID string                   `json:"frame_id" yaml:"frame_id"`
+	Twist   TwistWithCovarianceValue `json:"twist" yaml:"twist"`
+}
+
+// AppendProtobuf appends the protobuf encoded fields of the body to `b`
+func (body TwistWithCovarianceBody) AppendProtobuf(b []byte) []byte {
+	b = common.AppendProtobufString(b, 1, body.FrameID)
+	b = common.AppendProtobufMessage(b, 2, body.Twist)
+	return b
+}
+
+// ParseProtobuf parses the protobuf encoded body from `b`
+func (body *TwistWithCovarianceBody) ParseProtobuf(b []byte) error {
+	fields, err := common.ParseProtobufFields(b)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range fields {
+		switch f.Num {
+		case 1:
+			body.FrameID = f.String()
+		case 2:
+			err = body.Twist.ParseProtobuf(f.Bytes)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalROS1 writes the ROS1 serialization of the fields that follow the header of the stamped ROS message
+func (body TwistWithCovarianceBody) MarshalROS1(e *ros1.Encoder) {
+	body.Twist.MarshalROS1(e)
+}
+
+// UnmarshalROS1 reads the ROS1 serialization of the fields that follow the header of the stamped ROS message
+func (body *TwistWithCovarianceBody) UnmarshalROS1(d *ros1.Decoder) {
+	body.Twist.UnmarshalROS1(d)
+}
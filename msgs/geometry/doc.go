@@ -0,0 +1,24 @@
+/*
+Package geometry provides the spatial and kinematic message types for the robotics nodes.
+
+The message types follow the structure and field naming of the `geometry_msgs` package of ROS.
+The body of a message is the same as the stamped variant of the corresponding ROS message without its header,
+except the `frame_id` of the ROS header, that is held by the body:
+
+	geometry/Point               geometry_msgs/PointStamped
+	geometry/Vector3             geometry_msgs/Vector3Stamped
+	geometry/Quaternion          geometry_msgs/QuaternionStamped
+	geometry/Pose                geometry_msgs/PoseStamped
+	geometry/Twist               geometry_msgs/TwistStamped
+	geometry/Transform           geometry_msgs/TransformStamped
+	geometry/PoseWithCovariance  geometry_msgs/PoseWithCovarianceStamped
+	geometry/TwistWithCovariance geometry_msgs/TwistWithCovarianceStamped
+
+The JSON and YAML representations use the field names of ROS, e.g.:
+
+	{"Header": {...}, "Body": {"frame_id": "map", "pose": {"position": {"x": 1, "y": 2, "z": 0}, "orientation": {"x": 0, "y": 0, "z": 0, "w": 1}}}}
+
+The `application/x-ros1` representation is the ROS1 serialization of the stamped ROS message,
+so it can be exchanged with the ROS nodes as it is.
+*/
+package geometry
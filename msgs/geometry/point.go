@@ -0,0 +1,291 @@
+package geometry
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"github.com/fxamacker/cbor/v2"
+	"github.com/tombenke/axon-go-common/msgs"
+	"github.com/tombenke/axon-go-common/msgs/avro"
+	"github.com/tombenke/axon-go-common/msgs/common"
+	"github.com/tombenke/axon-go-common/msgs/ros1"
+	"github.com/vmihailenco/msgpack/v5"
+	"gopkg.in/yaml.v2"
+	"time"
+)
+
+const (
+	// PointTypeName is the printable name of the `Point` message-type
+	PointTypeName = "geometry/Point"
+	// PointROSTypeName is the name of the ROS message-type that the `Point` message-type corresponds to
+	PointROSTypeName = "geometry_msgs/PointStamped"
+)
+
+func init() {
+	msgs.RegisterMessageType(PointTypeName, []msgs.Representation{msgs.JSONRepresentation, msgs.YAMLRepresentation, msgs.GobRepresentation, msgs.ProtobufRepresentation, msgs.XMLRepresentation, msgs.AvroRepresentation, msgs.MsgpackRepresentation, msgs.CBORRepresentation, msgs.ROS1Representation}, func() msgs.Message {
+		return NewPointMessage(PointBody{})
+	})
+	msgs.SetMessageTypeDescription(PointTypeName, "Point with the frame it is given in")
+	msgs.SetMessageTypeROSType(PointTypeName, PointROSTypeName)
+}
+
+// Point represents the structure of the messages that hold a point in free space.
+type Point struct {
+	Header common.Header
+	Body   PointBody
+}
+
+// GetType returns with the printable name of the `Point` message-type
+func (msg *Point) GetType() string {
+	return PointTypeName
+}
+
+// Encode returns with the `Point` message content in a representation format selected by `representation`
+func (msg *Point) Encode(representation msgs.Representation) (results []byte) {
+	switch representation {
+	case msgs.JSONRepresentation:
+		var err error
+		results, err = json.Marshal(*msg)
+		if err != nil {
+			panic(err)
+		}
+	case msgs.YAMLRepresentation:
+		results = msg.YAML()
+	case msgs.GobRepresentation:
+		results = msg.EncodeGob()
+	case msgs.ProtobufRepresentation:
+		results = msg.Protobuf()
+	case msgs.XMLRepresentation:
+		results = msg.XML()
+	case msgs.AvroRepresentation:
+		results = msg.Avro()
+	case msgs.MsgpackRepresentation:
+		results = msg.Msgpack()
+	case msgs.CBORRepresentation:
+		results = msg.CBOR()
+	case msgs.ROS1Representation:
+		results = msg.ROS1()
+	default:
+		panic(fmt.Errorf("Encode error: unknown representational format '%s'", representation))
+	}
+	return results
+}
+
+// Decode parses the `content` using the selected `representation` format.
+// The messages of older versions are upgraded to the current version of the message-type, if the `representation` is upgradable.
+func (msg *Point) Decode(representation msgs.Representation, content []byte) error {
+	content, err := msgs.Upgrade(PointTypeName, representation, content)
+	if err != nil {
+		return err
+	}
+
+	switch representation {
+	case msgs.JSONRepresentation:
+		return json.Unmarshal(content, msg)
+	case msgs.YAMLRepresentation:
+		return msg.ParseYAML(content)
+	case msgs.GobRepresentation:
+		return msg.DecodeGob(content)
+	case msgs.ProtobufRepresentation:
+		return msg.ParseProtobuf(content)
+	case msgs.XMLRepresentation:
+		return msg.ParseXML(content)
+	case msgs.AvroRepresentation:
+		return msg.ParseAvro(content)
+	case msgs.MsgpackRepresentation:
+		return msg.ParseMsgpack(content)
+	case msgs.CBORRepresentation:
+		return msg.ParseCBOR(content)
+	case msgs.ROS1Representation:
+		return msg.ParseROS1(content)
+	default:
+		panic(fmt.Errorf("Decode error: unknown representational format '%s'", representation))
+	}
+}
+
+// JSON returns with the `Point` message content in JSON representation format
+func (msg *Point) JSON() []byte {
+	jsonBytes, err := json.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return jsonBytes
+}
+
+// String returns with the `Point` message content in JSON format string
+func (msg *Point) String() string {
+	jsonBytes, err := json.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return string(jsonBytes)
+}
+
+// ParseJSON parses the JSON representation of a `Point` messages from the `jsonBytes` argument.
+func (msg *Point) ParseJSON(jsonBytes []byte) error {
+	return json.Unmarshal(jsonBytes, msg)
+}
+
+// Msgpack returns with the `Point` message content in MessagePack representation format
+func (msg *Point) Msgpack() []byte {
+	msgpackBytes, err := msgpack.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return msgpackBytes
+}
+
+// ParseMsgpack parses the MessagePack representation of a `Point` messages from the `msgpackBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *Point) ParseMsgpack(msgpackBytes []byte) error {
+	var decoded Point
+	if err := msgpack.Unmarshal(msgpackBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// CBOR returns with the `Point` message content in CBOR representation format
+func (msg *Point) CBOR() []byte {
+	cborBytes, err := cbor.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return cborBytes
+}
+
+// ParseCBOR parses the CBOR representation of a `Point` messages from the `cborBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *Point) ParseCBOR(cborBytes []byte) error {
+	var decoded Point
+	if err := cbor.Unmarshal(cborBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// Avro returns with the `Point` message content in Avro representation format
+func (msg *Point) Avro() []byte {
+	avroBytes, err := avro.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return avroBytes
+}
+
+// ParseAvro parses the Avro representation of a `Point` messages from the `avroBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *Point) ParseAvro(avroBytes []byte) error {
+	var decoded Point
+	if err := avro.Unmarshal(avroBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// XML returns with the `Point` message content in XML representation format
+func (msg *Point) XML() []byte {
+	xmlBytes, err := xml.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return xmlBytes
+}
+
+// ParseXML parses the XML representation of a `Point` messages from the `xmlBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *Point) ParseXML(xmlBytes []byte) error {
+	var decoded Point
+	if err := xml.Unmarshal(xmlBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// ROS1 returns with the `Point` message content in the ROS1 serialization format of the `geometry_msgs/PointStamped` ROS message
+func (msg *Point) ROS1() []byte {
+	return ros1.MarshalStamped(msg.Header, msg.Body.FrameID, msg.Body)
+}
+
+// ParseROS1 parses the ROS1 serialization of a `geometry_msgs/PointStamped` ROS message from the `ros1Bytes` argument.
+// The timestamp is converted into the current precision of the message, or into the default precision if the message has no precision.
+// The message is replaced as a whole by the decoded one.
+func (msg *Point) ParseROS1(ros1Bytes []byte) error {
+	decoded := Point{Header: common.Header{TimePrecision: msg.Header.TimePrecision}}
+	if err := ros1.UnmarshalStamped(ros1Bytes, &decoded.Header, &decoded.Body.FrameID, &decoded.Body); err != nil {
+		return err
+	}
+	decoded.Header.Version = msgs.GetMessageTypeVersion(PointTypeName)
+	*msg = decoded
+	return nil
+}
+
+// Protobuf returns with the `Point` message content in protobuf representation format
+func (msg *Point) Protobuf() []byte {
+	return common.MarshalProtobufMessage(msg.Header, msg.Body)
+}
+
+// ParseProtobuf parses the protobuf representation of a `Point` messages from the `protobufBytes` argument.
+// Protobuf omits the zero values, so the message is replaced as a whole by the decoded one.
+func (msg *Point) ParseProtobuf(protobufBytes []byte) error {
+	var decoded Point
+	if err := common.UnmarshalProtobufMessage(protobufBytes, &decoded.Header, &decoded.Body); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// YAML returns with the `Point` message content in YAML representation format
+func (msg *Point) YAML() []byte {
+	yamlBytes, err := yaml.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return yamlBytes
+}
+
+// ParseYAML parses the YAML representation of a `Point` messages from the `yamlBytes` argument.
+func (msg *Point) ParseYAML(yamlBytes []byte) error {
+	return yaml.Unmarshal(yamlBytes, msg)
+}
+
+// EncodeGob returns with the `Point` message content in Gob representation format
+func (msg *Point) EncodeGob() []byte {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(*msg); err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
+// DecodeGob parses the Gob representation of a `Point` messages from the `gobBytes` argument.
+// Gob omits the zero values, so the message is replaced as a whole by the decoded one.
+func (msg *Point) DecodeGob(gobBytes []byte) error {
+	var decoded Point
+	if err := gob.NewDecoder(bytes.NewReader(gobBytes)).Decode(&decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// NewPointMessage returns with a new `Point` message with the `body`. The header will contain the current time in `Nanoseconds` precision.
+func NewPointMessage(body PointBody) msgs.Message {
+	return NewPointMessageAt(body, time.Now().UnixNano(), "ns")
+}
+
+// NewPointMessageAt returns with a new `Point` message with the `body`. The header will contain the `at` time in `withPrecision` precision.
+func NewPointMessageAt(body PointBody, at int64, withPrecision common.TimePrecision) msgs.Message {
+	var msg Point
+	msg.Header = common.NewHeaderAt(at, withPrecision)
+	msg.Header.Version = msgs.GetMessageTypeVersion(PointTypeName)
+	msg.Body = body
+	return &msg
+}
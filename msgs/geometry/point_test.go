@@ -0,0 +1,65 @@
+package geometry
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/tombenke/axon-go-common/msgs"
+	"github.com/tombenke/axon-go-common/msgs/common"
+	"testing"
+)
+
+func TestPointGetType(t *testing.T) {
+	assert.Equal(t, NewPointMessage(PointBody{}).GetType(), PointTypeName)
+}
+
+func TestPointMessageCodecs(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewPointMessageAt(PointBody{FrameID: "map", Point: PointValue{X: 1, Y: -2.5, Z: 0.5}}, at, prec)
+
+	for _, representation := range []msgs.Representation{
+		msgs.JSONRepresentation,
+		msgs.YAMLRepresentation,
+		msgs.GobRepresentation,
+		msgs.ProtobufRepresentation,
+		msgs.XMLRepresentation,
+		msgs.AvroRepresentation,
+		msgs.MsgpackRepresentation,
+		msgs.CBORRepresentation,
+		msgs.ROS1Representation,
+	} {
+		t.Run(string(representation), func(t *testing.T) {
+			var n Point
+			err := n.Decode(representation, m.Encode(representation))
+			assert.Nil(t, err)
+			assert.Equal(t, m, &n)
+		})
+	}
+}
+
+// pointStampedFixture is the ROS1 serialization of a `geometry_msgs/PointStamped` message
+// with 1608732048.980057025 stamp, "map" frame id and (1, -2.5, 0.5) point
+var pointStampedFixture = []byte{
+	0x00, 0x00, 0x00, 0x00, 0x90, 0x4d, 0xe3, 0x5f, 0xc1, 0x7b, 0x6a, 0x3a, 0x03, 0x00, 0x00, 0x00, 0x6d, 0x61, 0x70,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04, 0xc0,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xe0, 0x3f,
+}
+
+func TestPointMessageROS1(t *testing.T) {
+	m := NewPointMessageAt(PointBody{FrameID: "map", Point: PointValue{X: 1, Y: -2.5, Z: 0.5}}, 1608732048980057025, common.Nanoseconds)
+	assert.Equal(t, pointStampedFixture, m.Encode(msgs.ROS1Representation))
+
+	// The timestamp is converted to the precision of the message
+	n := Point{Header: common.Header{TimePrecision: common.Milliseconds}}
+	assert.Nil(t, n.ParseROS1(pointStampedFixture))
+	assert.Equal(t, int64(1608732048980), n.Header.Timestamp)
+	assert.Equal(t, m.(*Point).Body, n.Body)
+
+	assert.NotNil(t, n.ParseROS1(pointStampedFixture[:30]))
+	assert.NotNil(t, n.ParseROS1(append(pointStampedFixture, 0)))
+}
+
+func TestPointMessageJSONFieldNames(t *testing.T) {
+	m := NewPointMessageAt(PointBody{FrameID: "map", Point: PointValue{X: 1, Y: 2, Z: 3}}, 1608732048980057025, common.Nanoseconds)
+	assert.Equal(t, `{"Header":{"TimePrecision":"ns","Timestamp":1608732048980057025},"Body":{"frame_id":"map","point":{"x":1,"y":2,"z":3}}}`, string(m.JSON()))
+}
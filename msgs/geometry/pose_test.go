@@ -0,0 +1,37 @@
+package geometry
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/tombenke/axon-go-common/msgs"
+	"github.com/tombenke/axon-go-common/msgs/common"
+	"testing"
+)
+
+func TestPoseGetType(t *testing.T) {
+	assert.Equal(t, NewPoseMessage(PoseBody{}).GetType(), PoseTypeName)
+}
+
+func TestPoseMessageCodecs(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewPoseMessageAt(PoseBody{FrameID: "map", Pose: PoseValue{Position: PointValue{X: 1, Y: 2}, Orientation: IdentityQuaternion}}, at, prec)
+
+	for _, representation := range []msgs.Representation{
+		msgs.JSONRepresentation,
+		msgs.YAMLRepresentation,
+		msgs.GobRepresentation,
+		msgs.ProtobufRepresentation,
+		msgs.XMLRepresentation,
+		msgs.AvroRepresentation,
+		msgs.MsgpackRepresentation,
+		msgs.CBORRepresentation,
+		msgs.ROS1Representation,
+	} {
+		t.Run(string(representation), func(t *testing.T) {
+			var n Pose
+			err := n.Decode(representation, m.Encode(representation))
+			assert.Nil(t, err)
+			assert.Equal(t, m, &n)
+		})
+	}
+}
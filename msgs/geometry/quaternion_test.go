@@ -0,0 +1,37 @@
+package geometry
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/tombenke/axon-go-common/msgs"
+	"github.com/tombenke/axon-go-common/msgs/common"
+	"testing"
+)
+
+func TestQuaternionGetType(t *testing.T) {
+	assert.Equal(t, NewQuaternionMessage(QuaternionBody{}).GetType(), QuaternionTypeName)
+}
+
+func TestQuaternionMessageCodecs(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewQuaternionMessageAt(QuaternionBody{FrameID: "map", Quaternion: QuaternionValue{X: 0, Y: 0, Z: 0.7071, W: 0.7071}}, at, prec)
+
+	for _, representation := range []msgs.Representation{
+		msgs.JSONRepresentation,
+		msgs.YAMLRepresentation,
+		msgs.GobRepresentation,
+		msgs.ProtobufRepresentation,
+		msgs.XMLRepresentation,
+		msgs.AvroRepresentation,
+		msgs.MsgpackRepresentation,
+		msgs.CBORRepresentation,
+		msgs.ROS1Representation,
+	} {
+		t.Run(string(representation), func(t *testing.T) {
+			var n Quaternion
+			err := n.Decode(representation, m.Encode(representation))
+			assert.Nil(t, err)
+			assert.Equal(t, m, &n)
+		})
+	}
+}
@@ -0,0 +1,291 @@
+package geometry
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"github.com/fxamacker/cbor/v2"
+	"github.com/tombenke/axon-go-common/msgs"
+	"github.com/tombenke/axon-go-common/msgs/avro"
+	"github.com/tombenke/axon-go-common/msgs/common"
+	"github.com/tombenke/axon-go-common/msgs/ros1"
+	"github.com/vmihailenco/msgpack/v5"
+	"gopkg.in/yaml.v2"
+	"time"
+)
+
+const (
+	// TransformTypeName is the printable name of the `Transform` message-type
+	TransformTypeName = "geometry/Transform"
+	// TransformROSTypeName is the name of the ROS message-type that the `Transform` message-type corresponds to
+	TransformROSTypeName = "geometry_msgs/TransformStamped"
+)
+
+func init() {
+	msgs.RegisterMessageType(TransformTypeName, []msgs.Representation{msgs.JSONRepresentation, msgs.YAMLRepresentation, msgs.GobRepresentation, msgs.ProtobufRepresentation, msgs.XMLRepresentation, msgs.AvroRepresentation, msgs.MsgpackRepresentation, msgs.CBORRepresentation, msgs.ROS1Representation}, func() msgs.Message {
+		return NewTransformMessage(TransformBody{Transform: TransformValue{Rotation: IdentityQuaternion}})
+	})
+	msgs.SetMessageTypeDescription(TransformTypeName, "Transform from a parent coordinate frame to a child frame")
+	msgs.SetMessageTypeROSType(TransformTypeName, TransformROSTypeName)
+}
+
+// Transform represents the structure of the messages that hold a transform between two coordinate frames.
+type Transform struct {
+	Header common.Header
+	Body   TransformBody
+}
+
+// GetType returns with the printable name of the `Transform` message-type
+func (msg *Transform) GetType() string {
+	return TransformTypeName
+}
+
+// Encode returns with the `Transform` message content in a representation format selected by `representation`
+func (msg *Transform) Encode(representation msgs.Representation) (results []byte) {
+	switch representation {
+	case msgs.JSONRepresentation:
+		var err error
+		results, err = json.Marshal(*msg)
+		if err != nil {
+			panic(err)
+		}
+	case msgs.YAMLRepresentation:
+		results = msg.YAML()
+	case msgs.GobRepresentation:
+		results = msg.EncodeGob()
+	case msgs.ProtobufRepresentation:
+		results = msg.Protobuf()
+	case msgs.XMLRepresentation:
+		results = msg.XML()
+	case msgs.AvroRepresentation:
+		results = msg.Avro()
+	case msgs.MsgpackRepresentation:
+		results = msg.Msgpack()
+	case msgs.CBORRepresentation:
+		results = msg.CBOR()
+	case msgs.ROS1Representation:
+		results = msg.ROS1()
+	default:
+		panic(fmt.Errorf("Encode error: unknown representational format '%s'", representation))
+	}
+	return results
+}
+
+// Decode parses the `content` using the selected `representation` format.
+// The messages of older versions are upgraded to the current version of the message-type, if the `representation` is upgradable.
+func (msg *Transform) Decode(representation msgs.Representation, content []byte) error {
+	content, err := msgs.Upgrade(TransformTypeName, representation, content)
+	if err != nil {
+		return err
+	}
+
+	switch representation {
+	case msgs.JSONRepresentation:
+		return json.Unmarshal(content, msg)
+	case msgs.YAMLRepresentation:
+		return msg.ParseYAML(content)
+	case msgs.GobRepresentation:
+		return msg.DecodeGob(content)
+	case msgs.ProtobufRepresentation:
+		return msg.ParseProtobuf(content)
+	case msgs.XMLRepresentation:
+		return msg.ParseXML(content)
+	case msgs.AvroRepresentation:
+		return msg.ParseAvro(content)
+	case msgs.MsgpackRepresentation:
+		return msg.ParseMsgpack(content)
+	case msgs.CBORRepresentation:
+		return msg.ParseCBOR(content)
+	case msgs.ROS1Representation:
+		return msg.ParseROS1(content)
+	default:
+		panic(fmt.Errorf("Decode error: unknown representational format '%s'", representation))
+	}
+}
+
+// JSON returns with the `Transform` message content in JSON representation format
+func (msg *Transform) JSON() []byte {
+	jsonBytes, err := json.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return jsonBytes
+}
+
+// String returns with the `Transform` message content in JSON format string
+func (msg *Transform) String() string {
+	jsonBytes, err := json.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return string(jsonBytes)
+}
+
+// ParseJSON parses the JSON representation of a `Transform` messages from the `jsonBytes` argument.
+func (msg *Transform) ParseJSON(jsonBytes []byte) error {
+	return json.Unmarshal(jsonBytes, msg)
+}
+
+// Msgpack returns with the `Transform` message content in MessagePack representation format
+func (msg *Transform) Msgpack() []byte {
+	msgpackBytes, err := msgpack.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return msgpackBytes
+}
+
+// ParseMsgpack parses the MessagePack representation of a `Transform` messages from the `msgpackBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *Transform) ParseMsgpack(msgpackBytes []byte) error {
+	var decoded Transform
+	if err := msgpack.Unmarshal(msgpackBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// CBOR returns with the `Transform` message content in CBOR representation format
+func (msg *Transform) CBOR() []byte {
+	cborBytes, err := cbor.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return cborBytes
+}
+
+// ParseCBOR parses the CBOR representation of a `Transform` messages from the `cborBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *Transform) ParseCBOR(cborBytes []byte) error {
+	var decoded Transform
+	if err := cbor.Unmarshal(cborBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// Avro returns with the `Transform` message content in Avro representation format
+func (msg *Transform) Avro() []byte {
+	avroBytes, err := avro.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return avroBytes
+}
+
+// ParseAvro parses the Avro representation of a `Transform` messages from the `avroBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *Transform) ParseAvro(avroBytes []byte) error {
+	var decoded Transform
+	if err := avro.Unmarshal(avroBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// XML returns with the `Transform` message content in XML representation format
+func (msg *Transform) XML() []byte {
+	xmlBytes, err := xml.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return xmlBytes
+}
+
+// ParseXML parses the XML representation of a `Transform` messages from the `xmlBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *Transform) ParseXML(xmlBytes []byte) error {
+	var decoded Transform
+	if err := xml.Unmarshal(xmlBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// ROS1 returns with the `Transform` message content in the ROS1 serialization format of the `geometry_msgs/TransformStamped` ROS message
+func (msg *Transform) ROS1() []byte {
+	return ros1.MarshalStamped(msg.Header, msg.Body.FrameID, msg.Body)
+}
+
+// ParseROS1 parses the ROS1 serialization of a `geometry_msgs/TransformStamped` ROS message from the `ros1Bytes` argument.
+// The timestamp is converted into the current precision of the message, or into the default precision if the message has no precision.
+// The message is replaced as a whole by the decoded one.
+func (msg *Transform) ParseROS1(ros1Bytes []byte) error {
+	decoded := Transform{Header: common.Header{TimePrecision: msg.Header.TimePrecision}}
+	if err := ros1.UnmarshalStamped(ros1Bytes, &decoded.Header, &decoded.Body.FrameID, &decoded.Body); err != nil {
+		return err
+	}
+	decoded.Header.Version = msgs.GetMessageTypeVersion(TransformTypeName)
+	*msg = decoded
+	return nil
+}
+
+// Protobuf returns with the `Transform` message content in protobuf representation format
+func (msg *Transform) Protobuf() []byte {
+	return common.MarshalProtobufMessage(msg.Header, msg.Body)
+}
+
+// ParseProtobuf parses the protobuf representation of a `Transform` messages from the `protobufBytes` argument.
+// Protobuf omits the zero values, so the message is replaced as a whole by the decoded one.
+func (msg *Transform) ParseProtobuf(protobufBytes []byte) error {
+	var decoded Transform
+	if err := common.UnmarshalProtobufMessage(protobufBytes, &decoded.Header, &decoded.Body); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// YAML returns with the `Transform` message content in YAML representation format
+func (msg *Transform) YAML() []byte {
+	yamlBytes, err := yaml.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return yamlBytes
+}
+
+// ParseYAML parses the YAML representation of a `Transform` messages from the `yamlBytes` argument.
+func (msg *Transform) ParseYAML(yamlBytes []byte) error {
+	return yaml.Unmarshal(yamlBytes, msg)
+}
+
+// EncodeGob returns with the `Transform` message content in Gob representation format
+func (msg *Transform) EncodeGob() []byte {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(*msg); err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
+// DecodeGob parses the Gob representation of a `Transform` messages from the `gobBytes` argument.
+// Gob omits the zero values, so the message is replaced as a whole by the decoded one.
+func (msg *Transform) DecodeGob(gobBytes []byte) error {
+	var decoded Transform
+	if err := gob.NewDecoder(bytes.NewReader(gobBytes)).Decode(&decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// NewTransformMessage returns with a new `Transform` message with the `body`. The header will contain the current time in `Nanoseconds` precision.
+func NewTransformMessage(body TransformBody) msgs.Message {
+	return NewTransformMessageAt(body, time.Now().UnixNano(), "ns")
+}
+
+// NewTransformMessageAt returns with a new `Transform` message with the `body`. The header will contain the `at` time in `withPrecision` precision.
+func NewTransformMessageAt(body TransformBody, at int64, withPrecision common.TimePrecision) msgs.Message {
+	var msg Transform
+	msg.Header = common.NewHeaderAt(at, withPrecision)
+	msg.Header.Version = msgs.GetMessageTypeVersion(TransformTypeName)
+	msg.Body = body
+	return &msg
+}
@@ -0,0 +1,37 @@
+package geometry
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/tombenke/axon-go-common/msgs"
+	"github.com/tombenke/axon-go-common/msgs/common"
+	"testing"
+)
+
+func TestTransformGetType(t *testing.T) {
+	assert.Equal(t, NewTransformMessage(TransformBody{}).GetType(), TransformTypeName)
+}
+
+func TestTransformMessageCodecs(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewTransformMessageAt(TransformBody{FrameID: "odom", ChildFrameID: "base_link", Transform: TransformValue{Translation: Vector3Value{X: 3, Y: -1}, Rotation: QuaternionValue{Z: 1}}}, at, prec)
+
+	for _, representation := range []msgs.Representation{
+		msgs.JSONRepresentation,
+		msgs.YAMLRepresentation,
+		msgs.GobRepresentation,
+		msgs.ProtobufRepresentation,
+		msgs.XMLRepresentation,
+		msgs.AvroRepresentation,
+		msgs.MsgpackRepresentation,
+		msgs.CBORRepresentation,
+		msgs.ROS1Representation,
+	} {
+		t.Run(string(representation), func(t *testing.T) {
+			var n Transform
+			err := n.Decode(representation, m.Encode(representation))
+			assert.Nil(t, err)
+			assert.Equal(t, m, &n)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package geometry
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/tombenke/axon-go-common/msgs"
+	"github.com/tombenke/axon-go-common/msgs/common"
+	"testing"
+)
+
+func TestTwistWithCovarianceGetType(t *testing.T) {
+	assert.Equal(t, NewTwistWithCovarianceMessage(TwistWithCovarianceBody{}).GetType(), TwistWithCovarianceTypeName)
+}
+
+func TestTwistWithCovarianceMessageCodecs(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewTwistWithCovarianceMessageAt(TwistWithCovarianceBody{FrameID: "base_link", Twist: TwistWithCovarianceValue{Twist: TwistValue{Linear: Vector3Value{X: 0.5}}, Covariance: func() (c Covariance) {
+		for i := range c {
+			c[i] = float64(i) / 10
+		}
+		return c
+	}()}}, at, prec)
+
+	for _, representation := range []msgs.Representation{
+		msgs.JSONRepresentation,
+		msgs.YAMLRepresentation,
+		msgs.GobRepresentation,
+		msgs.ProtobufRepresentation,
+		msgs.XMLRepresentation,
+		msgs.AvroRepresentation,
+		msgs.MsgpackRepresentation,
+		msgs.CBORRepresentation,
+		msgs.ROS1Representation,
+	} {
+		t.Run(string(representation), func(t *testing.T) {
+			var n TwistWithCovariance
+			err := n.Decode(representation, m.Encode(representation))
+			assert.Nil(t, err)
+			assert.Equal(t, m, &n)
+		})
+	}
+}
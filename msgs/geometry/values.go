@@ -0,0 +1,429 @@
+package geometry
+
+import (
+	"encoding/xml"
+	"fmt"
+	"github.com/tombenke/axon-go-common/msgs/common"
+	"github.com/tombenke/axon-go-common/msgs/ros1"
+	"strconv"
+	"strings"
+)
+
+// CovarianceSize is the number of the values of the row-major 6x6 covariance matrices
+const CovarianceSize = 36
+
+// Covariance is a row-major 6x6 covariance matrix about the
+// (x, y, z, rotation about X axis, rotation about Y axis, rotation about Z axis) parameters.
+type Covariance [CovarianceSize]float64
+
+// MarshalXML encodes the covariance matrix into one XML element that holds the values separated by space.
+// The `encoding/xml` package can not decode the fixed-length arrays, so they need to be encoded as text.
+func (c Covariance) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	values := make([]string, len(c))
+	for i, v := range c {
+		values[i] = strconv.FormatFloat(v, 'g', -1, 64)
+	}
+	return e.EncodeElement(strings.Join(values, " "), start)
+}
+
+// UnmarshalXML decodes the covariance matrix from an XML element that holds the values separated by white space
+func (c *Covariance) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var text string
+	if err := d.DecodeElement(&text, &start); err != nil {
+		return err
+	}
+	values := strings.Fields(text)
+	if len(values) != CovarianceSize {
+		return fmt.Errorf("covariance has %d values instead of %d", len(values), CovarianceSize)
+	}
+	for i, v := range values {
+		var err error
+		if c[i], err = strconv.ParseFloat(v, 64); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PointValue is the position of a point in free space, like the `geometry_msgs/Point` ROS message
+type PointValue struct {
+	X float64 `json:"x" yaml:"x"`
+	Y float64 `json:"y" yaml:"y"`
+	Z float64 `json:"z" yaml:"z"`
+}
+
+// AppendProtobuf appends the protobuf encoded fields of the point to `b`
+func (v PointValue) AppendProtobuf(b []byte) []byte {
+	b = common.AppendProtobufFloat64(b, 1, v.X)
+	b = common.AppendProtobufFloat64(b, 2, v.Y)
+	b = common.AppendProtobufFloat64(b, 3, v.Z)
+	return b
+}
+
+// ParseProtobuf parses the protobuf encoded point from `b`
+func (v *PointValue) ParseProtobuf(b []byte) error {
+	fields, err := common.ParseProtobufFields(b)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range fields {
+		switch f.Num {
+		case 1:
+			v.X = f.Float64()
+		case 2:
+			v.Y = f.Float64()
+		case 3:
+			v.Z = f.Float64()
+		}
+	}
+	return nil
+}
+
+// MarshalROS1 writes the ROS1 serialization of the point
+func (v PointValue) MarshalROS1(e *ros1.Encoder) {
+	e.Float64(v.X)
+	e.Float64(v.Y)
+	e.Float64(v.Z)
+}
+
+// UnmarshalROS1 reads the ROS1 serialization of the point
+func (v *PointValue) UnmarshalROS1(d *ros1.Decoder) {
+	v.X = d.Float64()
+	v.Y = d.Float64()
+	v.Z = d.Float64()
+}
+
+// Vector3Value represents a vector in free space, like the `geometry_msgs/Vector3` ROS message
+type Vector3Value struct {
+	X float64 `json:"x" yaml:"x"`
+	Y float64 `json:"y" yaml:"y"`
+	Z float64 `json:"z" yaml:"z"`
+}
+
+// AppendProtobuf appends the protobuf encoded fields of the vector to `b`
+func (v Vector3Value) AppendProtobuf(b []byte) []byte {
+	return PointValue(v).AppendProtobuf(b)
+}
+
+// ParseProtobuf parses the protobuf encoded vector from `b`
+func (v *Vector3Value) ParseProtobuf(b []byte) error {
+	return (*PointValue)(v).ParseProtobuf(b)
+}
+
+// MarshalROS1 writes the ROS1 serialization of the vector
+func (v Vector3Value) MarshalROS1(e *ros1.Encoder) {
+	PointValue(v).MarshalROS1(e)
+}
+
+// UnmarshalROS1 reads the ROS1 serialization of the vector
+func (v *Vector3Value) UnmarshalROS1(d *ros1.Decoder) {
+	(*PointValue)(v).UnmarshalROS1(d)
+}
+
+// QuaternionValue represents an orientation in free space in quaternion form,
+// like the `geometry_msgs/Quaternion` ROS message
+type QuaternionValue struct {
+	X float64 `json:"x" yaml:"x"`
+	Y float64 `json:"y" yaml:"y"`
+	Z float64 `json:"z" yaml:"z"`
+	W float64 `json:"w" yaml:"w"`
+}
+
+// IdentityQuaternion is the quaternion of no rotation
+var IdentityQuaternion = QuaternionValue{W: 1}
+
+// AppendProtobuf appends the protobuf encoded fields of the quaternion to `b`
+func (v QuaternionValue) AppendProtobuf(b []byte) []byte {
+	b = common.AppendProtobufFloat64(b, 1, v.X)
+	b = common.AppendProtobufFloat64(b, 2, v.Y)
+	b = common.AppendProtobufFloat64(b, 3, v.Z)
+	b = common.AppendProtobufFloat64(b, 4, v.W)
+	return b
+}
+
+// ParseProtobuf parses the protobuf encoded quaternion from `b`
+func (v *QuaternionValue) ParseProtobuf(b []byte) error {
+	fields, err := common.ParseProtobufFields(b)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range fields {
+		switch f.Num {
+		case 1:
+			v.X = f.Float64()
+		case 2:
+			v.Y = f.Float64()
+		case 3:
+			v.Z = f.Float64()
+		case 4:
+			v.W = f.Float64()
+		}
+	}
+	return nil
+}
+
+// MarshalROS1 writes the ROS1 serialization of the quaternion
+func (v QuaternionValue) MarshalROS1(e *ros1.Encoder) {
+	e.Float64(v.X)
+	e.Float64(v.Y)
+	e.Float64(v.Z)
+	e.Float64(v.W)
+}
+
+// UnmarshalROS1 reads the ROS1 serialization of the quaternion
+func (v *QuaternionValue) UnmarshalROS1(d *ros1.Decoder) {
+	v.X = d.Float64()
+	v.Y = d.Float64()
+	v.Z = d.Float64()
+	v.W = d.Float64()
+}
+
+// PoseValue represents a pose in free space, composed of position and orientation,
+// like the `geometry_msgs/Pose` ROS message
+type PoseValue struct {
+	Position    PointValue      `json:"position" yaml:"position"`
+	Orientation QuaternionValue `json:"orientation" yaml:"orientation"`
+}
+
+// AppendProtobuf appends the protobuf encoded fields of the pose to `b`
+func (v PoseValue) AppendProtobuf(b []byte) []byte {
+	b = common.AppendProtobufMessage(b, 1, v.Position)
+	b = common.AppendProtobufMessage(b, 2, v.Orientation)
+	return b
+}
+
+// ParseProtobuf parses the protobuf encoded pose from `b`
+func (v *PoseValue) ParseProtobuf(b []byte) error {
+	fields, err := common.ParseProtobufFields(b)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range fields {
+		switch f.Num {
+		case 1:
+			err = v.Position.ParseProtobuf(f.Bytes)
+		case 2:
+			err = v.Orientation.ParseProtobuf(f.Bytes)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalROS1 writes the ROS1 serialization of the pose
+func (v PoseValue) MarshalROS1(e *ros1.Encoder) {
+	v.Position.MarshalROS1(e)
+	v.Orientation.MarshalROS1(e)
+}
+
+// UnmarshalROS1 reads the ROS1 serialization of the pose
+func (v *PoseValue) UnmarshalROS1(d *ros1.Decoder) {
+	v.Position.UnmarshalROS1(d)
+	v.Orientation.UnmarshalROS1(d)
+}
+
+// TwistValue expresses velocity in free space broken into its linear and angular parts,
+// like the `geometry_msgs/Twist` ROS message
+type TwistValue struct {
+	Linear  Vector3Value `json:"linear" yaml:"linear"`
+	Angular Vector3Value `json:"angular" yaml:"angular"`
+}
+
+// AppendProtobuf appends the protobuf encoded fields of the twist to `b`
+func (v TwistValue) AppendProtobuf(b []byte) []byte {
+	b = common.AppendProtobufMessage(b, 1, v.Linear)
+	b = common.AppendProtobufMessage(b, 2, v.Angular)
+	return b
+}
+
+// ParseProtobuf parses the protobuf encoded twist from `b`
+func (v *TwistValue) ParseProtobuf(b []byte) error {
+	fields, err := common.ParseProtobufFields(b)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range fields {
+		switch f.Num {
+		case 1:
+			err = v.Linear.ParseProtobuf(f.Bytes)
+		case 2:
+			err = v.Angular.ParseProtobuf(f.Bytes)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalROS1 writes the ROS1 serialization of the twist
+func (v TwistValue) MarshalROS1(e *ros1.Encoder) {
+	v.Linear.MarshalROS1(e)
+	v.Angular.MarshalROS1(e)
+}
+
+// UnmarshalROS1 reads the ROS1 serialization of the twist
+func (v *TwistValue) UnmarshalROS1(d *ros1.Decoder) {
+	v.Linear.UnmarshalROS1(d)
+	v.Angular.UnmarshalROS1(d)
+}
+
+// TransformValue represents the transform between two coordinate frames in free space,
+// like the `geometry_msgs/Transform` ROS message
+type TransformValue struct {
+	Translation Vector3Value    `json:"translation" yaml:"translation"`
+	Rotation    QuaternionValue `json:"rotation" yaml:"rotation"`
+}
+
+// AppendProtobuf appends the protobuf encoded fields of the transform to `b`
+func (v TransformValue) AppendProtobuf(b []byte) []byte {
+	b = common.AppendProtobufMessage(b, 1, v.Translation)
+	b = common.AppendProtobufMessage(b, 2, v.Rotation)
+	return b
+}
+
+// ParseProtobuf parses the protobuf encoded transform from `b`
+func (v *TransformValue) ParseProtobuf(b []byte) error {
+	fields, err := common.ParseProtobufFields(b)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range fields {
+		switch f.Num {
+		case 1:
+			err = v.Translation.ParseProtobuf(f.Bytes)
+		case 2:
+			err = v.Rotation.ParseProtobuf(f.Bytes)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalROS1 writes the ROS1 serialization of the transform
+func (v TransformValue) MarshalROS1(e *ros1.Encoder) {
+	v.Translation.MarshalROS1(e)
+	v.Rotation.MarshalROS1(e)
+}
+
+// UnmarshalROS1 reads the ROS1 serialization of the transform
+func (v *TransformValue) UnmarshalROS1(d *ros1.Decoder) {
+	v.Translation.UnmarshalROS1(d)
+	v.Rotation.UnmarshalROS1(d)
+}
+
+// PoseWithCovarianceValue represents a pose in free space with uncertainty,
+// like the `geometry_msgs/PoseWithCovariance` ROS message.
+type PoseWithCovarianceValue struct {
+	Pose       PoseValue  `json:"pose" yaml:"pose"`
+	Covariance Covariance `json:"covariance" yaml:"covariance"`
+}
+
+// AppendProtobuf appends the protobuf encoded fields of the pose with covariance to `b`
+func (v PoseWithCovarianceValue) AppendProtobuf(b []byte) []byte {
+	b = common.AppendProtobufMessage(b, 1, v.Pose)
+	b = common.AppendProtobufPackedFloat64s(b, 2, v.Covariance[:])
+	return b
+}
+
+// ParseProtobuf parses the protobuf encoded pose with covariance from `b`
+func (v *PoseWithCovarianceValue) ParseProtobuf(b []byte) error {
+	fields, err := common.ParseProtobufFields(b)
+	if err != nil {
+		return err
+	}
+
+	covariance := []float64{}
+	for _, f := range fields {
+		switch f.Num {
+		case 1:
+			err = v.Pose.ParseProtobuf(f.Bytes)
+		case 2:
+			covariance = append(covariance, f.Float64s()...)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return parseCovariance(&v.Covariance, covariance)
+}
+
+// MarshalROS1 writes the ROS1 serialization of the pose with covariance
+func (v PoseWithCovarianceValue) MarshalROS1(e *ros1.Encoder) {
+	v.Pose.MarshalROS1(e)
+	e.Float64s(v.Covariance[:])
+}
+
+// UnmarshalROS1 reads the ROS1 serialization of the pose with covariance
+func (v *PoseWithCovarianceValue) UnmarshalROS1(d *ros1.Decoder) {
+	v.Pose.UnmarshalROS1(d)
+	d.Float64s(v.Covariance[:])
+}
+
+// TwistWithCovarianceValue expresses velocity in free space with uncertainty,
+// like the `geometry_msgs/TwistWithCovariance` ROS message.
+type TwistWithCovarianceValue struct {
+	Twist      TwistValue `json:"twist" yaml:"twist"`
+	Covariance Covariance `json:"covariance" yaml:"covariance"`
+}
+
+// AppendProtobuf appends the protobuf encoded fields of the twist with covariance to `b`
+func (v TwistWithCovarianceValue) AppendProtobuf(b []byte) []byte {
+	b = common.AppendProtobufMessage(b, 1, v.Twist)
+	b = common.AppendProtobufPackedFloat64s(b, 2, v.Covariance[:])
+	return b
+}
+
+// ParseProtobuf parses the protobuf encoded twist with covariance from `b`
+func (v *TwistWithCovarianceValue) ParseProtobuf(b []byte) error {
+	fields, err := common.ParseProtobufFields(b)
+	if err != nil {
+		return err
+	}
+
+	covariance := []float64{}
+	for _, f := range fields {
+		switch f.Num {
+		case 1:
+			err = v.Twist.ParseProtobuf(f.Bytes)
+		case 2:
+			covariance = append(covariance, f.Float64s()...)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return parseCovariance(&v.Covariance, covariance)
+}
+
+// MarshalROS1 writes the ROS1 serialization of the twist with covariance
+func (v TwistWithCovarianceValue) MarshalROS1(e *ros1.Encoder) {
+	v.Twist.MarshalROS1(e)
+	e.Float64s(v.Covariance[:])
+}
+
+// UnmarshalROS1 reads the ROS1 serialization of the twist with covariance
+func (v *TwistWithCovarianceValue) UnmarshalROS1(d *ros1.Decoder) {
+	v.Twist.UnmarshalROS1(d)
+	d.Float64s(v.Covariance[:])
+}
+
+// parseCovariance copies the `values` parsed from protobuf into the `covariance` matrix.
+// Protobuf omits the empty list, so a zero matrix is accepted as no values.
+func parseCovariance(covariance *Covariance, values []float64) error {
+	if len(values) != 0 && len(values) != CovarianceSize {
+		return fmt.Errorf("covariance has %d values instead of %d", len(values), CovarianceSize)
+	}
+	*covariance = Covariance{}
+	copy(covariance[:], values)
+	return nil
+}
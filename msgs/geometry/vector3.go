@@ -0,0 +1,291 @@
+package geometry
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"github.com/fxamacker/cbor/v2"
+	"github.com/tombenke/axon-go-common/msgs"
+	"github.com/tombenke/axon-go-common/msgs/avro"
+	"github.com/tombenke/axon-go-common/msgs/common"
+	"github.com/tombenke/axon-go-common/msgs/ros1"
+	"github.com/vmihailenco/msgpack/v5"
+	"gopkg.in/yaml.v2"
+	"time"
+)
+
+const (
+	// Vector3TypeName is the printable name of the `Vector3` message-type
+	Vector3TypeName = "geometry/Vector3"
+	// Vector3ROSTypeName is the name of the ROS message-type that the `Vector3` message-type corresponds to
+	Vector3ROSTypeName = "geometry_msgs/Vector3Stamped"
+)
+
+func init() {
+	msgs.RegisterMessageType(Vector3TypeName, []msgs.Representation{msgs.JSONRepresentation, msgs.YAMLRepresentation, msgs.GobRepresentation, msgs.ProtobufRepresentation, msgs.XMLRepresentation, msgs.AvroRepresentation, msgs.MsgpackRepresentation, msgs.CBORRepresentation, msgs.ROS1Representation}, func() msgs.Message {
+		return NewVector3Message(Vector3Body{})
+	})
+	msgs.SetMessageTypeDescription(Vector3TypeName, "Vector with the frame it is given in")
+	msgs.SetMessageTypeROSType(Vector3TypeName, Vector3ROSTypeName)
+}
+
+// Vector3 represents the structure of the messages that hold a vector in free space.
+type Vector3 struct {
+	Header common.Header
+	Body   Vector3Body
+}
+
+// GetType returns with the printable name of the `Vector3` message-type
+func (msg *Vector3) GetType() string {
+	return Vector3TypeName
+}
+
+// Encode returns with the `Vector3` message content in a representation format selected by `representation`
+func (msg *Vector3) Encode(representation msgs.Representation) (results []byte) {
+	switch representation {
+	case msgs.JSONRepresentation:
+		var err error
+		results, err = json.Marshal(*msg)
+		if err != nil {
+			panic(err)
+		}
+	case msgs.YAMLRepresentation:
+		results = msg.YAML()
+	case msgs.GobRepresentation:
+		results = msg.EncodeGob()
+	case msgs.ProtobufRepresentation:
+		results = msg.Protobuf()
+	case msgs.XMLRepresentation:
+		results = msg.XML()
+	case msgs.AvroRepresentation:
+		results = msg.Avro()
+	case msgs.MsgpackRepresentation:
+		results = msg.Msgpack()
+	case msgs.CBORRepresentation:
+		results = msg.CBOR()
+	case msgs.ROS1Representation:
+		results = msg.ROS1()
+	default:
+		panic(fmt.Errorf("Encode error: unknown representational format '%s'", representation))
+	}
+	return results
+}
+
+// Decode parses the `content` using the selected `representation` format.
+// The messages of older versions are upgraded to the current version of the message-type, if the `representation` is upgradable.
+func (msg *Vector3) Decode(representation msgs.Representation, content []byte) error {
+	content, err := msgs.Upgrade(Vector3TypeName, representation, content)
+	if err != nil {
+		return err
+	}
+
+	switch representation {
+	case msgs.JSONRepresentation:
+		return json.Unmarshal(content, msg)
+	case msgs.YAMLRepresentation:
+		return msg.ParseYAML(content)
+	case msgs.GobRepresentation:
+		return msg.DecodeGob(content)
+	case msgs.ProtobufRepresentation:
+		return msg.ParseProtobuf(content)
+	case msgs.XMLRepresentation:
+		return msg.ParseXML(content)
+	case msgs.AvroRepresentation:
+		return msg.ParseAvro(content)
+	case msgs.MsgpackRepresentation:
+		return msg.ParseMsgpack(content)
+	case msgs.CBORRepresentation:
+		return msg.ParseCBOR(content)
+	case msgs.ROS1Representation:
+		return msg.ParseROS1(content)
+	default:
+		panic(fmt.Errorf("Decode error: unknown representational format '%s'", representation))
+	}
+}
+
+// JSON returns with the `Vector3` message content in JSON representation format
+func (msg *Vector3) JSON() []byte {
+	jsonBytes, err := json.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return jsonBytes
+}
+
+// String returns with the `Vector3` message content in JSON format string
+func (msg *Vector3) String() string {
+	jsonBytes, err := json.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return string(jsonBytes)
+}
+
+// ParseJSON parses the JSON representation of a `Vector3` messages from the `jsonBytes` argument.
+func (msg *Vector3) ParseJSON(jsonBytes []byte) error {
+	return json.Unmarshal(jsonBytes, msg)
+}
+
+// Msgpack returns with the `Vector3` message content in MessagePack representation format
+func (msg *Vector3) Msgpack() []byte {
+	msgpackBytes, err := msgpack.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return msgpackBytes
+}
+
+// ParseMsgpack parses the MessagePack representation of a `Vector3` messages from the `msgpackBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *Vector3) ParseMsgpack(msgpackBytes []byte) error {
+	var decoded Vector3
+	if err := msgpack.Unmarshal(msgpackBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// CBOR returns with the `Vector3` message content in CBOR representation format
+func (msg *Vector3) CBOR() []byte {
+	cborBytes, err := cbor.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return cborBytes
+}
+
+// ParseCBOR parses the CBOR representation of a `Vector3` messages from the `cborBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *Vector3) ParseCBOR(cborBytes []byte) error {
+	var decoded Vector3
+	if err := cbor.Unmarshal(cborBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// Avro returns with the `Vector3` message content in Avro representation format
+func (msg *Vector3) Avro() []byte {
+	avroBytes, err := avro.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return avroBytes
+}
+
+// ParseAvro parses the Avro representation of a `Vector3` messages from the `avroBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *Vector3) ParseAvro(avroBytes []byte) error {
+	var decoded Vector3
+	if err := avro.Unmarshal(avroBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// XML returns with the `Vector3` message content in XML representation format
+func (msg *Vector3) XML() []byte {
+	xmlBytes, err := xml.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return xmlBytes
+}
+
+// ParseXML parses the XML representation of a `Vector3` messages from the `xmlBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *Vector3) ParseXML(xmlBytes []byte) error {
+	var decoded Vector3
+	if err := xml.Unmarshal(xmlBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// ROS1 returns with the `Vector3` message content in the ROS1 serialization format of the `geometry_msgs/Vector3Stamped` ROS message
+func (msg *Vector3) ROS1() []byte {
+	return ros1.MarshalStamped(msg.Header, msg.Body.FrameID, msg.Body)
+}
+
+// ParseROS1 parses the ROS1 serialization of a `geometry_msgs/Vector3Stamped` ROS message from the `ros1Bytes` argument.
+// The timestamp is converted into the current precision of the message, or into the default precision if the message has no precision.
+// The message is replaced as a whole by the decoded one.
+func (msg *Vector3) ParseROS1(ros1Bytes []byte) error {
+	decoded := Vector3{Header: common.Header{TimePrecision: msg.Header.TimePrecision}}
+	if err := ros1.UnmarshalStamped(ros1Bytes, &decoded.Header, &decoded.Body.FrameID, &decoded.Body); err != nil {
+		return err
+	}
+	decoded.Header.Version = msgs.GetMessageTypeVersion(Vector3TypeName)
+	*msg = decoded
+	return nil
+}
+
+// Protobuf returns with the `Vector3` message content in protobuf representation format
+func (msg *Vector3) Protobuf() []byte {
+	return common.MarshalProtobufMessage(msg.Header, msg.Body)
+}
+
+// ParseProtobuf parses the protobuf representation of a `Vector3` messages from the `protobufBytes` argument.
+// Protobuf omits the zero values, so the message is replaced as a whole by the decoded one.
+func (msg *Vector3) ParseProtobuf(protobufBytes []byte) error {
+	var decoded Vector3
+	if err := common.UnmarshalProtobufMessage(protobufBytes, &decoded.Header, &decoded.Body); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// YAML returns with the `Vector3` message content in YAML representation format
+func (msg *Vector3) YAML() []byte {
+	yamlBytes, err := yaml.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return yamlBytes
+}
+
+// ParseYAML parses the YAML representation of a `Vector3` messages from the `yamlBytes` argument.
+func (msg *Vector3) ParseYAML(yamlBytes []byte) error {
+	return yaml.Unmarshal(yamlBytes, msg)
+}
+
+// EncodeGob returns with the `Vector3` message content in Gob representation format
+func (msg *Vector3) EncodeGob() []byte {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(*msg); err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
+// DecodeGob parses the Gob representation of a `Vector3` messages from the `gobBytes` argument.
+// Gob omits the zero values, so the message is replaced as a whole by the decoded one.
+func (msg *Vector3) DecodeGob(gobBytes []byte) error {
+	var decoded Vector3
+	if err := gob.NewDecoder(bytes.NewReader(gobBytes)).Decode(&decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// NewVector3Message returns with a new `Vector3` message with the `body`. The header will contain the current time in `Nanoseconds` precision.
+func NewVector3Message(body Vector3Body) msgs.Message {
+	return NewVector3MessageAt(body, time.Now().UnixNano(), "ns")
+}
+
+// NewVector3MessageAt returns with a new `Vector3` message with the `body`. The header will contain the `at` time in `withPrecision` precision.
+func NewVector3MessageAt(body Vector3Body, at int64, withPrecision common.TimePrecision) msgs.Message {
+	var msg Vector3
+	msg.Header = common.NewHeaderAt(at, withPrecision)
+	msg.Header.Version = msgs.GetMessageTypeVersion(Vector3TypeName)
+	msg.Body = body
+	return &msg
+}
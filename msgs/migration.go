@@ -0,0 +1,179 @@
+package msgs
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/fxamacker/cbor/v2"
+	"github.com/vmihailenco/msgpack/v5"
+	"reflect"
+)
+
+// MigrationFunc upgrades the `doc` document of a message from a version of its message-type to the next one.
+// The document holds the message in the structure of its JSON representation,
+// e.g. the version is held by `doc["Header"].(map[string]interface{})["Version"]`.
+// The type of the numbers depends on the representation: `json.Number` in case of JSON,
+// and one of the integer or float types in case of the binary representations.
+type MigrationFunc func(doc map[string]interface{}) error
+
+// headerKey and versionKey are the keys of the version in the document of the messages
+const (
+	headerKey  = "Header"
+	versionKey = "Version"
+)
+
+// cborDecMode decodes the nested CBOR maps into `map[string]interface{}` values, like the JSON decoder does
+var cborDecMode = func() cbor.DecMode {
+	decMode, err := cbor.DecOptions{DefaultMapType: reflect.TypeOf(map[string]interface{}{})}.DecMode()
+	if err != nil {
+		panic(err)
+	}
+	return decMode
+}()
+
+// RegisterMigration registers the `migrate` function, that upgrades the messages of the `Type` message-type
+// from the `fromVersion` version to the next one. The current version of the message-type becomes `fromVersion+1`.
+// The message-types start with the 0 initial version, and the migrations have to be registered in the order of the versions.
+func (r *Registry) RegisterMigration(Type string, fromVersion int, migrate MigrationFunc) {
+	r.update(Type, func(t *MessageTypeDescriptor) {
+		if fromVersion != t.Version {
+			panic(fmt.Sprintf("The migration of the '%s' message type has to start from the current %d version, instead of %d!", Type, t.Version, fromVersion))
+		}
+		t.Migrations = append(t.Migrations, migrate)
+		t.Version++
+	})
+}
+
+// GetMessageTypeVersion returns with the current version of the `Type` message-type.
+// It returns with 0, the initial version, if the message-type is not registered.
+func (r *Registry) GetMessageTypeVersion(Type string) int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.types[Type].Version
+}
+
+// IsUpgradable returns true if the messages can be upgraded to the current version of their message-type in the `representation` format.
+// The schema based representations can not be upgraded: the Avro messages of other versions are rejected by their schema fingerprint,
+// and the other schema based ones are decoded by the current schema.
+func IsUpgradable(representation Representation) bool {
+	switch representation {
+	case JSONRepresentation, MsgpackRepresentation, CBORRepresentation:
+		return true
+	}
+	return false
+}
+
+// Upgrade returns with the `content` of a `Type` message upgraded to the current version of the message-type.
+// The version of the message is taken from its header, the missing version means the initial version.
+// The `content` is returned as it is, if the message-type has only the initial version, if the message has the current version,
+// or if the `representation` is not upgradable.
+// It returns error if the message has a newer version than the current one, or a migration fails.
+func (r *Registry) Upgrade(Type string, representation Representation, content []byte) ([]byte, error) {
+	r.mu.RLock()
+	t := r.types[Type]
+	r.mu.RUnlock()
+	if t.Version == 0 || !IsUpgradable(representation) {
+		return content, nil
+	}
+
+	doc, err := unmarshalDocument(representation, content)
+	if err != nil {
+		return nil, err
+	}
+	header, _ := doc[headerKey].(map[string]interface{})
+	if header == nil {
+		header = map[string]interface{}{}
+		doc[headerKey] = header
+	}
+	version, err := documentVersion(header[versionKey])
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case version == t.Version:
+		return content, nil
+	case version > t.Version || version < 0:
+		return nil, fmt.Errorf("the '%s' message has the %d version, but the current version of the message type is %d", Type, version, t.Version)
+	}
+
+	for v := version; v < t.Version; v++ {
+		if err := t.Migrations[v](doc); err != nil {
+			return nil, fmt.Errorf("the migration of the '%s' message from the %d version failed: %w", Type, v, err)
+		}
+	}
+	if header, _ = doc[headerKey].(map[string]interface{}); header == nil {
+		return nil, fmt.Errorf("the migration of the '%s' message removed the header", Type)
+	}
+	header[versionKey] = t.Version
+	return marshalDocument(representation, doc)
+}
+
+// RegisterMigration registers the `migrate` function of the `Type` message-type into the default registry.
+// See `Registry.RegisterMigration` for details.
+func RegisterMigration(Type string, fromVersion int, migrate MigrationFunc) {
+	DefaultRegistry().RegisterMigration(Type, fromVersion, migrate)
+}
+
+// GetMessageTypeVersion returns with the current version of the `Type` message-type of the default registry
+func GetMessageTypeVersion(Type string) int {
+	return DefaultRegistry().GetMessageTypeVersion(Type)
+}
+
+// Upgrade returns with the `content` of a `Type` message upgraded to the current version of the message-type of the default registry.
+// See `Registry.Upgrade` for details.
+func Upgrade(Type string, representation Representation, content []byte) ([]byte, error) {
+	return DefaultRegistry().Upgrade(Type, representation, content)
+}
+
+// unmarshalDocument parses the `content` in the `representation` format into a document
+func unmarshalDocument(representation Representation, content []byte) (map[string]interface{}, error) {
+	doc := map[string]interface{}{}
+	var err error
+	switch representation {
+	case JSONRepresentation:
+		// The numbers are kept as they are, so the int64 timestamps do not lose precision
+		decoder := json.NewDecoder(bytes.NewReader(content))
+		decoder.UseNumber()
+		err = decoder.Decode(&doc)
+	case MsgpackRepresentation:
+		err = msgpack.Unmarshal(content, &doc)
+	case CBORRepresentation:
+		err = cborDecMode.Unmarshal(content, &doc)
+	}
+	return doc, err
+}
+
+// marshalDocument returns with the `doc` document in the `representation` format
+func marshalDocument(representation Representation, doc map[string]interface{}) ([]byte, error) {
+	switch representation {
+	case MsgpackRepresentation:
+		return msgpack.Marshal(doc)
+	case CBORRepresentation:
+		return cbor.Marshal(doc)
+	default:
+		return json.Marshal(doc)
+	}
+}
+
+// documentVersion returns with the integer value of the `version` field of the header
+func documentVersion(version interface{}) (int, error) {
+	switch v := version.(type) {
+	case nil:
+		return 0, nil
+	case json.Number:
+		i, err := v.Int64()
+		return int(i), err
+	case float64:
+		return int(v), nil
+	}
+
+	rv := reflect.ValueOf(version)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return int(rv.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int(rv.Uint()), nil
+	}
+	return 0, fmt.Errorf("invalid message version '%v'", version)
+}
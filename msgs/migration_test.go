@@ -0,0 +1,131 @@
+package msgs
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/vmihailenco/msgpack/v5"
+	"testing"
+)
+
+const versionedTypeName = "test/Versioned"
+
+// versionedV2 is the structure of the current version of the `test/Versioned` message-type
+type versionedV2 struct {
+	Header struct {
+		TimePrecision string
+		Timestamp     int64
+		Version       int
+	}
+	Body struct {
+		Data     float64
+		Variance float64
+	}
+}
+
+func init() {
+	RegisterMessageType(versionedTypeName, []Representation{JSONRepresentation, MsgpackRepresentation, CBORRepresentation}, nil)
+	// The 1. version renamed the `Value` field of the body to `Data`
+	RegisterMigration(versionedTypeName, 0, func(doc map[string]interface{}) error {
+		body := doc["Body"].(map[string]interface{})
+		body["Data"] = body["Value"]
+		delete(body, "Value")
+		return nil
+	})
+	// The 2. version added the `Variance` field to the body
+	RegisterMigration(versionedTypeName, 1, func(doc map[string]interface{}) error {
+		doc["Body"].(map[string]interface{})["Variance"] = 0.5
+		return nil
+	})
+}
+
+func TestRegisterMigration(t *testing.T) {
+	assert.Equal(t, 2, GetMessageTypeVersion(versionedTypeName))
+	assert.Equal(t, 0, GetMessageTypeVersion("test/Unknown"))
+
+	assert.Panics(t, func() { RegisterMigration(versionedTypeName, 0, nil) })
+	assert.Panics(t, func() { RegisterMigration("test/Unknown", 0, nil) })
+}
+
+func TestUpgradeJSON(t *testing.T) {
+	v0 := []byte(`{"Header":{"TimePrecision":"ns","Timestamp":1608732048980057025},"Body":{"Value":42}}`)
+	upgraded, err := Upgrade(versionedTypeName, JSONRepresentation, v0)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"Header":{"TimePrecision":"ns","Timestamp":1608732048980057025,"Version":2},"Body":{"Data":42,"Variance":0.5}}`, string(upgraded))
+
+	v1 := []byte(`{"Header":{"TimePrecision":"ns","Timestamp":1608732048980057025,"Version":1},"Body":{"Data":42}}`)
+	upgraded, err = Upgrade(versionedTypeName, JSONRepresentation, v1)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"Header":{"TimePrecision":"ns","Timestamp":1608732048980057025,"Version":2},"Body":{"Data":42,"Variance":0.5}}`, string(upgraded))
+
+	var decoded versionedV2
+	assert.Nil(t, json.Unmarshal(upgraded, &decoded))
+	assert.Equal(t, int64(1608732048980057025), decoded.Header.Timestamp)
+	assert.Equal(t, 2, decoded.Header.Version)
+
+	// The current version is returned as it is
+	upgraded, err = Upgrade(versionedTypeName, JSONRepresentation, upgraded)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"Header":{"TimePrecision":"ns","Timestamp":1608732048980057025,"Version":2},"Body":{"Data":42,"Variance":0.5}}`, string(upgraded))
+}
+
+func TestUpgradeBinary(t *testing.T) {
+	v0 := map[string]interface{}{
+		"Header": map[string]interface{}{"TimePrecision": "ns", "Timestamp": int64(1608732048980057025)},
+		"Body":   map[string]interface{}{"Value": 42.},
+	}
+
+	msgpackV0, err := msgpack.Marshal(v0)
+	assert.Nil(t, err)
+	upgraded, err := Upgrade(versionedTypeName, MsgpackRepresentation, msgpackV0)
+	assert.Nil(t, err)
+	var decoded versionedV2
+	assert.Nil(t, msgpack.Unmarshal(upgraded, &decoded))
+	assert.Equal(t, 2, decoded.Header.Version)
+	assert.Equal(t, int64(1608732048980057025), decoded.Header.Timestamp)
+	assert.Equal(t, 42., decoded.Body.Data)
+	assert.Equal(t, 0.5, decoded.Body.Variance)
+
+	cborV0, err := cbor.Marshal(v0)
+	assert.Nil(t, err)
+	upgraded, err = Upgrade(versionedTypeName, CBORRepresentation, cborV0)
+	assert.Nil(t, err)
+	decoded = versionedV2{}
+	assert.Nil(t, cbor.Unmarshal(upgraded, &decoded))
+	assert.Equal(t, 2, decoded.Header.Version)
+	assert.Equal(t, 42., decoded.Body.Data)
+	assert.Equal(t, 0.5, decoded.Body.Variance)
+}
+
+func TestUpgradeUnchanged(t *testing.T) {
+	content := []byte("some content")
+
+	// The schema based representations are not upgraded
+	upgraded, err := Upgrade(versionedTypeName, ProtobufRepresentation, content)
+	assert.Nil(t, err)
+	assert.Equal(t, content, upgraded)
+
+	// The message-types with the initial version only are not upgraded
+	upgraded, err = Upgrade("test/Unknown", JSONRepresentation, content)
+	assert.Nil(t, err)
+	assert.Equal(t, content, upgraded)
+}
+
+func TestUpgradeErrors(t *testing.T) {
+	_, err := Upgrade(versionedTypeName, JSONRepresentation, []byte(`{"Header":{"Version":3},"Body":{}}`))
+	assert.Equal(t, "the 'test/Versioned' message has the 3 version, but the current version of the message type is 2", err.Error())
+
+	_, err = Upgrade(versionedTypeName, JSONRepresentation, []byte(`{"Header":{"Version":"one"}}`))
+	assert.Equal(t, "invalid message version 'one'", err.Error())
+
+	_, err = Upgrade(versionedTypeName, JSONRepresentation, []byte(`not JSON`))
+	assert.NotNil(t, err)
+
+	RegisterMessageType("test/Failing", []Representation{JSONRepresentation}, nil)
+	RegisterMigration("test/Failing", 0, func(doc map[string]interface{}) error {
+		return errors.New("missing field")
+	})
+	_, err = Upgrade("test/Failing", JSONRepresentation, []byte(`{"Header":{},"Body":{}}`))
+	assert.Equal(t, "the migration of the 'test/Failing' message from the 0 version failed: missing field", err.Error())
+}
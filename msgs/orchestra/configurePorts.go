@@ -0,0 +1,295 @@
+package orchestra
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"github.com/fxamacker/cbor/v2"
+	"github.com/tombenke/axon-go-common/msgs"
+	"github.com/tombenke/axon-go-common/msgs/avro"
+	"github.com/tombenke/axon-go-common/msgs/common"
+	"github.com/vmihailenco/msgpack/v5"
+	"gopkg.in/yaml.v2"
+	"time"
+)
+
+const (
+	// ConfigurePortsTypeName is the printable name of the `ConfigurePorts` message-type
+	ConfigurePortsTypeName = "orchestra/ConfigurePorts"
+)
+
+func init() {
+	msgs.RegisterMessageType(ConfigurePortsTypeName, []msgs.Representation{msgs.JSONRepresentation, msgs.YAMLRepresentation, msgs.GobRepresentation, msgs.ProtobufRepresentation, msgs.XMLRepresentation, msgs.AvroRepresentation, msgs.MsgpackRepresentation, msgs.CBORRepresentation}, func() msgs.Message {
+		return NewConfigurePortsMessage(ConfigurePortsBody{})
+	})
+	msgs.SetMessageTypeDescription(ConfigurePortsTypeName, "Reconfiguration of the I/O ports of an actor node, sent by the orchestrator")
+}
+
+// ConfigurePorts represents the structure of the `configure-ports` message that is usually sent
+// by the orchestrator to a running actor node in order to reconfigure its I/O ports.
+// The node responds with its new `status-report` message.
+type ConfigurePorts struct {
+	Header common.Header
+	Body   ConfigurePortsBody
+}
+
+// ConfigurePortsBody holds the descriptors of the I/O ports to change or to add to the node.
+type ConfigurePortsBody struct {
+	// Inputs is a list of input port descriptors
+	Inputs []PortConfig
+
+	// Outputs is a list of output port descriptors
+	Outputs []PortConfig
+}
+
+// PortConfig describes the requested configuration of an I/O port.
+// The empty properties leave the current values of the port unchanged.
+type PortConfig struct {
+	// Name is the name of the port to change or to add
+	Name string
+
+	// Type is the message-type the port uses for transfer
+	Type string
+
+	// Representation is the message representation format used for transfer
+	Representation string
+
+	// Channel is the name of the messaging subject the port uses
+	Channel string
+
+	// Default is the default message of an input port in JSON format. The output ports ignore it.
+	Default string
+}
+
+// GetType returns with the printable name of the `ConfigurePorts` message-type
+func (msg *ConfigurePorts) GetType() string {
+	return ConfigurePortsTypeName
+}
+
+// Encode returns with the `ConfigurePorts` message content in a representation format selected by `representation`
+func (msg *ConfigurePorts) Encode(representation msgs.Representation) (results []byte) {
+	switch representation {
+	case msgs.JSONRepresentation:
+		var err error
+		results, err = json.Marshal(*msg)
+		if err != nil {
+			panic(err)
+		}
+	case msgs.YAMLRepresentation:
+		results = msg.YAML()
+	case msgs.GobRepresentation:
+		results = msg.EncodeGob()
+	case msgs.ProtobufRepresentation:
+		results = msg.Protobuf()
+	case msgs.XMLRepresentation:
+		results = msg.XML()
+	case msgs.AvroRepresentation:
+		results = msg.Avro()
+	case msgs.MsgpackRepresentation:
+		results = msg.Msgpack()
+	case msgs.CBORRepresentation:
+		results = msg.CBOR()
+	default:
+		panic(fmt.Errorf("Encode error: unknown representational format '%s'", representation))
+	}
+	return results
+}
+
+// Decode parses the `content` using the selected `representation` format.
+// The messages of older versions are upgraded to the current version of the message-type, if the `representation` is upgradable.
+func (msg *ConfigurePorts) Decode(representation msgs.Representation, content []byte) error {
+	content, err := msgs.Upgrade(ConfigurePortsTypeName, representation, content)
+	if err != nil {
+		return err
+	}
+
+	switch representation {
+	case msgs.JSONRepresentation:
+		return json.Unmarshal(content, msg)
+	case msgs.YAMLRepresentation:
+		return msg.ParseYAML(content)
+	case msgs.GobRepresentation:
+		return msg.DecodeGob(content)
+	case msgs.ProtobufRepresentation:
+		return msg.ParseProtobuf(content)
+	case msgs.XMLRepresentation:
+		return msg.ParseXML(content)
+	case msgs.AvroRepresentation:
+		return msg.ParseAvro(content)
+	case msgs.MsgpackRepresentation:
+		return msg.ParseMsgpack(content)
+	case msgs.CBORRepresentation:
+		return msg.ParseCBOR(content)
+	default:
+		panic(fmt.Errorf("Decode error: unknown representational format '%s'", representation))
+	}
+}
+
+// JSON returns with the `ConfigurePorts` message content in JSON representation format
+func (msg *ConfigurePorts) JSON() []byte {
+	jsonBytes, err := json.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return jsonBytes
+}
+
+// String returns with the `ConfigurePorts` message content in JSON format string
+func (msg *ConfigurePorts) String() string {
+	jsonBytes, err := json.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return string(jsonBytes)
+}
+
+// ParseJSON parses the JSON representation of a `ConfigurePorts` messages from the `jsonBytes` argument.
+func (msg *ConfigurePorts) ParseJSON(jsonBytes []byte) error {
+	return json.Unmarshal(jsonBytes, msg)
+}
+
+// Msgpack returns with the `ConfigurePorts` message content in MessagePack representation format
+func (msg *ConfigurePorts) Msgpack() []byte {
+	msgpackBytes, err := msgpack.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return msgpackBytes
+}
+
+// ParseMsgpack parses the MessagePack representation of a `ConfigurePorts` messages from the `msgpackBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *ConfigurePorts) ParseMsgpack(msgpackBytes []byte) error {
+	var decoded ConfigurePorts
+	if err := msgpack.Unmarshal(msgpackBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// CBOR returns with the `ConfigurePorts` message content in CBOR representation format
+func (msg *ConfigurePorts) CBOR() []byte {
+	cborBytes, err := cbor.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return cborBytes
+}
+
+// ParseCBOR parses the CBOR representation of a `ConfigurePorts` messages from the `cborBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *ConfigurePorts) ParseCBOR(cborBytes []byte) error {
+	var decoded ConfigurePorts
+	if err := cbor.Unmarshal(cborBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// Avro returns with the `ConfigurePorts` message content in Avro representation format
+func (msg *ConfigurePorts) Avro() []byte {
+	avroBytes, err := avro.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return avroBytes
+}
+
+// ParseAvro parses the Avro representation of a `ConfigurePorts` messages from the `avroBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *ConfigurePorts) ParseAvro(avroBytes []byte) error {
+	var decoded ConfigurePorts
+	if err := avro.Unmarshal(avroBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// XML returns with the `ConfigurePorts` message content in XML representation format
+func (msg *ConfigurePorts) XML() []byte {
+	xmlBytes, err := xml.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return xmlBytes
+}
+
+// ParseXML parses the XML representation of a `ConfigurePorts` messages from the `xmlBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *ConfigurePorts) ParseXML(xmlBytes []byte) error {
+	var decoded ConfigurePorts
+	if err := xml.Unmarshal(xmlBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// Protobuf returns with the `ConfigurePorts` message content in protobuf representation format
+func (msg *ConfigurePorts) Protobuf() []byte {
+	return common.MarshalProtobufMessage(msg.Header, msg.Body)
+}
+
+// ParseProtobuf parses the protobuf representation of a `ConfigurePorts` messages from the `protobufBytes` argument.
+// Protobuf omits the zero values, so the message is replaced as a whole by the decoded one.
+func (msg *ConfigurePorts) ParseProtobuf(protobufBytes []byte) error {
+	var decoded ConfigurePorts
+	if err := common.UnmarshalProtobufMessage(protobufBytes, &decoded.Header, &decoded.Body); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// YAML returns with the `ConfigurePorts` message content in YAML representation format
+func (msg *ConfigurePorts) YAML() []byte {
+	yamlBytes, err := yaml.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return yamlBytes
+}
+
+// ParseYAML parses the YAML representation of a `ConfigurePorts` messages from the `yamlBytes` argument.
+func (msg *ConfigurePorts) ParseYAML(yamlBytes []byte) error {
+	return yaml.Unmarshal(yamlBytes, msg)
+}
+
+// EncodeGob returns with the `ConfigurePorts` message content in Gob representation format
+func (msg *ConfigurePorts) EncodeGob() []byte {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(*msg); err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
+// DecodeGob parses the Gob representation of a `ConfigurePorts` messages from the `gobBytes` argument.
+// Gob omits the zero values, so the message is replaced as a whole by the decoded one.
+func (msg *ConfigurePorts) DecodeGob(gobBytes []byte) error {
+	var decoded ConfigurePorts
+	if err := gob.NewDecoder(bytes.NewReader(gobBytes)).Decode(&decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// NewConfigurePortsMessage returns with a new `ConfigurePorts` message. The header will contain the current time in `Nanoseconds` precision.
+func NewConfigurePortsMessage(body ConfigurePortsBody) msgs.Message {
+	return NewConfigurePortsMessageAt(body, time.Now().UnixNano(), "ns")
+}
+
+// NewConfigurePortsMessageAt returns with a new `ConfigurePorts` message. The header will contain the `at` time in `withPrecision` precision.
+func NewConfigurePortsMessageAt(body ConfigurePortsBody, at int64, withPrecision common.TimePrecision) msgs.Message {
+	var msg ConfigurePorts
+	msg.Header = common.NewHeaderAt(at, withPrecision)
+	msg.Header.Version = msgs.GetMessageTypeVersion(ConfigurePortsTypeName)
+	msg.Body = body
+	return &msg
+}
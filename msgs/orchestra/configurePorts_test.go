@@ -0,0 +1,137 @@
+package orchestra
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/tombenke/axon-go-common/msgs"
+	"github.com/tombenke/axon-go-common/msgs/common"
+	"testing"
+)
+
+var (
+	testConfigurePortsBody = ConfigurePortsBody{
+		Inputs:  []PortConfig{PortConfig{Name: "water-level", Channel: "new-water-level-ch", Default: `{"Body": {"Data": 0.5}}`}},
+		Outputs: []PortConfig{PortConfig{Name: "water-level-state", Channel: "new-water-level-state-ch"}},
+	}
+)
+
+func TestConfigurePortsGetType(t *testing.T) {
+	assert.Equal(t, NewConfigurePortsMessage(testConfigurePortsBody).GetType(), ConfigurePortsTypeName)
+}
+
+func TestConfigurePortsMessage(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewConfigurePortsMessageAt(testConfigurePortsBody, at, prec)
+	var n ConfigurePorts
+	err := n.ParseJSON(m.JSON())
+	assert.Nil(t, err)
+	err = n.ParseJSON([]byte(m.String()))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestConfigurePortsMessageCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewConfigurePortsMessageAt(testConfigurePortsBody, at, prec)
+	var n ConfigurePorts
+	err := n.Decode(msgs.JSONRepresentation, m.Encode(msgs.JSONRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestConfigurePortsMessageMsgpackCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewConfigurePortsMessageAt(testConfigurePortsBody, at, prec)
+	var n ConfigurePorts
+	err := n.Decode(msgs.MsgpackRepresentation, m.Encode(msgs.MsgpackRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestConfigurePortsMessageCBORCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewConfigurePortsMessageAt(testConfigurePortsBody, at, prec)
+	var n ConfigurePorts
+	err := n.Decode(msgs.CBORRepresentation, m.Encode(msgs.CBORRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestConfigurePortsMessageAvroCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewConfigurePortsMessageAt(testConfigurePortsBody, at, prec)
+	var n ConfigurePorts
+	err := n.Decode(msgs.AvroRepresentation, m.Encode(msgs.AvroRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestConfigurePortsMessageXMLCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewConfigurePortsMessageAt(testConfigurePortsBody, at, prec)
+	var n ConfigurePorts
+	err := n.Decode(msgs.XMLRepresentation, m.Encode(msgs.XMLRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestConfigurePortsMessageProtobufCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewConfigurePortsMessageAt(testConfigurePortsBody, at, prec)
+	var n ConfigurePorts
+	err := n.Decode(msgs.ProtobufRepresentation, m.Encode(msgs.ProtobufRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestConfigurePortsMessageYAMLCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewConfigurePortsMessageAt(testConfigurePortsBody, at, prec)
+	var n ConfigurePorts
+	err := n.Decode(msgs.YAMLRepresentation, m.Encode(msgs.YAMLRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestConfigurePortsMessageGobCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewConfigurePortsMessageAt(testConfigurePortsBody, at, prec)
+	var n ConfigurePorts
+	err := n.Decode(msgs.GobRepresentation, m.Encode(msgs.GobRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestConfigurePortsMessageCodecPanic(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewConfigurePortsMessageAt(testConfigurePortsBody, at, prec)
+	var n ConfigurePorts
+	func() {
+		defer func() {
+			if r := recover(); r != nil {
+				assert.Equal(t, r, errors.New("Decode error: unknown representational format 'wrong-representation'"))
+			}
+		}()
+		err := n.Decode(msgs.Representation("wrong-representation"), m.Encode(msgs.JSONRepresentation))
+		assert.Nil(t, err)
+	}()
+	func() {
+		defer func() {
+			if r := recover(); r != nil {
+				assert.Equal(t, r, errors.New("Encode error: unknown representational format 'wrong-representation'"))
+			}
+		}()
+		err := n.Decode(msgs.JSONRepresentation, m.Encode(msgs.Representation("wrong-representation")))
+		assert.Nil(t, err)
+	}()
+}
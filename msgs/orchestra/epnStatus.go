@@ -1,10 +1,17 @@
 package orchestra
 
 import (
+	"bytes"
+	"encoding/gob"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
+	"github.com/fxamacker/cbor/v2"
 	"github.com/tombenke/axon-go-common/msgs"
+	"github.com/tombenke/axon-go-common/msgs/avro"
 	"github.com/tombenke/axon-go-common/msgs/common"
+	"github.com/vmihailenco/msgpack/v5"
+	"gopkg.in/yaml.v2"
 	"time"
 )
 
@@ -14,9 +21,10 @@ const (
 )
 
 func init() {
-	msgs.RegisterMessageType(EPNStatusTypeName, []msgs.Representation{msgs.JSONRepresentation}, func() msgs.Message {
+	msgs.RegisterMessageType(EPNStatusTypeName, []msgs.Representation{msgs.JSONRepresentation, msgs.YAMLRepresentation, msgs.GobRepresentation, msgs.ProtobufRepresentation, msgs.XMLRepresentation, msgs.AvroRepresentation, msgs.MsgpackRepresentation, msgs.CBORRepresentation}, func() msgs.Message {
 		return NewEPNStatusMessage(EPNStatusBody{})
 	})
+	msgs.SetMessageTypeDescription(EPNStatusTypeName, "Status of the actors of the event processing network, collected by the orchestrator")
 }
 
 // EPNStatus represents the structure of the `status-report` message that the actor is usually sent
@@ -53,17 +61,51 @@ func (msg *EPNStatus) Encode(representation msgs.Representation) (results []byte
 		if err != nil {
 			panic(err)
 		}
+	case msgs.YAMLRepresentation:
+		results = msg.YAML()
+	case msgs.GobRepresentation:
+		results = msg.EncodeGob()
+	case msgs.ProtobufRepresentation:
+		results = msg.Protobuf()
+	case msgs.XMLRepresentation:
+		results = msg.XML()
+	case msgs.AvroRepresentation:
+		results = msg.Avro()
+	case msgs.MsgpackRepresentation:
+		results = msg.Msgpack()
+	case msgs.CBORRepresentation:
+		results = msg.CBOR()
 	default:
 		panic(fmt.Errorf("Encode error: unknown representational format '%s'", representation))
 	}
 	return results
 }
 
-// Decode parses the `content` using the selected `representation` format
+// Decode parses the `content` using the selected `representation` format.
+// The messages of older versions are upgraded to the current version of the message-type, if the `representation` is upgradable.
 func (msg *EPNStatus) Decode(representation msgs.Representation, content []byte) error {
+	content, err := msgs.Upgrade(EPNStatusTypeName, representation, content)
+	if err != nil {
+		return err
+	}
+
 	switch representation {
 	case msgs.JSONRepresentation:
 		return json.Unmarshal(content, msg)
+	case msgs.YAMLRepresentation:
+		return msg.ParseYAML(content)
+	case msgs.GobRepresentation:
+		return msg.DecodeGob(content)
+	case msgs.ProtobufRepresentation:
+		return msg.ParseProtobuf(content)
+	case msgs.XMLRepresentation:
+		return msg.ParseXML(content)
+	case msgs.AvroRepresentation:
+		return msg.ParseAvro(content)
+	case msgs.MsgpackRepresentation:
+		return msg.ParseMsgpack(content)
+	case msgs.CBORRepresentation:
+		return msg.ParseCBOR(content)
 	default:
 		panic(fmt.Errorf("Decode error: unknown representational format '%s'", representation))
 	}
@@ -92,6 +134,136 @@ func (msg *EPNStatus) ParseJSON(jsonBytes []byte) error {
 	return json.Unmarshal(jsonBytes, msg)
 }
 
+// Msgpack returns with the `EPNStatus` message content in MessagePack representation format
+func (msg *EPNStatus) Msgpack() []byte {
+	msgpackBytes, err := msgpack.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return msgpackBytes
+}
+
+// ParseMsgpack parses the MessagePack representation of a `EPNStatus` messages from the `msgpackBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *EPNStatus) ParseMsgpack(msgpackBytes []byte) error {
+	var decoded EPNStatus
+	if err := msgpack.Unmarshal(msgpackBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// CBOR returns with the `EPNStatus` message content in CBOR representation format
+func (msg *EPNStatus) CBOR() []byte {
+	cborBytes, err := cbor.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return cborBytes
+}
+
+// ParseCBOR parses the CBOR representation of a `EPNStatus` messages from the `cborBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *EPNStatus) ParseCBOR(cborBytes []byte) error {
+	var decoded EPNStatus
+	if err := cbor.Unmarshal(cborBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// Avro returns with the `EPNStatus` message content in Avro representation format
+func (msg *EPNStatus) Avro() []byte {
+	avroBytes, err := avro.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return avroBytes
+}
+
+// ParseAvro parses the Avro representation of a `EPNStatus` messages from the `avroBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *EPNStatus) ParseAvro(avroBytes []byte) error {
+	var decoded EPNStatus
+	if err := avro.Unmarshal(avroBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// XML returns with the `EPNStatus` message content in XML representation format
+func (msg *EPNStatus) XML() []byte {
+	xmlBytes, err := xml.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return xmlBytes
+}
+
+// ParseXML parses the XML representation of a `EPNStatus` messages from the `xmlBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *EPNStatus) ParseXML(xmlBytes []byte) error {
+	var decoded EPNStatus
+	if err := xml.Unmarshal(xmlBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// Protobuf returns with the `EPNStatus` message content in protobuf representation format
+func (msg *EPNStatus) Protobuf() []byte {
+	return common.MarshalProtobufMessage(msg.Header, msg.Body)
+}
+
+// ParseProtobuf parses the protobuf representation of a `EPNStatus` messages from the `protobufBytes` argument.
+// Protobuf omits the zero values, so the message is replaced as a whole by the decoded one.
+func (msg *EPNStatus) ParseProtobuf(protobufBytes []byte) error {
+	var decoded EPNStatus
+	if err := common.UnmarshalProtobufMessage(protobufBytes, &decoded.Header, &decoded.Body); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// YAML returns with the `EPNStatus` message content in YAML representation format
+func (msg *EPNStatus) YAML() []byte {
+	yamlBytes, err := yaml.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return yamlBytes
+}
+
+// ParseYAML parses the YAML representation of a `EPNStatus` messages from the `yamlBytes` argument.
+func (msg *EPNStatus) ParseYAML(yamlBytes []byte) error {
+	return yaml.Unmarshal(yamlBytes, msg)
+}
+
+// EncodeGob returns with the `EPNStatus` message content in Gob representation format
+func (msg *EPNStatus) EncodeGob() []byte {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(*msg); err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
+// DecodeGob parses the Gob representation of a `EPNStatus` messages from the `gobBytes` argument.
+// Gob omits the zero values, so the message is replaced as a whole by the decoded one.
+func (msg *EPNStatus) DecodeGob(gobBytes []byte) error {
+	var decoded EPNStatus
+	if err := gob.NewDecoder(bytes.NewReader(gobBytes)).Decode(&decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
 // NewEPNStatusMessage returns with a new `EPNStatus` message. The header will contain the current time in `Nanoseconds` precision.
 func NewEPNStatusMessage(body EPNStatusBody) msgs.Message {
 	return NewEPNStatusMessageAt(body, time.Now().UnixNano(), "ns")
@@ -101,6 +273,7 @@ func NewEPNStatusMessage(body EPNStatusBody) msgs.Message {
 func NewEPNStatusMessageAt(body EPNStatusBody, at int64, withPrecision common.TimePrecision) msgs.Message {
 	var msg EPNStatus
 	msg.Header = common.NewHeaderAt(at, withPrecision)
+	msg.Header.Version = msgs.GetMessageTypeVersion(EPNStatusTypeName)
 	msg.Body = body
 	return &msg
 }
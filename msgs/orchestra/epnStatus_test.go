@@ -6,6 +6,13 @@ import (
 	"github.com/tombenke/axon-go-common/msgs"
 	"github.com/tombenke/axon-go-common/msgs/common"
 	"testing"
+	"time"
+)
+
+var (
+	testEPNStatusBody = EPNStatusBody{
+		Actors: []Actor{Actor{Node: testStatusReportBody, ResponseTime: 42 * time.Millisecond}},
+	}
 )
 
 func TestEPNStatusGetType(t *testing.T) {
@@ -34,6 +41,76 @@ func TestEPNStatusMessageCodec(t *testing.T) {
 	assert.Equal(t, m, &n)
 }
 
+func TestEPNStatusMessageMsgpackCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewEPNStatusMessageAt(EPNStatusBody{}, at, prec)
+	var n EPNStatus
+	err := n.Decode(msgs.MsgpackRepresentation, m.Encode(msgs.MsgpackRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestEPNStatusMessageCBORCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewEPNStatusMessageAt(EPNStatusBody{}, at, prec)
+	var n EPNStatus
+	err := n.Decode(msgs.CBORRepresentation, m.Encode(msgs.CBORRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestEPNStatusMessageAvroCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewEPNStatusMessageAt(EPNStatusBody{}, at, prec)
+	var n EPNStatus
+	err := n.Decode(msgs.AvroRepresentation, m.Encode(msgs.AvroRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestEPNStatusMessageXMLCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewEPNStatusMessageAt(EPNStatusBody{}, at, prec)
+	var n EPNStatus
+	err := n.Decode(msgs.XMLRepresentation, m.Encode(msgs.XMLRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestEPNStatusMessageProtobufCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewEPNStatusMessageAt(EPNStatusBody{}, at, prec)
+	var n EPNStatus
+	err := n.Decode(msgs.ProtobufRepresentation, m.Encode(msgs.ProtobufRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestEPNStatusMessageYAMLCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewEPNStatusMessageAt(testEPNStatusBody, at, prec)
+	var n EPNStatus
+	err := n.Decode(msgs.YAMLRepresentation, m.Encode(msgs.YAMLRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestEPNStatusMessageGobCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewEPNStatusMessageAt(testEPNStatusBody, at, prec)
+	var n EPNStatus
+	err := n.Decode(msgs.GobRepresentation, m.Encode(msgs.GobRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
 func TestEPNStatusMessageCodecPanic(t *testing.T) {
 	at := int64(1608732048980057025)
 	prec := common.TimePrecision("ns")
@@ -1,10 +1,17 @@
 package orchestra
 
 import (
+	"bytes"
+	"encoding/gob"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
+	"github.com/fxamacker/cbor/v2"
 	"github.com/tombenke/axon-go-common/msgs"
+	"github.com/tombenke/axon-go-common/msgs/avro"
 	"github.com/tombenke/axon-go-common/msgs/common"
+	"github.com/vmihailenco/msgpack/v5"
+	"gopkg.in/yaml.v2"
 	"time"
 )
 
@@ -14,9 +21,10 @@ const (
 )
 
 func init() {
-	msgs.RegisterMessageType(ProcessingCompletedTypeName, []msgs.Representation{msgs.JSONRepresentation}, func() msgs.Message {
+	msgs.RegisterMessageType(ProcessingCompletedTypeName, []msgs.Representation{msgs.JSONRepresentation, msgs.YAMLRepresentation, msgs.GobRepresentation, msgs.ProtobufRepresentation, msgs.XMLRepresentation, msgs.AvroRepresentation, msgs.MsgpackRepresentation, msgs.CBORRepresentation}, func() msgs.Message {
 		return NewProcessingCompletedMessage("")
 	})
+	msgs.SetMessageTypeDescription(ProcessingCompletedTypeName, "Notification of an actor that completed the processing")
 }
 
 // ProcessingCompleted represents the structure of the `processing-completed` status message
@@ -41,17 +49,51 @@ func (msg *ProcessingCompleted) Encode(representation msgs.Representation) (resu
 		if err != nil {
 			panic(err)
 		}
+	case msgs.YAMLRepresentation:
+		results = msg.YAML()
+	case msgs.GobRepresentation:
+		results = msg.EncodeGob()
+	case msgs.ProtobufRepresentation:
+		results = msg.Protobuf()
+	case msgs.XMLRepresentation:
+		results = msg.XML()
+	case msgs.AvroRepresentation:
+		results = msg.Avro()
+	case msgs.MsgpackRepresentation:
+		results = msg.Msgpack()
+	case msgs.CBORRepresentation:
+		results = msg.CBOR()
 	default:
 		panic(fmt.Errorf("Encode error: unknown representational format '%s'", representation))
 	}
 	return results
 }
 
-// Decode parses the `content` using the selected `representation` format
+// Decode parses the `content` using the selected `representation` format.
+// The messages of older versions are upgraded to the current version of the message-type, if the `representation` is upgradable.
 func (msg *ProcessingCompleted) Decode(representation msgs.Representation, content []byte) error {
+	content, err := msgs.Upgrade(ProcessingCompletedTypeName, representation, content)
+	if err != nil {
+		return err
+	}
+
 	switch representation {
 	case msgs.JSONRepresentation:
 		return json.Unmarshal(content, msg)
+	case msgs.YAMLRepresentation:
+		return msg.ParseYAML(content)
+	case msgs.GobRepresentation:
+		return msg.DecodeGob(content)
+	case msgs.ProtobufRepresentation:
+		return msg.ParseProtobuf(content)
+	case msgs.XMLRepresentation:
+		return msg.ParseXML(content)
+	case msgs.AvroRepresentation:
+		return msg.ParseAvro(content)
+	case msgs.MsgpackRepresentation:
+		return msg.ParseMsgpack(content)
+	case msgs.CBORRepresentation:
+		return msg.ParseCBOR(content)
 	default:
 		panic(fmt.Errorf("Decode error: unknown representational format '%s'", representation))
 	}
@@ -80,6 +122,136 @@ func (msg *ProcessingCompleted) ParseJSON(jsonBytes []byte) error {
 	return json.Unmarshal(jsonBytes, msg)
 }
 
+// Msgpack returns with the `ProcessingCompleted` message content in MessagePack representation format
+func (msg *ProcessingCompleted) Msgpack() []byte {
+	msgpackBytes, err := msgpack.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return msgpackBytes
+}
+
+// ParseMsgpack parses the MessagePack representation of a `ProcessingCompleted` messages from the `msgpackBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *ProcessingCompleted) ParseMsgpack(msgpackBytes []byte) error {
+	var decoded ProcessingCompleted
+	if err := msgpack.Unmarshal(msgpackBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// CBOR returns with the `ProcessingCompleted` message content in CBOR representation format
+func (msg *ProcessingCompleted) CBOR() []byte {
+	cborBytes, err := cbor.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return cborBytes
+}
+
+// ParseCBOR parses the CBOR representation of a `ProcessingCompleted` messages from the `cborBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *ProcessingCompleted) ParseCBOR(cborBytes []byte) error {
+	var decoded ProcessingCompleted
+	if err := cbor.Unmarshal(cborBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// Avro returns with the `ProcessingCompleted` message content in Avro representation format
+func (msg *ProcessingCompleted) Avro() []byte {
+	avroBytes, err := avro.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return avroBytes
+}
+
+// ParseAvro parses the Avro representation of a `ProcessingCompleted` messages from the `avroBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *ProcessingCompleted) ParseAvro(avroBytes []byte) error {
+	var decoded ProcessingCompleted
+	if err := avro.Unmarshal(avroBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// XML returns with the `ProcessingCompleted` message content in XML representation format
+func (msg *ProcessingCompleted) XML() []byte {
+	xmlBytes, err := xml.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return xmlBytes
+}
+
+// ParseXML parses the XML representation of a `ProcessingCompleted` messages from the `xmlBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *ProcessingCompleted) ParseXML(xmlBytes []byte) error {
+	var decoded ProcessingCompleted
+	if err := xml.Unmarshal(xmlBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// Protobuf returns with the `ProcessingCompleted` message content in protobuf representation format
+func (msg *ProcessingCompleted) Protobuf() []byte {
+	return common.MarshalProtobufMessage(msg.Header, msg.Body)
+}
+
+// ParseProtobuf parses the protobuf representation of a `ProcessingCompleted` messages from the `protobufBytes` argument.
+// Protobuf omits the zero values, so the message is replaced as a whole by the decoded one.
+func (msg *ProcessingCompleted) ParseProtobuf(protobufBytes []byte) error {
+	var decoded ProcessingCompleted
+	if err := common.UnmarshalProtobufMessage(protobufBytes, &decoded.Header, &decoded.Body); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// YAML returns with the `ProcessingCompleted` message content in YAML representation format
+func (msg *ProcessingCompleted) YAML() []byte {
+	yamlBytes, err := yaml.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return yamlBytes
+}
+
+// ParseYAML parses the YAML representation of a `ProcessingCompleted` messages from the `yamlBytes` argument.
+func (msg *ProcessingCompleted) ParseYAML(yamlBytes []byte) error {
+	return yaml.Unmarshal(yamlBytes, msg)
+}
+
+// EncodeGob returns with the `ProcessingCompleted` message content in Gob representation format
+func (msg *ProcessingCompleted) EncodeGob() []byte {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(*msg); err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
+// DecodeGob parses the Gob representation of a `ProcessingCompleted` messages from the `gobBytes` argument.
+// Gob omits the zero values, so the message is replaced as a whole by the decoded one.
+func (msg *ProcessingCompleted) DecodeGob(gobBytes []byte) error {
+	var decoded ProcessingCompleted
+	if err := gob.NewDecoder(bytes.NewReader(gobBytes)).Decode(&decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
 // NewProcessingCompletedMessage returns with a new `ProcessingCompleted` message. The header will contain the current time in `Nanoseconds` precision.
 func NewProcessingCompletedMessage(data string) msgs.Message {
 	return NewProcessingCompletedMessageAt(data, time.Now().UnixNano(), "ns")
@@ -89,6 +261,7 @@ func NewProcessingCompletedMessage(data string) msgs.Message {
 func NewProcessingCompletedMessageAt(data string, at int64, withPrecision common.TimePrecision) msgs.Message {
 	var msg ProcessingCompleted
 	msg.Header = common.NewHeaderAt(at, withPrecision)
+	msg.Header.Version = msgs.GetMessageTypeVersion(ProcessingCompletedTypeName)
 	msg.Body.Data = data
 	return &msg
 }
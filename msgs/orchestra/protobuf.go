@@ -0,0 +1,263 @@
+package orchestra
+
+import (
+	"github.com/tombenke/axon-go-common/msgs/common"
+	"time"
+)
+
+// This file implements the protobuf encoding of the body parts of the orchestra messages,
+// according to the definitions of the `orchestra.proto` file.
+
+// AppendProtobuf appends the protobuf encoded fields of the body to `b`
+func (body StatusReportBody) AppendProtobuf(b []byte) []byte {
+	b = common.AppendProtobufString(b, 1, body.Name)
+	b = common.AppendProtobufString(b, 2, body.Type)
+	b = common.AppendProtobufMessage(b, 3, body.Ports)
+	b = common.AppendProtobufBool(b, 4, body.Synchronization)
+	b = common.AppendProtobufString(b, 5, body.SpecsURL)
+	return b
+}
+
+// ParseProtobuf parses the protobuf encoded body from `b`
+func (body *StatusReportBody) ParseProtobuf(b []byte) error {
+	fields, err := common.ParseProtobufFields(b)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range fields {
+		switch f.Num {
+		case 1:
+			body.Name = f.String()
+		case 2:
+			body.Type = f.String()
+		case 3:
+			err = body.Ports.ParseProtobuf(f.Bytes)
+		case 4:
+			body.Synchronization = f.Bool()
+		case 5:
+			body.SpecsURL = f.String()
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AppendProtobuf appends the protobuf encoded fields of the ports to `b`
+func (ports Ports) AppendProtobuf(b []byte) []byte {
+	for _, in := range ports.Inputs {
+		b = common.AppendProtobufMessage(b, 1, in)
+	}
+	for _, out := range ports.Outputs {
+		b = common.AppendProtobufMessage(b, 2, out)
+	}
+	return b
+}
+
+// ParseProtobuf parses the protobuf encoded ports from `b`
+func (ports *Ports) ParseProtobuf(b []byte) error {
+	fields, err := common.ParseProtobufFields(b)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range fields {
+		var port Port
+		switch f.Num {
+		case 1:
+			err = port.ParseProtobuf(f.Bytes)
+			ports.Inputs = append(ports.Inputs, port)
+		case 2:
+			err = port.ParseProtobuf(f.Bytes)
+			ports.Outputs = append(ports.Outputs, port)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AppendProtobuf appends the protobuf encoded fields of the port to `b`
+func (port Port) AppendProtobuf(b []byte) []byte {
+	b = common.AppendProtobufString(b, 1, port.Name)
+	b = common.AppendProtobufString(b, 2, port.Type)
+	b = common.AppendProtobufString(b, 3, port.Representation)
+	b = common.AppendProtobufMessage(b, 4, port.Channel)
+	return b
+}
+
+// ParseProtobuf parses the protobuf encoded port from `b`
+func (port *Port) ParseProtobuf(b []byte) error {
+	fields, err := common.ParseProtobufFields(b)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range fields {
+		switch f.Num {
+		case 1:
+			port.Name = f.String()
+		case 2:
+			port.Type = f.String()
+		case 3:
+			port.Representation = f.String()
+		case 4:
+			if err := port.Channel.ParseProtobuf(f.Bytes); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// AppendProtobuf appends the protobuf encoded fields of the channel to `b`
+func (channel Channel) AppendProtobuf(b []byte) []byte {
+	b = common.AppendProtobufString(b, 1, channel.Name)
+	b = common.AppendProtobufString(b, 2, channel.Type)
+	return b
+}
+
+// ParseProtobuf parses the protobuf encoded channel from `b`
+func (channel *Channel) ParseProtobuf(b []byte) error {
+	fields, err := common.ParseProtobufFields(b)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range fields {
+		switch f.Num {
+		case 1:
+			channel.Name = f.String()
+		case 2:
+			channel.Type = f.String()
+		}
+	}
+	return nil
+}
+
+// AppendProtobuf appends the protobuf encoded fields of the body to `b`
+func (body EPNStatusBody) AppendProtobuf(b []byte) []byte {
+	for _, actor := range body.Actors {
+		b = common.AppendProtobufMessage(b, 1, actor)
+	}
+	return b
+}
+
+// ParseProtobuf parses the protobuf encoded body from `b`
+func (body *EPNStatusBody) ParseProtobuf(b []byte) error {
+	fields, err := common.ParseProtobufFields(b)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range fields {
+		if f.Num == 1 {
+			var actor Actor
+			if err := actor.ParseProtobuf(f.Bytes); err != nil {
+				return err
+			}
+			body.Actors = append(body.Actors, actor)
+		}
+	}
+	return nil
+}
+
+// AppendProtobuf appends the protobuf encoded fields of the actor to `b`.
+// The response time is encoded in nanoseconds.
+func (actor Actor) AppendProtobuf(b []byte) []byte {
+	b = common.AppendProtobufMessage(b, 1, actor.Node)
+	b = common.AppendProtobufInt64(b, 2, int64(actor.ResponseTime))
+	return b
+}
+
+// ParseProtobuf parses the protobuf encoded actor from `b`
+func (actor *Actor) ParseProtobuf(b []byte) error {
+	fields, err := common.ParseProtobufFields(b)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range fields {
+		switch f.Num {
+		case 1:
+			if err := actor.Node.ParseProtobuf(f.Bytes); err != nil {
+				return err
+			}
+		case 2:
+			actor.ResponseTime = time.Duration(f.Int64())
+		}
+	}
+	return nil
+}
+
+// AppendProtobuf appends the protobuf encoded fields of the body to `b`
+func (body ConfigurePortsBody) AppendProtobuf(b []byte) []byte {
+	for _, in := range body.Inputs {
+		b = common.AppendProtobufMessage(b, 1, in)
+	}
+	for _, out := range body.Outputs {
+		b = common.AppendProtobufMessage(b, 2, out)
+	}
+	return b
+}
+
+// ParseProtobuf parses the protobuf encoded body from `b`
+func (body *ConfigurePortsBody) ParseProtobuf(b []byte) error {
+	fields, err := common.ParseProtobufFields(b)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range fields {
+		var port PortConfig
+		switch f.Num {
+		case 1:
+			err = port.ParseProtobuf(f.Bytes)
+			body.Inputs = append(body.Inputs, port)
+		case 2:
+			err = port.ParseProtobuf(f.Bytes)
+			body.Outputs = append(body.Outputs, port)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AppendProtobuf appends the protobuf encoded fields of the port configuration to `b`
+func (port PortConfig) AppendProtobuf(b []byte) []byte {
+	b = common.AppendProtobufString(b, 1, port.Name)
+	b = common.AppendProtobufString(b, 2, port.Type)
+	b = common.AppendProtobufString(b, 3, port.Representation)
+	b = common.AppendProtobufString(b, 4, port.Channel)
+	b = common.AppendProtobufString(b, 5, port.Default)
+	return b
+}
+
+// ParseProtobuf parses the protobuf encoded port configuration from `b`
+func (port *PortConfig) ParseProtobuf(b []byte) error {
+	fields, err := common.ParseProtobufFields(b)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range fields {
+		switch f.Num {
+		case 1:
+			port.Name = f.String()
+		case 2:
+			port.Type = f.String()
+		case 3:
+			port.Representation = f.String()
+		case 4:
+			port.Channel = f.String()
+		case 5:
+			port.Default = f.String()
+		}
+	}
+	return nil
+}
@@ -1,10 +1,17 @@
 package orchestra
 
 import (
+	"bytes"
+	"encoding/gob"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
+	"github.com/fxamacker/cbor/v2"
 	"github.com/tombenke/axon-go-common/msgs"
+	"github.com/tombenke/axon-go-common/msgs/avro"
 	"github.com/tombenke/axon-go-common/msgs/common"
+	"github.com/vmihailenco/msgpack/v5"
+	"gopkg.in/yaml.v2"
 	"time"
 )
 
@@ -14,9 +21,10 @@ const (
 )
 
 func init() {
-	msgs.RegisterMessageType(ReceiveAndProcessTypeName, []msgs.Representation{msgs.JSONRepresentation}, func() msgs.Message {
+	msgs.RegisterMessageType(ReceiveAndProcessTypeName, []msgs.Representation{msgs.JSONRepresentation, msgs.YAMLRepresentation, msgs.GobRepresentation, msgs.ProtobufRepresentation, msgs.XMLRepresentation, msgs.AvroRepresentation, msgs.MsgpackRepresentation, msgs.CBORRepresentation}, func() msgs.Message {
 		return NewReceiveAndProcessMessage(float64(0))
 	})
+	msgs.SetMessageTypeDescription(ReceiveAndProcessTypeName, "Trigger of the orchestrator to receive the inputs and start the processing")
 }
 
 // ReceiveAndProcess represents the structure of the messages emitted by the orchestrator,
@@ -41,17 +49,51 @@ func (msg *ReceiveAndProcess) Encode(representation msgs.Representation) (result
 		if err != nil {
 			panic(err)
 		}
+	case msgs.YAMLRepresentation:
+		results = msg.YAML()
+	case msgs.GobRepresentation:
+		results = msg.EncodeGob()
+	case msgs.ProtobufRepresentation:
+		results = msg.Protobuf()
+	case msgs.XMLRepresentation:
+		results = msg.XML()
+	case msgs.AvroRepresentation:
+		results = msg.Avro()
+	case msgs.MsgpackRepresentation:
+		results = msg.Msgpack()
+	case msgs.CBORRepresentation:
+		results = msg.CBOR()
 	default:
 		panic(fmt.Errorf("Encode error: unknown representational format '%s'", representation))
 	}
 	return results
 }
 
-// Decode parses the `content` using the selected `representation` format
+// Decode parses the `content` using the selected `representation` format.
+// The messages of older versions are upgraded to the current version of the message-type, if the `representation` is upgradable.
 func (msg *ReceiveAndProcess) Decode(representation msgs.Representation, content []byte) error {
+	content, err := msgs.Upgrade(ReceiveAndProcessTypeName, representation, content)
+	if err != nil {
+		return err
+	}
+
 	switch representation {
 	case msgs.JSONRepresentation:
 		return json.Unmarshal(content, msg)
+	case msgs.YAMLRepresentation:
+		return msg.ParseYAML(content)
+	case msgs.GobRepresentation:
+		return msg.DecodeGob(content)
+	case msgs.ProtobufRepresentation:
+		return msg.ParseProtobuf(content)
+	case msgs.XMLRepresentation:
+		return msg.ParseXML(content)
+	case msgs.AvroRepresentation:
+		return msg.ParseAvro(content)
+	case msgs.MsgpackRepresentation:
+		return msg.ParseMsgpack(content)
+	case msgs.CBORRepresentation:
+		return msg.ParseCBOR(content)
 	default:
 		panic(fmt.Errorf("Decode error: unknown representational format '%s'", representation))
 	}
@@ -80,6 +122,136 @@ func (msg *ReceiveAndProcess) ParseJSON(jsonBytes []byte) error {
 	return json.Unmarshal(jsonBytes, msg)
 }
 
+// Msgpack returns with the `ReceiveAndProcess` message content in MessagePack representation format
+func (msg *ReceiveAndProcess) Msgpack() []byte {
+	msgpackBytes, err := msgpack.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return msgpackBytes
+}
+
+// ParseMsgpack parses the MessagePack representation of a `ReceiveAndProcess` messages from the `msgpackBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *ReceiveAndProcess) ParseMsgpack(msgpackBytes []byte) error {
+	var decoded ReceiveAndProcess
+	if err := msgpack.Unmarshal(msgpackBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// CBOR returns with the `ReceiveAndProcess` message content in CBOR representation format
+func (msg *ReceiveAndProcess) CBOR() []byte {
+	cborBytes, err := cbor.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return cborBytes
+}
+
+// ParseCBOR parses the CBOR representation of a `ReceiveAndProcess` messages from the `cborBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *ReceiveAndProcess) ParseCBOR(cborBytes []byte) error {
+	var decoded ReceiveAndProcess
+	if err := cbor.Unmarshal(cborBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// Avro returns with the `ReceiveAndProcess` message content in Avro representation format
+func (msg *ReceiveAndProcess) Avro() []byte {
+	avroBytes, err := avro.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return avroBytes
+}
+
+// ParseAvro parses the Avro representation of a `ReceiveAndProcess` messages from the `avroBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *ReceiveAndProcess) ParseAvro(avroBytes []byte) error {
+	var decoded ReceiveAndProcess
+	if err := avro.Unmarshal(avroBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// XML returns with the `ReceiveAndProcess` message content in XML representation format
+func (msg *ReceiveAndProcess) XML() []byte {
+	xmlBytes, err := xml.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return xmlBytes
+}
+
+// ParseXML parses the XML representation of a `ReceiveAndProcess` messages from the `xmlBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *ReceiveAndProcess) ParseXML(xmlBytes []byte) error {
+	var decoded ReceiveAndProcess
+	if err := xml.Unmarshal(xmlBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// Protobuf returns with the `ReceiveAndProcess` message content in protobuf representation format
+func (msg *ReceiveAndProcess) Protobuf() []byte {
+	return common.MarshalProtobufMessage(msg.Header, msg.Body)
+}
+
+// ParseProtobuf parses the protobuf representation of a `ReceiveAndProcess` messages from the `protobufBytes` argument.
+// Protobuf omits the zero values, so the message is replaced as a whole by the decoded one.
+func (msg *ReceiveAndProcess) ParseProtobuf(protobufBytes []byte) error {
+	var decoded ReceiveAndProcess
+	if err := common.UnmarshalProtobufMessage(protobufBytes, &decoded.Header, &decoded.Body); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// YAML returns with the `ReceiveAndProcess` message content in YAML representation format
+func (msg *ReceiveAndProcess) YAML() []byte {
+	yamlBytes, err := yaml.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return yamlBytes
+}
+
+// ParseYAML parses the YAML representation of a `ReceiveAndProcess` messages from the `yamlBytes` argument.
+func (msg *ReceiveAndProcess) ParseYAML(yamlBytes []byte) error {
+	return yaml.Unmarshal(yamlBytes, msg)
+}
+
+// EncodeGob returns with the `ReceiveAndProcess` message content in Gob representation format
+func (msg *ReceiveAndProcess) EncodeGob() []byte {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(*msg); err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
+// DecodeGob parses the Gob representation of a `ReceiveAndProcess` messages from the `gobBytes` argument.
+// Gob omits the zero values, so the message is replaced as a whole by the decoded one.
+func (msg *ReceiveAndProcess) DecodeGob(gobBytes []byte) error {
+	var decoded ReceiveAndProcess
+	if err := gob.NewDecoder(bytes.NewReader(gobBytes)).Decode(&decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
 // NewReceiveAndProcessMessage returns with a new `ReceiveAndProcess` message. The header will contain the current time in `Nanoseconds` precision.
 func NewReceiveAndProcessMessage(data float64) msgs.Message {
 	return NewReceiveAndProcessMessageAt(data, time.Now().UnixNano(), "ns")
@@ -89,6 +261,7 @@ func NewReceiveAndProcessMessage(data float64) msgs.Message {
 func NewReceiveAndProcessMessageAt(data float64, at int64, withPrecision common.TimePrecision) msgs.Message {
 	var msg ReceiveAndProcess
 	msg.Header = common.NewHeaderAt(at, withPrecision)
+	msg.Header.Version = msgs.GetMessageTypeVersion(ReceiveAndProcessTypeName)
 	msg.Body.Data = data
 	return &msg
 }
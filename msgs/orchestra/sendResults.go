@@ -1,10 +1,17 @@
 package orchestra
 
 import (
+	"bytes"
+	"encoding/gob"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
+	"github.com/fxamacker/cbor/v2"
 	"github.com/tombenke/axon-go-common/msgs"
+	"github.com/tombenke/axon-go-common/msgs/avro"
 	"github.com/tombenke/axon-go-common/msgs/common"
+	"github.com/vmihailenco/msgpack/v5"
+	"gopkg.in/yaml.v2"
 	"time"
 )
 
@@ -14,9 +21,10 @@ const (
 )
 
 func init() {
-	msgs.RegisterMessageType(SendResultsTypeName, []msgs.Representation{msgs.JSONRepresentation}, func() msgs.Message {
+	msgs.RegisterMessageType(SendResultsTypeName, []msgs.Representation{msgs.JSONRepresentation, msgs.YAMLRepresentation, msgs.GobRepresentation, msgs.ProtobufRepresentation, msgs.XMLRepresentation, msgs.AvroRepresentation, msgs.MsgpackRepresentation, msgs.CBORRepresentation}, func() msgs.Message {
 		return NewSendResultsMessage()
 	})
+	msgs.SetMessageTypeDescription(SendResultsTypeName, "Trigger of the orchestrator to send the results of the processing")
 }
 
 // SendResults represents the structure of the `send-results` message that is usually sent
@@ -40,17 +48,51 @@ func (msg *SendResults) Encode(representation msgs.Representation) (results []by
 		if err != nil {
 			panic(err)
 		}
+	case msgs.YAMLRepresentation:
+		results = msg.YAML()
+	case msgs.GobRepresentation:
+		results = msg.EncodeGob()
+	case msgs.ProtobufRepresentation:
+		results = msg.Protobuf()
+	case msgs.XMLRepresentation:
+		results = msg.XML()
+	case msgs.AvroRepresentation:
+		results = msg.Avro()
+	case msgs.MsgpackRepresentation:
+		results = msg.Msgpack()
+	case msgs.CBORRepresentation:
+		results = msg.CBOR()
 	default:
 		panic(fmt.Errorf("Encode error: unknown representational format '%s'", representation))
 	}
 	return results
 }
 
-// Decode parses the `content` using the selected `representation` format
+// Decode parses the `content` using the selected `representation` format.
+// The messages of older versions are upgraded to the current version of the message-type, if the `representation` is upgradable.
 func (msg *SendResults) Decode(representation msgs.Representation, content []byte) error {
+	content, err := msgs.Upgrade(SendResultsTypeName, representation, content)
+	if err != nil {
+		return err
+	}
+
 	switch representation {
 	case msgs.JSONRepresentation:
 		return json.Unmarshal(content, msg)
+	case msgs.YAMLRepresentation:
+		return msg.ParseYAML(content)
+	case msgs.GobRepresentation:
+		return msg.DecodeGob(content)
+	case msgs.ProtobufRepresentation:
+		return msg.ParseProtobuf(content)
+	case msgs.XMLRepresentation:
+		return msg.ParseXML(content)
+	case msgs.AvroRepresentation:
+		return msg.ParseAvro(content)
+	case msgs.MsgpackRepresentation:
+		return msg.ParseMsgpack(content)
+	case msgs.CBORRepresentation:
+		return msg.ParseCBOR(content)
 	default:
 		panic(fmt.Errorf("Decode error: unknown representational format '%s'", representation))
 	}
@@ -79,6 +121,136 @@ func (msg *SendResults) ParseJSON(jsonBytes []byte) error {
 	return json.Unmarshal(jsonBytes, msg)
 }
 
+// Msgpack returns with the `SendResults` message content in MessagePack representation format
+func (msg *SendResults) Msgpack() []byte {
+	msgpackBytes, err := msgpack.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return msgpackBytes
+}
+
+// ParseMsgpack parses the MessagePack representation of a `SendResults` messages from the `msgpackBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *SendResults) ParseMsgpack(msgpackBytes []byte) error {
+	var decoded SendResults
+	if err := msgpack.Unmarshal(msgpackBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// CBOR returns with the `SendResults` message content in CBOR representation format
+func (msg *SendResults) CBOR() []byte {
+	cborBytes, err := cbor.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return cborBytes
+}
+
+// ParseCBOR parses the CBOR representation of a `SendResults` messages from the `cborBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *SendResults) ParseCBOR(cborBytes []byte) error {
+	var decoded SendResults
+	if err := cbor.Unmarshal(cborBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// Avro returns with the `SendResults` message content in Avro representation format
+func (msg *SendResults) Avro() []byte {
+	avroBytes, err := avro.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return avroBytes
+}
+
+// ParseAvro parses the Avro representation of a `SendResults` messages from the `avroBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *SendResults) ParseAvro(avroBytes []byte) error {
+	var decoded SendResults
+	if err := avro.Unmarshal(avroBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// XML returns with the `SendResults` message content in XML representation format
+func (msg *SendResults) XML() []byte {
+	xmlBytes, err := xml.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return xmlBytes
+}
+
+// ParseXML parses the XML representation of a `SendResults` messages from the `xmlBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *SendResults) ParseXML(xmlBytes []byte) error {
+	var decoded SendResults
+	if err := xml.Unmarshal(xmlBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// Protobuf returns with the `SendResults` message content in protobuf representation format
+func (msg *SendResults) Protobuf() []byte {
+	return common.MarshalProtobufMessage(msg.Header, msg.Body)
+}
+
+// ParseProtobuf parses the protobuf representation of a `SendResults` messages from the `protobufBytes` argument.
+// Protobuf omits the zero values, so the message is replaced as a whole by the decoded one.
+func (msg *SendResults) ParseProtobuf(protobufBytes []byte) error {
+	var decoded SendResults
+	if err := common.UnmarshalProtobufMessage(protobufBytes, &decoded.Header, &decoded.Body); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// YAML returns with the `SendResults` message content in YAML representation format
+func (msg *SendResults) YAML() []byte {
+	yamlBytes, err := yaml.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return yamlBytes
+}
+
+// ParseYAML parses the YAML representation of a `SendResults` messages from the `yamlBytes` argument.
+func (msg *SendResults) ParseYAML(yamlBytes []byte) error {
+	return yaml.Unmarshal(yamlBytes, msg)
+}
+
+// EncodeGob returns with the `SendResults` message content in Gob representation format
+func (msg *SendResults) EncodeGob() []byte {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(*msg); err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
+// DecodeGob parses the Gob representation of a `SendResults` messages from the `gobBytes` argument.
+// Gob omits the zero values, so the message is replaced as a whole by the decoded one.
+func (msg *SendResults) DecodeGob(gobBytes []byte) error {
+	var decoded SendResults
+	if err := gob.NewDecoder(bytes.NewReader(gobBytes)).Decode(&decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
 // NewSendResultsMessage returns with a new `SendResults` message. The header will contain the current time in `Nanoseconds` precision.
 func NewSendResultsMessage() msgs.Message {
 	return NewSendResultsMessageAt(time.Now().UnixNano(), "ns")
@@ -88,5 +260,6 @@ func NewSendResultsMessage() msgs.Message {
 func NewSendResultsMessageAt(at int64, withPrecision common.TimePrecision) msgs.Message {
 	var msg SendResults
 	msg.Header = common.NewHeaderAt(at, withPrecision)
+	msg.Header.Version = msgs.GetMessageTypeVersion(SendResultsTypeName)
 	return &msg
 }
@@ -1,10 +1,17 @@
 package orchestra
 
 import (
+	"bytes"
+	"encoding/gob"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
+	"github.com/fxamacker/cbor/v2"
 	"github.com/tombenke/axon-go-common/msgs"
+	"github.com/tombenke/axon-go-common/msgs/avro"
 	"github.com/tombenke/axon-go-common/msgs/common"
+	"github.com/vmihailenco/msgpack/v5"
+	"gopkg.in/yaml.v2"
 	"time"
 )
 
@@ -14,9 +21,10 @@ const (
 )
 
 func init() {
-	msgs.RegisterMessageType(StatusReportTypeName, []msgs.Representation{msgs.JSONRepresentation}, func() msgs.Message {
+	msgs.RegisterMessageType(StatusReportTypeName, []msgs.Representation{msgs.JSONRepresentation, msgs.YAMLRepresentation, msgs.GobRepresentation, msgs.ProtobufRepresentation, msgs.XMLRepresentation, msgs.AvroRepresentation, msgs.MsgpackRepresentation, msgs.CBORRepresentation}, func() msgs.Message {
 		return NewStatusReportMessage(StatusReportBody{})
 	})
+	msgs.SetMessageTypeDescription(StatusReportTypeName, "Status of an actor node, sent as the response to the status request")
 }
 
 // StatusReport represents the structure of the `status-report` message that the actor is usually sent
@@ -96,17 +104,51 @@ func (msg *StatusReport) Encode(representation msgs.Representation) (results []b
 		if err != nil {
 			panic(err)
 		}
+	case msgs.YAMLRepresentation:
+		results = msg.YAML()
+	case msgs.GobRepresentation:
+		results = msg.EncodeGob()
+	case msgs.ProtobufRepresentation:
+		results = msg.Protobuf()
+	case msgs.XMLRepresentation:
+		results = msg.XML()
+	case msgs.AvroRepresentation:
+		results = msg.Avro()
+	case msgs.MsgpackRepresentation:
+		results = msg.Msgpack()
+	case msgs.CBORRepresentation:
+		results = msg.CBOR()
 	default:
 		panic(fmt.Errorf("Encode error: unknown representational format '%s'", representation))
 	}
 	return results
 }
 
-// Decode parses the `content` using the selected `representation` format
+// Decode parses the `content` using the selected `representation` format.
+// The messages of older versions are upgraded to the current version of the message-type, if the `representation` is upgradable.
 func (msg *StatusReport) Decode(representation msgs.Representation, content []byte) error {
+	content, err := msgs.Upgrade(StatusReportTypeName, representation, content)
+	if err != nil {
+		return err
+	}
+
 	switch representation {
 	case msgs.JSONRepresentation:
 		return json.Unmarshal(content, msg)
+	case msgs.YAMLRepresentation:
+		return msg.ParseYAML(content)
+	case msgs.GobRepresentation:
+		return msg.DecodeGob(content)
+	case msgs.ProtobufRepresentation:
+		return msg.ParseProtobuf(content)
+	case msgs.XMLRepresentation:
+		return msg.ParseXML(content)
+	case msgs.AvroRepresentation:
+		return msg.ParseAvro(content)
+	case msgs.MsgpackRepresentation:
+		return msg.ParseMsgpack(content)
+	case msgs.CBORRepresentation:
+		return msg.ParseCBOR(content)
 	default:
 		panic(fmt.Errorf("Decode error: unknown representational format '%s'", representation))
 	}
@@ -135,6 +177,136 @@ func (msg *StatusReport) ParseJSON(jsonBytes []byte) error {
 	return json.Unmarshal(jsonBytes, msg)
 }
 
+// Msgpack returns with the `StatusReport` message content in MessagePack representation format
+func (msg *StatusReport) Msgpack() []byte {
+	msgpackBytes, err := msgpack.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return msgpackBytes
+}
+
+// ParseMsgpack parses the MessagePack representation of a `StatusReport` messages from the `msgpackBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *StatusReport) ParseMsgpack(msgpackBytes []byte) error {
+	var decoded StatusReport
+	if err := msgpack.Unmarshal(msgpackBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// CBOR returns with the `StatusReport` message content in CBOR representation format
+func (msg *StatusReport) CBOR() []byte {
+	cborBytes, err := cbor.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return cborBytes
+}
+
+// ParseCBOR parses the CBOR representation of a `StatusReport` messages from the `cborBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *StatusReport) ParseCBOR(cborBytes []byte) error {
+	var decoded StatusReport
+	if err := cbor.Unmarshal(cborBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// Avro returns with the `StatusReport` message content in Avro representation format
+func (msg *StatusReport) Avro() []byte {
+	avroBytes, err := avro.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return avroBytes
+}
+
+// ParseAvro parses the Avro representation of a `StatusReport` messages from the `avroBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *StatusReport) ParseAvro(avroBytes []byte) error {
+	var decoded StatusReport
+	if err := avro.Unmarshal(avroBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// XML returns with the `StatusReport` message content in XML representation format
+func (msg *StatusReport) XML() []byte {
+	xmlBytes, err := xml.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return xmlBytes
+}
+
+// ParseXML parses the XML representation of a `StatusReport` messages from the `xmlBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *StatusReport) ParseXML(xmlBytes []byte) error {
+	var decoded StatusReport
+	if err := xml.Unmarshal(xmlBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// Protobuf returns with the `StatusReport` message content in protobuf representation format
+func (msg *StatusReport) Protobuf() []byte {
+	return common.MarshalProtobufMessage(msg.Header, msg.Body)
+}
+
+// ParseProtobuf parses the protobuf representation of a `StatusReport` messages from the `protobufBytes` argument.
+// Protobuf omits the zero values, so the message is replaced as a whole by the decoded one.
+func (msg *StatusReport) ParseProtobuf(protobufBytes []byte) error {
+	var decoded StatusReport
+	if err := common.UnmarshalProtobufMessage(protobufBytes, &decoded.Header, &decoded.Body); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// YAML returns with the `StatusReport` message content in YAML representation format
+func (msg *StatusReport) YAML() []byte {
+	yamlBytes, err := yaml.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return yamlBytes
+}
+
+// ParseYAML parses the YAML representation of a `StatusReport` messages from the `yamlBytes` argument.
+func (msg *StatusReport) ParseYAML(yamlBytes []byte) error {
+	return yaml.Unmarshal(yamlBytes, msg)
+}
+
+// EncodeGob returns with the `StatusReport` message content in Gob representation format
+func (msg *StatusReport) EncodeGob() []byte {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(*msg); err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
+// DecodeGob parses the Gob representation of a `StatusReport` messages from the `gobBytes` argument.
+// Gob omits the zero values, so the message is replaced as a whole by the decoded one.
+func (msg *StatusReport) DecodeGob(gobBytes []byte) error {
+	var decoded StatusReport
+	if err := gob.NewDecoder(bytes.NewReader(gobBytes)).Decode(&decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
 // NewStatusReportMessage returns with a new `StatusReport` message. The header will contain the current time in `Nanoseconds` precision.
 func NewStatusReportMessage(body StatusReportBody) msgs.Message {
 	return NewStatusReportMessageAt(body, time.Now().UnixNano(), "ns")
@@ -144,6 +316,7 @@ func NewStatusReportMessage(body StatusReportBody) msgs.Message {
 func NewStatusReportMessageAt(body StatusReportBody, at int64, withPrecision common.TimePrecision) msgs.Message {
 	var msg StatusReport
 	msg.Header = common.NewHeaderAt(at, withPrecision)
+	msg.Header.Version = msgs.GetMessageTypeVersion(StatusReportTypeName)
 	msg.Body = body
 	return &msg
 }
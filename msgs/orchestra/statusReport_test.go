@@ -9,7 +9,15 @@ import (
 )
 
 var (
-	testStatusReportBody = StatusReportBody{}
+	testStatusReportBody = StatusReportBody{
+		Name: "test-node",
+		Type: "test-node-type",
+		Ports: Ports{
+			Inputs:  []Port{Port{Name: "input", Type: "base/Float64", Representation: "application/json", Channel: Channel{Name: "input-ch"}}},
+			Outputs: []Port{Port{Name: "output", Type: "base/Bool", Representation: "application/json", Channel: Channel{Name: "output-ch"}}},
+		},
+		Synchronization: true,
+	}
 )
 
 func TestStatusReportGetType(t *testing.T) {
@@ -38,6 +46,76 @@ func TestStatusReportMessageCodec(t *testing.T) {
 	assert.Equal(t, m, &n)
 }
 
+func TestStatusReportMessageMsgpackCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewStatusReportMessageAt(testStatusReportBody, at, prec)
+	var n StatusReport
+	err := n.Decode(msgs.MsgpackRepresentation, m.Encode(msgs.MsgpackRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestStatusReportMessageCBORCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewStatusReportMessageAt(testStatusReportBody, at, prec)
+	var n StatusReport
+	err := n.Decode(msgs.CBORRepresentation, m.Encode(msgs.CBORRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestStatusReportMessageAvroCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewStatusReportMessageAt(testStatusReportBody, at, prec)
+	var n StatusReport
+	err := n.Decode(msgs.AvroRepresentation, m.Encode(msgs.AvroRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestStatusReportMessageXMLCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewStatusReportMessageAt(testStatusReportBody, at, prec)
+	var n StatusReport
+	err := n.Decode(msgs.XMLRepresentation, m.Encode(msgs.XMLRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestStatusReportMessageProtobufCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewStatusReportMessageAt(testStatusReportBody, at, prec)
+	var n StatusReport
+	err := n.Decode(msgs.ProtobufRepresentation, m.Encode(msgs.ProtobufRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestStatusReportMessageYAMLCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewStatusReportMessageAt(testStatusReportBody, at, prec)
+	var n StatusReport
+	err := n.Decode(msgs.YAMLRepresentation, m.Encode(msgs.YAMLRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestStatusReportMessageGobCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewStatusReportMessageAt(testStatusReportBody, at, prec)
+	var n StatusReport
+	err := n.Decode(msgs.GobRepresentation, m.Encode(msgs.GobRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
 func TestStatusReportMessageCodecPanic(t *testing.T) {
 	at := int64(1608732048980057025)
 	prec := common.TimePrecision("ns")
@@ -2,43 +2,165 @@ package msgs
 
 import (
 	"fmt"
+	"github.com/tombenke/axon-go-common/msgs/avro"
+	"reflect"
+	"sort"
+	"sync"
+	"sync/atomic"
 )
 
-// registry keeps track of the registered message types
+// Registry keeps track of the registered message types
 // as well as of the representation formats can be used to a specific message type.
 // It also holds funtions to each message type that return with the default value of the corresponding type.
-// The message-types need to be registered before use this registry.
-// It can happen typically in the `init()` function of the implementation of the message-types.
-var registry map[string]MessageTypeDescriptor = make(map[string]MessageTypeDescriptor)
+// The message-types need to be registered before use the registry.
+// It can happen typically in the `init()` function of the implementation of the message-types,
+// that register them into the default registry used by the package level functions.
+// The registry is safe for concurrent use.
+type Registry struct {
+	mu    sync.RWMutex
+	types map[string]MessageTypeDescriptor
+}
 
-// MessageTypeDescriptor describes one message-type registered into the `registry`.
+// MessageTypeDescriptor describes one message-type registered into the `Registry`.
 type MessageTypeDescriptor struct {
 	// The name of the message-type
 	Type string
+	// The description of the message-type
+	Description string
 	// The map of representation formats the message-type supports
 	Representations map[Representation]bool
 	// Function to get the default message value of the message-type
 	GetDefaultMessageFun func() Message
+	// The current version of the message-type, that starts with 0 and is increased by each registered migration
+	Version int
+	// The migration functions: the n-th one upgrades the messages from the n version to the next one
+	Migrations []MigrationFunc
+	// The name of the corresponding ROS message-type, e.g. `std_msgs/Float64`, or empty if there is no such one
+	ROSType string
 }
 
-// RegisterMessageType registers a specific message-type into the central registry
-func RegisterMessageType(Type string, Representations []Representation, GetDefaultMessageFun func() Message) {
-	if _, isPresent := registry[Type]; isPresent {
+// FieldDescriptor describes a field of a message-type
+type FieldDescriptor struct {
+	// The name of the field
+	Name string
+	// The Go type of the field, e.g. `float64` or `[]orchestra.Port`
+	Type string
+	// The fields of the struct typed field, or of the items of the slice, array or map typed field
+	Fields []FieldDescriptor
+}
+
+// NewRegistry returns with a new, empty registry. It is typically used by the tests, that need an isolated registry.
+func NewRegistry() *Registry {
+	return &Registry{types: make(map[string]MessageTypeDescriptor)}
+}
+
+// defaultRegistry holds the registry used by the package level functions.
+// It is initialized as a variable, so it is ready before the `init()` functions that register the message-types.
+var defaultRegistry = func() *atomic.Value {
+	v := &atomic.Value{}
+	v.Store(NewRegistry())
+	return v
+}()
+
+// DefaultRegistry returns with the registry used by the package level functions
+func DefaultRegistry() *Registry {
+	return defaultRegistry.Load().(*Registry)
+}
+
+// SetDefaultRegistry replaces the registry used by the package level functions, and returns with the previous one.
+// It is intended to be used by the tests, that need an isolated registry, e.g.:
+//
+//	defer msgs.SetDefaultRegistry(msgs.SetDefaultRegistry(msgs.DefaultRegistry().Clone()))
+func SetDefaultRegistry(r *Registry) *Registry {
+	previous := DefaultRegistry()
+	defaultRegistry.Store(r)
+	return previous
+}
+
+// Clone returns with a new registry that holds the same message-types as `r`.
+// The changes of the new registry do not affect `r`, and vice versa.
+func (r *Registry) Clone() *Registry {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	clone := NewRegistry()
+	for t, d := range r.types {
+		clone.types[t] = d.clone()
+	}
+	return clone
+}
+
+// Register registers a specific message-type into the registry.
+// It panics if the message-type has already been registered.
+func (r *Registry) Register(Type string, Representations []Representation, GetDefaultMessageFun func() Message) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, isPresent := r.types[Type]; isPresent {
 
 		errorString := fmt.Sprintf("The '%s' message type has already been registered yet!", Type)
 		panic(errorString)
 	}
 	rmap := make(map[Representation]bool)
-	for _, r := range Representations {
-		rmap[r] = true
+	for _, rep := range Representations {
+		rmap[rep] = true
 	}
-	registry[Type] = MessageTypeDescriptor{Type, rmap, GetDefaultMessageFun}
+	r.types[Type] = MessageTypeDescriptor{Type: Type, Representations: rmap, GetDefaultMessageFun: GetDefaultMessageFun}
 }
 
-// GetDefaultMessageByType returns with the default message value of the `Type` message-type
-func GetDefaultMessageByType(Type string) Message {
-	if _, isPresent := registry[Type]; isPresent {
-		return registry[Type].GetDefaultMessageFun()
+// Unregister removes the `Type` message-type from the registry. It is typically used by the tests.
+func (r *Registry) Unregister(Type string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.types, Type)
+}
+
+// SetDescription sets the description of the `Type` message-type. It panics if the message-type is not registered.
+func (r *Registry) SetDescription(Type string, description string) {
+	r.update(Type, func(d *MessageTypeDescriptor) {
+		d.Description = description
+	})
+}
+
+// GetDescriptor returns with the descriptor of the `Type` message-type,
+// and true if it is registered, othewise returns with false.
+func (r *Registry) GetDescriptor(Type string) (MessageTypeDescriptor, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	d, isPresent := r.types[Type]
+	if !isPresent {
+		return MessageTypeDescriptor{}, false
+	}
+	return d.clone(), true
+}
+
+// ListMessageTypes returns with the descriptors of the registered message-types in the alphabetical order of their names
+func (r *Registry) ListMessageTypes() []MessageTypeDescriptor {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	descriptors := make([]MessageTypeDescriptor, 0, len(r.types))
+	for _, d := range r.types {
+		descriptors = append(descriptors, d.clone())
+	}
+	sort.Slice(descriptors, func(i, j int) bool { return descriptors[i].Type < descriptors[j].Type })
+	return descriptors
+}
+
+// GetMessageTypes returns with the names of the registered message-types in alphabetical order
+func (r *Registry) GetMessageTypes() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	types := make([]string, 0, len(r.types))
+	for t := range r.types {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// GetDefaultMessageByType returns with the default message value of the `Type` message-type.
+// It panics if the message-type is not registered.
+func (r *Registry) GetDefaultMessageByType(Type string) Message {
+	if d, isPresent := r.GetDescriptor(Type); isPresent {
+		return d.GetDefaultMessageFun()
 	}
 
 	errorString := fmt.Sprintf("The '%s' message type has not been registered!", Type)
@@ -46,20 +168,163 @@ func GetDefaultMessageByType(Type string) Message {
 }
 
 // IsMessageTypeRegistered returns true if `Type` message-type is registered, othewise returns with false.
-func IsMessageTypeRegistered(Type string) bool {
-	if _, isPresent := registry[Type]; isPresent {
-		return true
-	}
-	return false
+func (r *Registry) IsMessageTypeRegistered(Type string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	_, isPresent := r.types[Type]
+	return isPresent
 }
 
 // DoesMessageTypeImplementsRepresentation returns true if `Type` message-type is registered,
 // ant it has implementation for Encoding and Decoding the `Representation` format, othewise returns with false.
-func DoesMessageTypeImplementsRepresentation(Type string, Representation Representation) bool {
-	if t, isTypePresent := registry[Type]; isTypePresent {
-		if _, isRepPresent := t.Representations[Representation]; isRepPresent {
-			return true
+func (r *Registry) DoesMessageTypeImplementsRepresentation(Type string, Representation Representation) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.types[Type].Representations[Representation]
+}
+
+// GetAvroSchema returns with the Avro schema of the `Type` message-type.
+// The schema is generated from the default message value of the message-type.
+// It returns error if the message-type is not registered, or it does not implement the Avro representation.
+func (r *Registry) GetAvroSchema(Type string) (*avro.Schema, error) {
+	d, isPresent := r.GetDescriptor(Type)
+	if !isPresent {
+		return nil, fmt.Errorf("the '%s' message type has not been registered", Type)
+	}
+	if !d.Representations[AvroRepresentation] {
+		return nil, fmt.Errorf("the '%s' message type does not implement the '%s' representation", Type, AvroRepresentation)
+	}
+	return avro.SchemaOf(d.GetDefaultMessageFun())
+}
+
+// GetAvroSchemas returns with the Avro schemas of the registered message-types that implement the Avro representation
+func (r *Registry) GetAvroSchemas() map[string]*avro.Schema {
+	schemas := map[string]*avro.Schema{}
+	for _, t := range r.GetMessageTypes() {
+		if s, err := r.GetAvroSchema(t); err == nil {
+			schemas[t] = s
+		}
+	}
+	return schemas
+}
+
+// update calls `fn` with the descriptor of the `Type` message-type, then stores the modified descriptor.
+// It panics if the message-type is not registered.
+func (r *Registry) update(Type string, fn func(d *MessageTypeDescriptor)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	d, isPresent := r.types[Type]
+	if !isPresent {
+		panic(fmt.Sprintf("The '%s' message type has not been registered!", Type))
+	}
+	fn(&d)
+	r.types[Type] = d
+}
+
+// clone returns with a copy of the descriptor, that shares no maps and slices with the original one
+func (d MessageTypeDescriptor) clone() MessageTypeDescriptor {
+	representations := make(map[Representation]bool, len(d.Representations))
+	for rep, ok := range d.Representations {
+		representations[rep] = ok
+	}
+	d.Representations = representations
+	d.Migrations = append([]MigrationFunc(nil), d.Migrations...)
+	return d
+}
+
+// Fields returns with the metadata of the fields of the message-type, taken from its default message value.
+// It returns with nil, if the message-type is not a struct, e.g. `base/Any`.
+func (d MessageTypeDescriptor) Fields() []FieldDescriptor {
+	if d.GetDefaultMessageFun == nil {
+		return nil
+	}
+	t := reflect.TypeOf(d.GetDefaultMessageFun())
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return fieldsOf(t, map[reflect.Type]bool{})
+}
+
+// fieldsOf returns with the metadata of the exported fields of the `t` struct type,
+// or of the items of the `t` slice, array or map type. The `visited` types are skipped to avoid infinite recursion.
+func fieldsOf(t reflect.Type, visited map[reflect.Type]bool) []FieldDescriptor {
+	switch t.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map, reflect.Ptr:
+		return fieldsOf(t.Elem(), visited)
+	case reflect.Struct:
+	default:
+		return nil
+	}
+	if visited[t] {
+		return nil
+	}
+	visited[t] = true
+	defer delete(visited, t)
+
+	fields := []FieldDescriptor{}
+	for i := 0; i < t.NumField(); i++ {
+		if f := t.Field(i); f.PkgPath == "" {
+			fields = append(fields, FieldDescriptor{Name: f.Name, Type: f.Type.String(), Fields: fieldsOf(f.Type, visited)})
 		}
 	}
-	return false
+	return fields
+}
+
+// RegisterMessageType registers a specific message-type into the default registry
+func RegisterMessageType(Type string, Representations []Representation, GetDefaultMessageFun func() Message) {
+	DefaultRegistry().Register(Type, Representations, GetDefaultMessageFun)
+}
+
+// UnregisterMessageType removes the `Type` message-type from the default registry. It is typically used by the tests.
+func UnregisterMessageType(Type string) {
+	DefaultRegistry().Unregister(Type)
+}
+
+// SetMessageTypeDescription sets the description of the `Type` message-type in the default registry
+func SetMessageTypeDescription(Type string, description string) {
+	DefaultRegistry().SetDescription(Type, description)
+}
+
+// GetDescriptor returns with the descriptor of the `Type` message-type from the default registry,
+// and true if it is registered, othewise returns with false.
+func GetDescriptor(Type string) (MessageTypeDescriptor, bool) {
+	return DefaultRegistry().GetDescriptor(Type)
+}
+
+// ListMessageTypes returns with the descriptors of the message-types of the default registry in the alphabetical order of their names
+func ListMessageTypes() []MessageTypeDescriptor {
+	return DefaultRegistry().ListMessageTypes()
+}
+
+// GetDefaultMessageByType returns with the default message value of the `Type` message-type
+func GetDefaultMessageByType(Type string) Message {
+	return DefaultRegistry().GetDefaultMessageByType(Type)
+}
+
+// IsMessageTypeRegistered returns true if `Type` message-type is registered, othewise returns with false.
+func IsMessageTypeRegistered(Type string) bool {
+	return DefaultRegistry().IsMessageTypeRegistered(Type)
+}
+
+// DoesMessageTypeImplementsRepresentation returns true if `Type` message-type is registered,
+// ant it has implementation for Encoding and Decoding the `Representation` format, othewise returns with false.
+func DoesMessageTypeImplementsRepresentation(Type string, Representation Representation) bool {
+	return DefaultRegistry().DoesMessageTypeImplementsRepresentation(Type, Representation)
+}
+
+// GetMessageTypes returns with the names of the registered message-types in alphabetical order
+func GetMessageTypes() []string {
+	return DefaultRegistry().GetMessageTypes()
+}
+
+// GetAvroSchema returns with the Avro schema of the `Type` message-type of the default registry.
+// The schema is generated from the default message value of the message-type.
+// It returns error if the message-type is not registered, or it does not implement the Avro representation.
+func GetAvroSchema(Type string) (*avro.Schema, error) {
+	return DefaultRegistry().GetAvroSchema(Type)
+}
+
+// GetAvroSchemas returns with the Avro schemas of the registered message-types that implement the Avro representation
+func GetAvroSchemas() map[string]*avro.Schema {
+	return DefaultRegistry().GetAvroSchemas()
 }
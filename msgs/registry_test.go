@@ -0,0 +1,115 @@
+package msgs
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+)
+
+func TestRegistry(t *testing.T) {
+	r := NewRegistry()
+	r.Register("test/B", []Representation{JSONRepresentation}, nil)
+	r.Register("test/A", []Representation{JSONRepresentation, YAMLRepresentation}, nil)
+	r.SetDescription("test/A", "The A message-type")
+
+	assert.Panics(t, func() { r.Register("test/A", nil, nil) })
+	assert.Panics(t, func() { r.SetDescription("test/C", "unknown") })
+	assert.Panics(t, func() { r.GetDefaultMessageByType("test/C") })
+
+	assert.Equal(t, []string{"test/A", "test/B"}, r.GetMessageTypes())
+	assert.True(t, r.IsMessageTypeRegistered("test/A"))
+	assert.True(t, r.DoesMessageTypeImplementsRepresentation("test/A", YAMLRepresentation))
+	assert.False(t, r.DoesMessageTypeImplementsRepresentation("test/B", YAMLRepresentation))
+	assert.False(t, r.DoesMessageTypeImplementsRepresentation("test/C", JSONRepresentation))
+
+	descriptors := r.ListMessageTypes()
+	assert.Equal(t, 2, len(descriptors))
+	assert.Equal(t, "test/A", descriptors[0].Type)
+	assert.Equal(t, "The A message-type", descriptors[0].Description)
+	assert.Equal(t, map[Representation]bool{JSONRepresentation: true, YAMLRepresentation: true}, descriptors[0].Representations)
+	assert.Nil(t, descriptors[0].Fields())
+
+	// The descriptors are copies, their changes do not affect the registry
+	d, isPresent := r.GetDescriptor("test/B")
+	assert.True(t, isPresent)
+	d.Representations[YAMLRepresentation] = true
+	assert.False(t, r.DoesMessageTypeImplementsRepresentation("test/B", YAMLRepresentation))
+
+	r.Unregister("test/B")
+	_, isPresent = r.GetDescriptor("test/B")
+	assert.False(t, isPresent)
+	assert.Equal(t, []string{"test/A"}, r.GetMessageTypes())
+}
+
+func TestRegistryClone(t *testing.T) {
+	r := NewRegistry()
+	r.Register("test/A", []Representation{JSONRepresentation}, nil)
+
+	clone := r.Clone()
+	clone.Register("test/B", []Representation{JSONRepresentation}, nil)
+	clone.RegisterMigration("test/A", 0, func(doc map[string]interface{}) error { return nil })
+
+	assert.Equal(t, []string{"test/A"}, r.GetMessageTypes())
+	assert.Equal(t, 0, r.GetMessageTypeVersion("test/A"))
+	assert.Equal(t, []string{"test/A", "test/B"}, clone.GetMessageTypes())
+	assert.Equal(t, 1, clone.GetMessageTypeVersion("test/A"))
+}
+
+func TestRegistryROSTypes(t *testing.T) {
+	r := NewRegistry()
+	r.Register("test/A", []Representation{ROS1Representation}, nil)
+	r.Register("test/B", []Representation{ROS1Representation}, nil)
+	r.Register("test/C", []Representation{JSONRepresentation}, nil)
+	r.SetROSType("test/B", "std_msgs/Float64MultiArray")
+	r.SetROSType("test/A", "std_msgs/Float64MultiArray")
+
+	assert.Panics(t, func() { r.SetROSType("test/D", "std_msgs/Float64") })
+
+	rosType, ok := r.GetROSType("test/A")
+	assert.True(t, ok)
+	assert.Equal(t, "std_msgs/Float64MultiArray", rosType)
+	_, ok = r.GetROSType("test/C")
+	assert.False(t, ok)
+	_, ok = r.GetROSType("test/D")
+	assert.False(t, ok)
+
+	assert.Equal(t, []string{"test/A", "test/B"}, r.GetMessageTypesByROSType("std_msgs/Float64MultiArray"))
+	assert.Equal(t, []string{}, r.GetMessageTypesByROSType("std_msgs/Float64"))
+	assert.Equal(t, map[string]string{"test/A": "std_msgs/Float64MultiArray", "test/B": "std_msgs/Float64MultiArray"}, r.GetROSTypes())
+}
+
+func TestSetDefaultRegistry(t *testing.T) {
+	isolated := NewRegistry()
+	previous := SetDefaultRegistry(isolated)
+	assert.Equal(t, isolated, DefaultRegistry())
+
+	RegisterMessageType("test/Isolated", []Representation{JSONRepresentation}, nil)
+	assert.True(t, IsMessageTypeRegistered("test/Isolated"))
+	assert.Equal(t, []string{"test/Isolated"}, GetMessageTypes())
+	UnregisterMessageType("test/Isolated")
+	assert.Equal(t, 0, len(ListMessageTypes()))
+
+	assert.Equal(t, isolated, SetDefaultRegistry(previous))
+	assert.False(t, IsMessageTypeRegistered("test/Isolated"))
+}
+
+func TestRegistryConcurrency(t *testing.T) {
+	r := NewRegistry()
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			typeName := fmt.Sprintf("test/Concurrent%d", i)
+			r.Register(typeName, []Representation{JSONRepresentation}, nil)
+			r.SetDescription(typeName, typeName)
+			r.RegisterMigration(typeName, 0, func(doc map[string]interface{}) error { return nil })
+			assert.True(t, r.IsMessageTypeRegistered(typeName))
+			r.ListMessageTypes()
+			r.Clone()
+		}(i)
+	}
+	wg.Wait()
+	assert.Equal(t, 10, len(r.GetMessageTypes()))
+}
@@ -0,0 +1,74 @@
+package msgs
+
+import (
+	"sort"
+)
+
+// SetROSType sets the name of the ROS message-type, e.g. `std_msgs/Float64`, that the `Type` message-type corresponds to.
+// The `application/x-ros1` representation of the message-type is the ROS1 serialization of this ROS message-type.
+// More message-types may correspond to the same ROS message-type. It panics if the message-type is not registered.
+func (r *Registry) SetROSType(Type string, rosType string) {
+	r.update(Type, func(d *MessageTypeDescriptor) {
+		d.ROSType = rosType
+	})
+}
+
+// GetROSType returns with the name of the ROS message-type that the `Type` message-type corresponds to,
+// and true if there is any, otherwise returns with false.
+func (r *Registry) GetROSType(Type string) (string, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	d, isPresent := r.types[Type]
+	return d.ROSType, isPresent && d.ROSType != ""
+}
+
+// GetMessageTypesByROSType returns with the names of the message-types that correspond to the `rosType` ROS message-type,
+// in alphabetical order
+func (r *Registry) GetMessageTypesByROSType(rosType string) []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	types := []string{}
+	for t, d := range r.types {
+		if d.ROSType == rosType {
+			types = append(types, t)
+		}
+	}
+	sort.Strings(types)
+	return types
+}
+
+// GetROSTypes returns with the mapping table of the message-types of the registry to the ROS message-types.
+// The keys are the names of the message-types, the values are the names of the corresponding ROS message-types.
+func (r *Registry) GetROSTypes() map[string]string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	rosTypes := make(map[string]string)
+	for t, d := range r.types {
+		if d.ROSType != "" {
+			rosTypes[t] = d.ROSType
+		}
+	}
+	return rosTypes
+}
+
+// SetMessageTypeROSType sets the name of the ROS message-type that the `Type` message-type of the default registry corresponds to
+func SetMessageTypeROSType(Type string, rosType string) {
+	DefaultRegistry().SetROSType(Type, rosType)
+}
+
+// GetROSType returns with the name of the ROS message-type that the `Type` message-type of the default registry corresponds to,
+// and true if there is any, otherwise returns with false.
+func GetROSType(Type string) (string, bool) {
+	return DefaultRegistry().GetROSType(Type)
+}
+
+// GetMessageTypesByROSType returns with the names of the message-types of the default registry
+// that correspond to the `rosType` ROS message-type, in alphabetical order
+func GetMessageTypesByROSType(rosType string) []string {
+	return DefaultRegistry().GetMessageTypesByROSType(rosType)
+}
+
+// GetROSTypes returns with the mapping table of the message-types of the default registry to the ROS message-types
+func GetROSTypes() map[string]string {
+	return DefaultRegistry().GetROSTypes()
+}
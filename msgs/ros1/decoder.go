@@ -0,0 +1,159 @@
+package ros1
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+)
+
+// Unmarshaler is implemented by the parts of the messages that can be deserialized from ROS1 wire-format
+type Unmarshaler interface {
+	// UnmarshalROS1 reads the fields of the ROS1 serialized content by `d`
+	UnmarshalROS1(d *Decoder)
+}
+
+// Decoder reads the ROS1 serialization of the primitive types from a buffer.
+// The first error is kept, and the further reads return with zero values,
+// so the error has to be checked only once, at the end of decoding, by `Err()` or `Close()`.
+type Decoder struct {
+	buf []byte
+	err error
+}
+
+// NewDecoder returns with a new Decoder that reads from `b`
+func NewDecoder(b []byte) *Decoder {
+	return &Decoder{buf: b}
+}
+
+// Err returns with the first error occurred during decoding
+func (d *Decoder) Err() error {
+	return d.err
+}
+
+// Close returns with the first error occurred during decoding,
+// or with error if there is unread content left in the buffer
+func (d *Decoder) Close() error {
+	if d.err == nil && len(d.buf) > 0 {
+		d.err = fmt.Errorf("ros1: %d unexpected trailing bytes", len(d.buf))
+	}
+	return d.err
+}
+
+// next returns with the next `n` bytes of the buffer, or with nil if there is not enough content
+func (d *Decoder) next(n int) []byte {
+	if d.err != nil {
+		return nil
+	}
+	if n < 0 || n > len(d.buf) {
+		d.err = io.ErrUnexpectedEOF
+		return nil
+	}
+	b := d.buf[:n]
+	d.buf = d.buf[n:]
+	return b
+}
+
+// Uint8 reads an `uint8` or `byte` value
+func (d *Decoder) Uint8() uint8 {
+	if b := d.next(1); b != nil {
+		return b[0]
+	}
+	return 0
+}
+
+// Bool reads a `bool` value
+func (d *Decoder) Bool() bool {
+	return d.Uint8() != 0
+}
+
+// Uint32 reads an `uint32` value
+func (d *Decoder) Uint32() uint32 {
+	if b := d.next(4); b != nil {
+		return binary.LittleEndian.Uint32(b)
+	}
+	return 0
+}
+
+// Int32 reads an `int32` value
+func (d *Decoder) Int32() int32 {
+	return int32(d.Uint32())
+}
+
+// Uint64 reads an `uint64` value
+func (d *Decoder) Uint64() uint64 {
+	if b := d.next(8); b != nil {
+		return binary.LittleEndian.Uint64(b)
+	}
+	return 0
+}
+
+// Int64 reads an `int64` value
+func (d *Decoder) Int64() int64 {
+	return int64(d.Uint64())
+}
+
+// Float64 reads a `float64` value
+func (d *Decoder) Float64() float64 {
+	return math.Float64frombits(d.Uint64())
+}
+
+// String reads a length-prefixed `string` value
+func (d *Decoder) String() string {
+	n := d.Uint32()
+	if n > uint32(len(d.buf)) {
+		d.next(len(d.buf) + 1)
+		return ""
+	}
+	return string(d.next(int(n)))
+}
+
+// Time reads a `time` value, and returns with its `secs` and `nsecs` parts
+func (d *Decoder) Time() (secs uint32, nsecs uint32) {
+	return d.Uint32(), d.Uint32()
+}
+
+// Float64s reads a fixed-length `float64[N]` array into `v`
+func (d *Decoder) Float64s(v []float64) {
+	for i := range v {
+		v[i] = d.Float64()
+	}
+}
+
+// Float64Slice reads a variable-length `float64[]` array
+func (d *Decoder) Float64Slice() []float64 {
+	n := d.Uint32()
+	if uint64(n)*8 > uint64(len(d.buf)) {
+		d.next(len(d.buf) + 1)
+		return nil
+	}
+	v := make([]float64, n)
+	d.Float64s(v)
+	return v
+}
+
+// Int64Slice reads a variable-length `int64[]` array
+func (d *Decoder) Int64Slice() []int64 {
+	n := d.Uint32()
+	if uint64(n)*8 > uint64(len(d.buf)) {
+		d.next(len(d.buf) + 1)
+		return nil
+	}
+	v := make([]int64, n)
+	for i := range v {
+		v[i] = d.Int64()
+	}
+	return v
+}
+
+// Uint8Slice reads a variable-length `uint8[]` array
+func (d *Decoder) Uint8Slice() []byte {
+	n := d.Uint32()
+	if n > uint32(len(d.buf)) {
+		d.next(len(d.buf) + 1)
+		return nil
+	}
+	v := make([]byte, n)
+	copy(v, d.next(int(n)))
+	return v
+}
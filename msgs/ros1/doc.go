@@ -0,0 +1,33 @@
+/*
+Package ros1 provides the building blocks of the ROS1 wire-format (serialization) of the messages.
+
+The ROS1 serialization is a little-endian binary format without field names or type tags.
+The strings and the variable-length arrays are prefixed with their length as `uint32`,
+the fixed-length arrays are written without length prefix.
+The stamped messages start with a `std_msgs/Header`, that holds a sequence number, the timestamp and the frame id.
+
+The message-types register the ROS message-type they correspond to, so the mapping table is held by the message registry,
+and can be queried by `msgs.GetROSType()`, `msgs.GetMessageTypesByROSType()` and `msgs.GetROSTypes()`.
+The built-in message-types that have ROS1 representation:
+
+	base/Bool                    std_msgs/Bool
+	base/Bytes                   std_msgs/UInt8MultiArray
+	base/Empty                   std_msgs/Empty
+	base/Float64                 std_msgs/Float64
+	base/Float64Array            std_msgs/Float64MultiArray
+	base/Int64                   std_msgs/Int64
+	base/Int64Array              std_msgs/Int64MultiArray
+	base/Matrix                  std_msgs/Float64MultiArray, with "rows" and "cols" dimensions
+	base/String                  std_msgs/String
+	sensors/Humidity             sensor_msgs/RelativeHumidity
+	sensors/Temperature          sensor_msgs/Temperature
+	geometry/*                   geometry_msgs/*Stamped
+
+The `std_msgs` messages have no header, so the timestamp of the messages is not serialized,
+and the decoded messages get the current time. The sequence number of the ROS headers is written as zero, and dropped when read.
+The `base/Any`, `base/BoolArray` and `base/StringArray` message-types have no ROS counterpart.
+
+See: http://wiki.ros.org/msg#Message_Description_Specification
+and http://wiki.ros.org/ROS/Connection%20Header
+*/
+package ros1
@@ -0,0 +1,109 @@
+package ros1
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+// Marshaler is implemented by the parts of the messages that can be serialized into ROS1 wire-format
+type Marshaler interface {
+	// MarshalROS1 writes the fields of the ROS1 serialized content by `e`
+	MarshalROS1(e *Encoder)
+}
+
+// Encoder writes the ROS1 serialization of the primitive types into a buffer
+type Encoder struct {
+	buf []byte
+}
+
+// NewEncoder returns with a new Encoder with an empty buffer
+func NewEncoder() *Encoder {
+	return &Encoder{buf: []byte{}}
+}
+
+// Bytes returns with the content written so far
+func (e *Encoder) Bytes() []byte {
+	return e.buf
+}
+
+// Uint8 writes an `uint8` or `byte` value
+func (e *Encoder) Uint8(v uint8) {
+	e.buf = append(e.buf, v)
+}
+
+// Bool writes a `bool` value, that is an `uint8` in ROS1
+func (e *Encoder) Bool(v bool) {
+	if v {
+		e.Uint8(1)
+	} else {
+		e.Uint8(0)
+	}
+}
+
+// Uint32 writes an `uint32` value
+func (e *Encoder) Uint32(v uint32) {
+	var b [4]byte
+	binary.LittleEndian.PutUint32(b[:], v)
+	e.buf = append(e.buf, b[:]...)
+}
+
+// Int32 writes an `int32` value
+func (e *Encoder) Int32(v int32) {
+	e.Uint32(uint32(v))
+}
+
+// Uint64 writes an `uint64` value
+func (e *Encoder) Uint64(v uint64) {
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], v)
+	e.buf = append(e.buf, b[:]...)
+}
+
+// Int64 writes an `int64` value
+func (e *Encoder) Int64(v int64) {
+	e.Uint64(uint64(v))
+}
+
+// Float64 writes a `float64` value
+func (e *Encoder) Float64(v float64) {
+	e.Uint64(math.Float64bits(v))
+}
+
+// String writes a length-prefixed `string` value
+func (e *Encoder) String(v string) {
+	e.Uint32(uint32(len(v)))
+	e.buf = append(e.buf, v...)
+}
+
+// Time writes a `time` value, made of the `secs` and `nsecs` `uint32` values
+func (e *Encoder) Time(secs uint32, nsecs uint32) {
+	e.Uint32(secs)
+	e.Uint32(nsecs)
+}
+
+// Float64s writes a fixed-length `float64[N]` array, without length prefix
+func (e *Encoder) Float64s(v []float64) {
+	for _, x := range v {
+		e.Float64(x)
+	}
+}
+
+// Float64Slice writes a variable-length `float64[]` array, prefixed with its length
+func (e *Encoder) Float64Slice(v []float64) {
+	e.Uint32(uint32(len(v)))
+	e.Float64s(v)
+}
+
+// Int64Slice writes a variable-length `int64[]` array, prefixed with its length
+func (e *Encoder) Int64Slice(v []int64) {
+	e.Uint32(uint32(len(v)))
+	for _, x := range v {
+		e.Int64(x)
+	}
+}
+
+// Uint8Slice writes a variable-length `uint8[]` array, prefixed with its length
+func (e *Encoder) Uint8Slice(v []byte) {
+	e.Uint32(uint32(len(v)))
+	e.buf = append(e.buf, v...)
+}
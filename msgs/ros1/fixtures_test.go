@@ -0,0 +1,117 @@
+package ros1_test
+
+import (
+	"encoding/hex"
+	"github.com/stretchr/testify/assert"
+	"github.com/tombenke/axon-go-common/msgs"
+	"github.com/tombenke/axon-go-common/msgs/base"
+	"github.com/tombenke/axon-go-common/msgs/common"
+	"github.com/tombenke/axon-go-common/msgs/geometry"
+	"github.com/tombenke/axon-go-common/msgs/sensors"
+	"testing"
+)
+
+const at = int64(1608732048980057025)
+
+// ros1Fixture holds a message, and the ROS1 serialization of the corresponding ROS message.
+// The fixtures are serialized as the ROS1 client libraries do, e.g. the `serialize()` of the `genpy` generated classes.
+type ros1Fixture struct {
+	rosType string
+	message msgs.Message
+	// stamped is true if the ROS message has header, so the timestamp is kept too
+	stamped bool
+	hex     string
+}
+
+var ros1Fixtures = []ros1Fixture{
+	{"std_msgs/Bool", base.NewBoolMessage(true), false, "01"},
+	{"std_msgs/Float64", base.NewFloat64Message(42.5), false, "0000000000404540"},
+	{"std_msgs/Int64", base.NewInt64Message(-42), false, "d6ffffffffffffff"},
+	{"std_msgs/String", base.NewStringMessage("hello"), false, "0500000068656c6c6f"},
+	{"std_msgs/Empty", base.NewEmptyMessage(), false, ""},
+	{"std_msgs/UInt8MultiArray", base.NewBytesMessage([]byte{1, 2, 3}), false, "000000000000000003000000010203"},
+	{"std_msgs/Float64MultiArray", base.NewFloat64ArrayMessage([]float64{1.5, -2}), false,
+		"000000000000000002000000000000000000f83f00000000000000c0"},
+	{"std_msgs/Int64MultiArray", base.NewInt64ArrayMessage([]int64{1, -300}), false,
+		"0000000000000000020000000100000000000000d4feffffffffffff"},
+	{"std_msgs/Float64MultiArray", base.NewMatrixMessage(2, 2, []float64{1, 2, 3, 4}), false,
+		"0200000004000000726f7773020000000400000004000000636f6c7302000000020000000000000004000000" +
+			"000000000000f03f000000000000004000000000000008400000000000001040"},
+	{"sensor_msgs/Temperature", &sensors.Temperature{
+		Header: common.NewHeaderAt(at, common.Nanoseconds),
+		Body:   common.Float64VarBody{Data: 21.5, Variance: 0.25},
+	}, true, "00000000904de35fc17b6a3a000000000000000000803540000000000000d03f"},
+	{"sensor_msgs/RelativeHumidity", sensors.NewHumidityMessageAt(0.45, at, common.Nanoseconds), true,
+		"00000000904de35fc17b6a3a00000000cdccccccccccdc3f0000000000000000"},
+	{"geometry_msgs/PoseStamped", geometry.NewPoseMessageAt(geometry.PoseBody{
+		FrameID: "map",
+		Pose:    geometry.PoseValue{Position: geometry.PointValue{X: 1, Y: 2, Z: 3}, Orientation: geometry.IdentityQuaternion},
+	}, at, common.Nanoseconds), true,
+		"00000000904de35fc17b6a3a030000006d6170" +
+			"000000000000f03f00000000000000400000000000000840" +
+			"000000000000000000000000000000000000000000000000000000000000f03f"},
+	{"geometry_msgs/TransformStamped", geometry.NewTransformMessageAt(geometry.TransformBody{
+		FrameID:      "odom",
+		ChildFrameID: "base_link",
+		Transform:    geometry.TransformValue{Translation: geometry.Vector3Value{X: 3, Y: -1}, Rotation: geometry.QuaternionValue{Z: 1}},
+	}, at, common.Nanoseconds), true,
+		"00000000904de35fc17b6a3a040000006f646f6d09000000626173655f6c696e6b" +
+			"0000000000000840000000000000f0bf0000000000000000" +
+			"00000000000000000000000000000000000000000000f03f0000000000000000"},
+}
+
+func TestROS1Fixtures(t *testing.T) {
+	for _, f := range ros1Fixtures {
+		t.Run(f.message.GetType(), func(t *testing.T) {
+			fixture, err := hex.DecodeString(f.hex)
+			assert.Nil(t, err)
+
+			rosType, ok := msgs.GetROSType(f.message.GetType())
+			assert.True(t, ok)
+			assert.Equal(t, f.rosType, rosType)
+			assert.True(t, msgs.DoesMessageTypeImplementsRepresentation(f.message.GetType(), msgs.ROS1Representation))
+
+			assert.Equal(t, fixture, f.message.Encode(msgs.ROS1Representation))
+
+			decoded := msgs.GetDefaultMessageByType(f.message.GetType())
+			assert.Nil(t, decoded.Decode(msgs.ROS1Representation, fixture))
+			assert.Equal(t, fixture, decoded.Encode(msgs.ROS1Representation))
+			if f.stamped {
+				assert.Equal(t, f.message, decoded)
+			}
+
+			assert.NotNil(t, decoded.Decode(msgs.ROS1Representation, append(fixture, 0)))
+		})
+	}
+}
+
+func TestROS1DecodeCapturedVariants(t *testing.T) {
+	// A header with a sequence number and a frame id, that are dropped
+	fixture, _ := hex.DecodeString("07000000904de35fc17b6a3a06000000746865726d6f0000000000803540000000000000d03f")
+	var temperature sensors.Temperature
+	assert.Nil(t, temperature.Decode(msgs.ROS1Representation, fixture))
+	assert.Equal(t, common.NewHeaderAt(at, common.Nanoseconds), temperature.Header)
+	assert.Equal(t, common.Float64VarBody{Data: 21.5, Variance: 0.25}, temperature.Body)
+
+	// A layout with a labeled dimension and a padding value at the beginning of the data
+	fixture, _ = hex.DecodeString("010000000100000078030000000300000001000000030000000000000000000000000000000000f83f00000000000000c0")
+	var array base.Float64Array
+	assert.Nil(t, array.Decode(msgs.ROS1Representation, fixture))
+	assert.Equal(t, []float64{1.5, -2}, array.Body.Data)
+
+	// A one dimensional layout is not a matrix
+	var matrix base.Matrix
+	assert.NotNil(t, matrix.Decode(msgs.ROS1Representation, fixture))
+}
+
+func TestROSTypeMapping(t *testing.T) {
+	rosTypes := msgs.GetROSTypes()
+	assert.Equal(t, "std_msgs/Float64", rosTypes[base.Float64TypeName])
+	assert.Equal(t, "sensor_msgs/Temperature", rosTypes[sensors.TemperatureTypeName])
+	assert.Equal(t, "geometry_msgs/PointStamped", rosTypes[geometry.PointTypeName])
+	assert.Equal(t, []string{base.Float64TypeName}, msgs.GetMessageTypesByROSType("std_msgs/Float64"))
+	assert.Equal(t, []string{base.Float64ArrayTypeName, base.MatrixTypeName}, msgs.GetMessageTypesByROSType("std_msgs/Float64MultiArray"))
+
+	_, ok := msgs.GetROSType(base.AnyTypeName)
+	assert.False(t, ok)
+}
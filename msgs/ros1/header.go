@@ -0,0 +1,75 @@
+package ros1
+
+import (
+	"github.com/tombenke/axon-go-common/msgs/common"
+)
+
+// timePrecisionNs holds the number of nanoseconds of one unit of the timestamps by time precision
+var timePrecisionNs = map[common.TimePrecision]int64{
+	common.Nanoseconds:  1,
+	"u":                 1e3,
+	common.Microseconds: 1e3,
+	common.Milliseconds: 1e6,
+	common.Seconds:      1e9,
+}
+
+// Stamp returns with the timestamp of the `header` as the `secs` and `nsecs` parts of a ROS1 `time` value
+func Stamp(header common.Header) (secs uint32, nsecs uint32) {
+	unit, ok := timePrecisionNs[header.TimePrecision]
+	if !ok {
+		unit = 1
+	}
+	ns := header.Timestamp * unit
+	return uint32(ns / 1e9), uint32(ns % 1e9)
+}
+
+// HeaderAt returns with a new message header, that holds the `secs` and `nsecs` ROS1 `time` value
+// as a timestamp of `precision` precision. The default precision is used if `precision` is empty.
+func HeaderAt(secs uint32, nsecs uint32, precision common.TimePrecision) common.Header {
+	if precision == "" {
+		precision = common.DefaultTimePrecision
+	}
+	unit, ok := timePrecisionNs[precision]
+	if !ok {
+		unit = 1
+	}
+	return common.NewHeaderAt((int64(secs)*1e9+int64(nsecs))/unit, precision)
+}
+
+// Header writes a `std_msgs/Header` with zero sequence number, the timestamp of `header` and the `frameID`
+func (e *Encoder) Header(header common.Header, frameID string) {
+	e.Uint32(0)
+	e.Time(Stamp(header))
+	e.String(frameID)
+}
+
+// Header reads a `std_msgs/Header`, and returns with the message header that holds its timestamp
+// in `precision` precision, and with its frame id. The sequence number is dropped.
+func (d *Decoder) Header(precision common.TimePrecision) (common.Header, string) {
+	d.Uint32()
+	secs, nsecs := d.Time()
+	frameID := d.String()
+	return HeaderAt(secs, nsecs, precision), frameID
+}
+
+// MarshalStamped returns with the ROS1 serialization of a stamped message,
+// that is made of a `std_msgs/Header` built from the `header` and `frameID`, followed by the fields of the `body`
+func MarshalStamped(header common.Header, frameID string, body Marshaler) []byte {
+	e := NewEncoder()
+	e.Header(header, frameID)
+	body.MarshalROS1(e)
+	return e.Bytes()
+}
+
+// UnmarshalStamped parses the ROS1 serialization of a stamped message from `b` into the `header`, `frameID` and `body`.
+// The timestamp is converted into the precision of `header`, or into the default precision if it is empty.
+func UnmarshalStamped(b []byte, header *common.Header, frameID *string, body Unmarshaler) error {
+	d := NewDecoder(b)
+	h, id := d.Header(header.TimePrecision)
+	body.UnmarshalROS1(d)
+	if err := d.Close(); err != nil {
+		return err
+	}
+	*header, *frameID = h, id
+	return nil
+}
@@ -0,0 +1,41 @@
+package ros1
+
+// MultiArrayDimension describes one dimension of the `std_msgs/*MultiArray` ROS messages,
+// like the `std_msgs/MultiArrayDimension` ROS message
+type MultiArrayDimension struct {
+	// Label is the name of the dimension
+	Label string
+	// Size is the number of the items in the dimension
+	Size uint32
+	// Stride is the number of the values that the items of the dimension span, including the lower dimensions
+	Stride uint32
+}
+
+// MultiArrayLayout writes a `std_msgs/MultiArrayLayout` with the `dims` dimensions and zero data offset
+func (e *Encoder) MultiArrayLayout(dims []MultiArrayDimension) {
+	e.Uint32(uint32(len(dims)))
+	for _, dim := range dims {
+		e.String(dim.Label)
+		e.Uint32(dim.Size)
+		e.Uint32(dim.Stride)
+	}
+	e.Uint32(0)
+}
+
+// MultiArrayLayout reads a `std_msgs/MultiArrayLayout`, and returns with its dimensions and data offset.
+// The data offset is the number of the padding values at the beginning of the data.
+func (d *Decoder) MultiArrayLayout() ([]MultiArrayDimension, uint32) {
+	n := d.Uint32()
+	// Every dimension takes at least 12 bytes
+	if uint64(n)*12 > uint64(len(d.buf)) {
+		d.next(len(d.buf) + 1)
+		return nil, 0
+	}
+	dims := make([]MultiArrayDimension, n)
+	for i := range dims {
+		dims[i].Label = d.String()
+		dims[i].Size = d.Uint32()
+		dims[i].Stride = d.Uint32()
+	}
+	return dims, d.Uint32()
+}
@@ -0,0 +1,73 @@
+package ros1
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/tombenke/axon-go-common/msgs/common"
+	"io"
+	"testing"
+)
+
+func TestEncoderDecoder(t *testing.T) {
+	e := NewEncoder()
+	e.Bool(true)
+	e.Uint8(7)
+	e.Int32(-2)
+	e.Int64(-3)
+	e.Float64(1.5)
+	e.String("abc")
+	e.Float64Slice([]float64{1, 2})
+	assert.Equal(t, []byte{
+		0x01,
+		0x07,
+		0xfe, 0xff, 0xff, 0xff,
+		0xfd, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf8, 0x3f,
+		0x03, 0x00, 0x00, 0x00, 0x61, 0x62, 0x63,
+		0x02, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40,
+	}, e.Bytes())
+
+	d := NewDecoder(e.Bytes())
+	assert.True(t, d.Bool())
+	assert.Equal(t, uint8(7), d.Uint8())
+	assert.Equal(t, int32(-2), d.Int32())
+	assert.Equal(t, int64(-3), d.Int64())
+	assert.Equal(t, 1.5, d.Float64())
+	assert.Equal(t, "abc", d.String())
+	assert.Equal(t, []float64{1, 2}, d.Float64Slice())
+	assert.Nil(t, d.Close())
+}
+
+func TestDecoderErrors(t *testing.T) {
+	// Truncated content
+	d := NewDecoder([]byte{0x01, 0x02})
+	assert.Equal(t, uint32(0), d.Uint32())
+	assert.Equal(t, io.ErrUnexpectedEOF, d.Err())
+	assert.Equal(t, "", d.String())
+	assert.Equal(t, io.ErrUnexpectedEOF, d.Close())
+
+	// Length prefix larger than the content
+	d = NewDecoder([]byte{0xff, 0xff, 0xff, 0xff, 0x61})
+	assert.Equal(t, "", d.String())
+	assert.NotNil(t, d.Err())
+
+	// Trailing bytes
+	d = NewDecoder([]byte{0x01, 0x02})
+	d.Uint8()
+	assert.Nil(t, d.Err())
+	assert.NotNil(t, d.Close())
+}
+
+func TestStamp(t *testing.T) {
+	secs, nsecs := Stamp(common.NewHeaderAt(1608732048980057025, common.Nanoseconds))
+	assert.Equal(t, uint32(1608732048), secs)
+	assert.Equal(t, uint32(980057025), nsecs)
+	secs, nsecs = Stamp(common.NewHeaderAt(1608732048980, common.Milliseconds))
+	assert.Equal(t, uint32(1608732048), secs)
+	assert.Equal(t, uint32(980000000), nsecs)
+
+	assert.Equal(t, common.NewHeaderAt(1608732048980057025, common.Nanoseconds), HeaderAt(1608732048, 980057025, ""))
+	assert.Equal(t, common.NewHeaderAt(1608732048980057, common.Microseconds), HeaderAt(1608732048, 980057025, common.Microseconds))
+	assert.Equal(t, common.NewHeaderAt(1608732048, common.Seconds), HeaderAt(1608732048, 980057025, common.Seconds))
+}
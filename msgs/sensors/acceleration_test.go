@@ -0,0 +1,90 @@
+// Code generated by axon-msggen from messages.yml. DO NOT EDIT.
+
+package sensors
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/tombenke/axon-go-common/msgs"
+	"github.com/tombenke/axon-go-common/msgs/common"
+	"testing"
+)
+
+func TestAccelerationGetType(t *testing.T) {
+	assert.Equal(t, NewAccelerationMessage(AccelerationBody{}).GetType(), AccelerationTypeName)
+}
+
+func TestAccelerationMessage(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewAccelerationMessageAt(AccelerationBody{X: 0.02, Y: -0.15, Z: 9.81, Unit: "m/s²", SensorID: "imu-1", Quality: QualityGood}, at, prec)
+	var n Acceleration
+	err := n.ParseJSON(m.JSON())
+	assert.Nil(t, err)
+	err = n.ParseJSON([]byte(m.String()))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestAccelerationMessageCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewAccelerationMessageAt(AccelerationBody{X: 0.02, Y: -0.15, Z: 9.81, Unit: "m/s²", SensorID: "imu-1", Quality: QualityGood}, at, prec)
+	representations := []msgs.Representation{
+		msgs.JSONRepresentation,
+		msgs.YAMLRepresentation,
+		msgs.GobRepresentation,
+		msgs.ProtobufRepresentation,
+		msgs.XMLRepresentation,
+		msgs.AvroRepresentation,
+		msgs.MsgpackRepresentation,
+		msgs.CBORRepresentation,
+		msgs.CSVRepresentation,
+		msgs.CSVHeaderRepresentation,
+	}
+	for _, representation := range representations {
+		t.Run(string(representation), func(t *testing.T) {
+			assert.True(t, msgs.DoesMessageTypeImplementsRepresentation(AccelerationTypeName, representation))
+			var n Acceleration
+			err := n.Decode(representation, m.Encode(representation))
+			assert.Nil(t, err)
+			assert.Equal(t, m, &n)
+		})
+	}
+}
+
+func TestAccelerationMessageTextCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewAccelerationMessageAt(AccelerationBody{X: 0.02, Y: -0.15, Z: 9.81, Unit: "m/s²", SensorID: "imu-1", Quality: QualityGood}, at, prec).(*Acceleration)
+	var n Acceleration
+	err := n.Decode(msgs.TextRepresentation, m.Encode(msgs.TextRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m.Body, n.Body)
+	assert.Equal(t, common.DefaultTimePrecision, n.Header.TimePrecision)
+}
+
+func TestAccelerationMessageCodecPanic(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewAccelerationMessageAt(AccelerationBody{X: 0.02, Y: -0.15, Z: 9.81, Unit: "m/s²", SensorID: "imu-1", Quality: QualityGood}, at, prec)
+	var n Acceleration
+	func() {
+		defer func() {
+			if r := recover(); r != nil {
+				assert.Equal(t, r, errors.New("Decode error: unknown representational format 'wrong-representation'"))
+			}
+		}()
+		err := n.Decode(msgs.Representation("wrong-representation"), m.Encode(msgs.JSONRepresentation))
+		assert.Nil(t, err)
+	}()
+	func() {
+		defer func() {
+			if r := recover(); r != nil {
+				assert.Equal(t, r, errors.New("Encode error: unknown representational format 'wrong-representation'"))
+			}
+		}()
+		err := n.Decode(msgs.JSONRepresentation, m.Encode(msgs.Representation("wrong-representation")))
+		assert.Nil(t, err)
+	}()
+}
@@ -0,0 +1,90 @@
+// Code generated by axon-msggen from messages.yml. DO NOT EDIT.
+
+package sensors
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/tombenke/axon-go-common/msgs"
+	"github.com/tombenke/axon-go-common/msgs/common"
+	"testing"
+)
+
+func TestCurrentGetType(t *testing.T) {
+	assert.Equal(t, NewCurrentMessage(CurrentBody{}).GetType(), CurrentTypeName)
+}
+
+func TestCurrentMessage(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewCurrentMessageAt(CurrentBody{Value: 1.25, Variance: 0.01, Unit: "A", SensorID: "current-1", Quality: QualityGood}, at, prec)
+	var n Current
+	err := n.ParseJSON(m.JSON())
+	assert.Nil(t, err)
+	err = n.ParseJSON([]byte(m.String()))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestCurrentMessageCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewCurrentMessageAt(CurrentBody{Value: 1.25, Variance: 0.01, Unit: "A", SensorID: "current-1", Quality: QualityGood}, at, prec)
+	representations := []msgs.Representation{
+		msgs.JSONRepresentation,
+		msgs.YAMLRepresentation,
+		msgs.GobRepresentation,
+		msgs.ProtobufRepresentation,
+		msgs.XMLRepresentation,
+		msgs.AvroRepresentation,
+		msgs.MsgpackRepresentation,
+		msgs.CBORRepresentation,
+		msgs.CSVRepresentation,
+		msgs.CSVHeaderRepresentation,
+	}
+	for _, representation := range representations {
+		t.Run(string(representation), func(t *testing.T) {
+			assert.True(t, msgs.DoesMessageTypeImplementsRepresentation(CurrentTypeName, representation))
+			var n Current
+			err := n.Decode(representation, m.Encode(representation))
+			assert.Nil(t, err)
+			assert.Equal(t, m, &n)
+		})
+	}
+}
+
+func TestCurrentMessageTextCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewCurrentMessageAt(CurrentBody{Value: 1.25, Variance: 0.01, Unit: "A", SensorID: "current-1", Quality: QualityGood}, at, prec).(*Current)
+	var n Current
+	err := n.Decode(msgs.TextRepresentation, m.Encode(msgs.TextRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m.Body, n.Body)
+	assert.Equal(t, common.DefaultTimePrecision, n.Header.TimePrecision)
+}
+
+func TestCurrentMessageCodecPanic(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewCurrentMessageAt(CurrentBody{Value: 1.25, Variance: 0.01, Unit: "A", SensorID: "current-1", Quality: QualityGood}, at, prec)
+	var n Current
+	func() {
+		defer func() {
+			if r := recover(); r != nil {
+				assert.Equal(t, r, errors.New("Decode error: unknown representational format 'wrong-representation'"))
+			}
+		}()
+		err := n.Decode(msgs.Representation("wrong-representation"), m.Encode(msgs.JSONRepresentation))
+		assert.Nil(t, err)
+	}()
+	func() {
+		defer func() {
+			if r := recover(); r != nil {
+				assert.Equal(t, r, errors.New("Encode error: unknown representational format 'wrong-representation'"))
+			}
+		}()
+		err := n.Decode(msgs.JSONRepresentation, m.Encode(msgs.Representation("wrong-representation")))
+		assert.Nil(t, err)
+	}()
+}
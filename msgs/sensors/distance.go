@@ -0,0 +1,415 @@
+// Code generated by axon-msggen from messages.yml. DO NOT EDIT.
+
+package sensors
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"github.com/fxamacker/cbor/v2"
+	"github.com/tombenke/axon-go-common/msgs"
+	"github.com/tombenke/axon-go-common/msgs/avro"
+	"github.com/tombenke/axon-go-common/msgs/common"
+	"github.com/vmihailenco/msgpack/v5"
+	"gopkg.in/yaml.v2"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// DistanceTypeName is the printable name of the `Distance` message-type
+	DistanceTypeName = "sensors/Distance"
+)
+
+func init() {
+	msgs.RegisterMessageType(DistanceTypeName, []msgs.Representation{msgs.JSONRepresentation, msgs.YAMLRepresentation, msgs.GobRepresentation, msgs.ProtobufRepresentation, msgs.XMLRepresentation, msgs.AvroRepresentation, msgs.MsgpackRepresentation, msgs.CBORRepresentation, msgs.CSVRepresentation, msgs.CSVHeaderRepresentation, msgs.TextRepresentation}, func() msgs.Message {
+		return NewDistanceMessage(DistanceBody{})
+	})
+	msgs.SetMessageTypeDescription(DistanceTypeName, "message structure represents the distance measured by a range sensor measured by a sensor")
+}
+
+// Distance message structure represents the distance measured by a range sensor measured by a sensor
+type Distance struct {
+	Header common.Header
+	Body   DistanceBody
+}
+
+// DistanceBody holds the body part of the `Distance` message
+type DistanceBody struct {
+	// Value is the measured value in the `Unit` unit
+	Value float64
+	// Variance is the variance of the measured value, 0 means unknown
+	Variance float64
+	// Unit is the unit of the measured value, e.g. `m`
+	Unit string
+	// SensorID is the identifier of the sensor that made the measurement
+	SensorID string
+	// Quality is the quality of the measurement: `good`, `uncertain` or `bad`
+	Quality string
+}
+
+// GetType returns with the printable name of the `Distance` message-type
+func (msg *Distance) GetType() string {
+	return DistanceTypeName
+}
+
+// Encode returns with the `Distance` message content in a representation format selected by `representation`
+func (msg *Distance) Encode(representation msgs.Representation) (results []byte) {
+	switch representation {
+	case msgs.JSONRepresentation:
+		results = msg.JSON()
+	case msgs.YAMLRepresentation:
+		results = msg.YAML()
+	case msgs.GobRepresentation:
+		results = msg.EncodeGob()
+	case msgs.ProtobufRepresentation:
+		results = msg.Protobuf()
+	case msgs.CSVRepresentation, msgs.CSVHeaderRepresentation:
+		results = msg.CSV(representation == msgs.CSVHeaderRepresentation)
+	case msgs.TextRepresentation:
+		results = msg.Text()
+	case msgs.XMLRepresentation:
+		results = msg.XML()
+	case msgs.AvroRepresentation:
+		results = msg.Avro()
+	case msgs.MsgpackRepresentation:
+		results = msg.Msgpack()
+	case msgs.CBORRepresentation:
+		results = msg.CBOR()
+	default:
+		panic(fmt.Errorf("Encode error: unknown representational format '%s'", representation))
+	}
+	return results
+}
+
+// Decode parses the `content` using the selected `representation` format.
+// The messages of older versions are upgraded to the current version of the message-type, if the `representation` is upgradable.
+func (msg *Distance) Decode(representation msgs.Representation, content []byte) error {
+	content, err := msgs.Upgrade(DistanceTypeName, representation, content)
+	if err != nil {
+		return err
+	}
+
+	switch representation {
+	case msgs.JSONRepresentation:
+		return msg.ParseJSON(content)
+	case msgs.YAMLRepresentation:
+		return msg.ParseYAML(content)
+	case msgs.GobRepresentation:
+		return msg.DecodeGob(content)
+	case msgs.ProtobufRepresentation:
+		return msg.ParseProtobuf(content)
+	case msgs.CSVRepresentation, msgs.CSVHeaderRepresentation:
+		return msg.ParseCSV(content)
+	case msgs.TextRepresentation:
+		return msg.ParseText(content)
+	case msgs.XMLRepresentation:
+		return msg.ParseXML(content)
+	case msgs.AvroRepresentation:
+		return msg.ParseAvro(content)
+	case msgs.MsgpackRepresentation:
+		return msg.ParseMsgpack(content)
+	case msgs.CBORRepresentation:
+		return msg.ParseCBOR(content)
+	default:
+		panic(fmt.Errorf("Decode error: unknown representational format '%s'", representation))
+	}
+}
+
+// JSON returns with the `Distance` message content in JSON representation format
+func (msg *Distance) JSON() []byte {
+	jsonBytes, err := json.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return jsonBytes
+}
+
+// String returns with the `Distance` message content in JSON format string
+func (msg *Distance) String() string {
+	return string(msg.JSON())
+}
+
+// ParseJSON parses the JSON representation of a `Distance` messages from the `jsonBytes` argument.
+func (msg *Distance) ParseJSON(jsonBytes []byte) error {
+	return json.Unmarshal(jsonBytes, msg)
+}
+
+// YAML returns with the `Distance` message content in YAML representation format
+func (msg *Distance) YAML() []byte {
+	yamlBytes, err := yaml.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return yamlBytes
+}
+
+// ParseYAML parses the YAML representation of a `Distance` messages from the `yamlBytes` argument.
+func (msg *Distance) ParseYAML(yamlBytes []byte) error {
+	return yaml.Unmarshal(yamlBytes, msg)
+}
+
+// EncodeGob returns with the `Distance` message content in Gob representation format
+func (msg *Distance) EncodeGob() []byte {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(*msg); err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
+// DecodeGob parses the Gob representation of a `Distance` messages from the `gobBytes` argument.
+// Gob omits the zero values, so the message is replaced as a whole by the decoded one.
+func (msg *Distance) DecodeGob(gobBytes []byte) error {
+	var decoded Distance
+	if err := gob.NewDecoder(bytes.NewReader(gobBytes)).Decode(&decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// Protobuf returns with the `Distance` message content in protobuf representation format
+func (msg *Distance) Protobuf() []byte {
+	return common.MarshalProtobufMessage(msg.Header, msg.Body)
+}
+
+// ParseProtobuf parses the protobuf representation of a `Distance` messages from the `protobufBytes` argument.
+// Protobuf omits the zero values, so the message is replaced as a whole by the decoded one.
+func (msg *Distance) ParseProtobuf(protobufBytes []byte) error {
+	var decoded Distance
+	if err := common.UnmarshalProtobufMessage(protobufBytes, &decoded.Header, &decoded.Body); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// CSV returns with the `Distance` message content in CSV representation format, as a `timestamp,values...` record.
+// The record is preceded by a header row, if `withHeaderRow` is true.
+func (msg *Distance) CSV(withHeaderRow bool) []byte {
+	return common.MarshalCSVMessage(msg.Header, msg.Body, withHeaderRow)
+}
+
+// ParseCSV parses the CSV representation of a `Distance` messages from the `csvBytes` argument.
+// If the content has no header row that tells the time precision, the current precision of the message is kept.
+func (msg *Distance) ParseCSV(csvBytes []byte) error {
+	decoded := Distance{Header: msg.Header}
+	if err := common.UnmarshalCSVMessage(csvBytes, &decoded.Header, &decoded.Body); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// Text returns with the body of the `Distance` message in plain text representation format
+func (msg *Distance) Text() []byte {
+	return []byte(msg.Body.Text())
+}
+
+// ParseText parses the plain text representation of a `Distance` messages from the `textBytes` argument.
+// The plain text holds no timestamp, so the header gets the current time in the current precision of the message,
+// or in the default precision if the message has no precision.
+func (msg *Distance) ParseText(textBytes []byte) error {
+	var decoded Distance
+	if err := decoded.Body.ParseText(string(textBytes)); err != nil {
+		return err
+	}
+	precision := msg.Header.TimePrecision
+	if precision == "" {
+		precision = common.DefaultTimePrecision
+	}
+	decoded.Header = common.NewHeaderAt(common.NowAsUnixWithPrecision(precision), precision)
+	*msg = decoded
+	return nil
+}
+
+// XML returns with the `Distance` message content in XML representation format
+func (msg *Distance) XML() []byte {
+	xmlBytes, err := xml.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return xmlBytes
+}
+
+// ParseXML parses the XML representation of a `Distance` messages from the `xmlBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *Distance) ParseXML(xmlBytes []byte) error {
+	var decoded Distance
+	if err := xml.Unmarshal(xmlBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// Avro returns with the `Distance` message content in Avro representation format
+func (msg *Distance) Avro() []byte {
+	avroBytes, err := avro.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return avroBytes
+}
+
+// ParseAvro parses the Avro representation of a `Distance` messages from the `avroBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *Distance) ParseAvro(avroBytes []byte) error {
+	var decoded Distance
+	if err := avro.Unmarshal(avroBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// Msgpack returns with the `Distance` message content in MessagePack representation format
+func (msg *Distance) Msgpack() []byte {
+	msgpackBytes, err := msgpack.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return msgpackBytes
+}
+
+// ParseMsgpack parses the MessagePack representation of a `Distance` messages from the `msgpackBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *Distance) ParseMsgpack(msgpackBytes []byte) error {
+	var decoded Distance
+	if err := msgpack.Unmarshal(msgpackBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// CBOR returns with the `Distance` message content in CBOR representation format
+func (msg *Distance) CBOR() []byte {
+	cborBytes, err := cbor.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return cborBytes
+}
+
+// ParseCBOR parses the CBOR representation of a `Distance` messages from the `cborBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *Distance) ParseCBOR(cborBytes []byte) error {
+	var decoded Distance
+	if err := cbor.Unmarshal(cborBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// NewDistanceMessage returns with a new `Distance` message. The header will contain the current time in `Nanoseconds` precision.
+func NewDistanceMessage(body DistanceBody) msgs.Message {
+	return NewDistanceMessageAt(body, time.Now().UnixNano(), "ns")
+}
+
+// NewDistanceMessageAt returns with a new `Distance` message. The header will contain the `at` time in `withPrecision` precision.
+func NewDistanceMessageAt(body DistanceBody, at int64, withPrecision common.TimePrecision) msgs.Message {
+	var msg Distance
+	msg.Header = common.NewHeaderAt(at, withPrecision)
+	msg.Header.Version = msgs.GetMessageTypeVersion(DistanceTypeName)
+	msg.Body = body
+	return &msg
+}
+
+// AppendProtobuf appends the protobuf encoded fields of the body to `b`
+func (body DistanceBody) AppendProtobuf(b []byte) []byte {
+	b = common.AppendProtobufFloat64(b, 1, body.Value)
+	b = common.AppendProtobufFloat64(b, 2, body.Variance)
+	b = common.AppendProtobufString(b, 3, body.Unit)
+	b = common.AppendProtobufString(b, 4, body.SensorID)
+	b = common.AppendProtobufString(b, 5, body.Quality)
+	return b
+}
+
+// ParseProtobuf parses the protobuf encoded body from `b`
+func (body *DistanceBody) ParseProtobuf(b []byte) error {
+	fields, err := common.ParseProtobufFields(b)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range fields {
+		switch f.Num {
+		case 1:
+			body.Value = f.Float64()
+		case 2:
+			body.Variance = f.Float64()
+		case 3:
+			body.Unit = f.String()
+		case 4:
+			body.SensorID = f.String()
+		case 5:
+			body.Quality = f.String()
+		}
+	}
+	return nil
+}
+
+// CSVColumns returns with the names of the CSV columns of the body values
+func (body DistanceBody) CSVColumns() []string {
+	return []string{"value", "variance", "unit", "sensorID", "quality"}
+}
+
+// CSVValues returns with the body values in text format
+func (body DistanceBody) CSVValues() []string {
+	return []string{strconv.FormatFloat(body.Value, 'g', -1, 64), strconv.FormatFloat(body.Variance, 'g', -1, 64), body.Unit, body.SensorID, body.Quality}
+}
+
+// ParseCSVValues parses the body from the `values` in text format
+func (body *DistanceBody) ParseCSVValues(values []string) error {
+	if len(values) != 5 {
+		return fmt.Errorf("wrong number of CSV values: expected 5, got %d", len(values))
+	}
+
+	var err error
+	if body.Value, err = strconv.ParseFloat(strings.TrimSpace(values[0]), 64); err != nil {
+		return err
+	}
+	if body.Variance, err = strconv.ParseFloat(strings.TrimSpace(values[1]), 64); err != nil {
+		return err
+	}
+	body.Unit = values[2]
+	body.SensorID = values[3]
+	body.Quality = values[4]
+	return nil
+}
+
+// Text returns with the body values in plain text format, separated by space
+func (body DistanceBody) Text() string {
+	return strings.Join(body.CSVValues(), " ")
+}
+
+// ParseText parses the body from the `text` in plain text format, that holds the values separated by white space
+func (body *DistanceBody) ParseText(text string) error {
+	return body.ParseCSVValues(strings.Fields(text))
+}
+
+// In returns with the body converted to the `unit` unit.
+// The value and its variance are converted together, and the rest of the body is kept.
+func (body DistanceBody) In(unit string) (DistanceBody, error) {
+	value, err := Convert(body.Value, body.Unit, unit)
+	if err != nil {
+		return body, err
+	}
+	variance, err := ConvertVariance(body.Variance, body.Unit, unit)
+	if err != nil {
+		return body, err
+	}
+	body.Value, body.Variance, body.Unit = value, variance, unit
+	return body, nil
+}
+
+// IsValid returns true if the measured value has good quality
+func (body DistanceBody) IsValid() bool {
+	return body.Quality == QualityGood
+}
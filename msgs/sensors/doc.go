@@ -1,4 +1,19 @@
 /*
 Package sensors provides the sensor-type messages.
+
+The Temperature and Humidity messages hold only the measured values.
+The other sensor messages are generated by axon-msggen from the messages.yml definition file.
+Their bodies hold the unit of the measured values in the `Unit` field, the identifier of the sensor in the `SensorID` field,
+and the quality of the measurement in the `Quality` field, that is one of `QualityGood`, `QualityUncertain` and `QualityBad`.
+The `IsValid()` method of these bodies tells if the measurement has good quality.
+The `In()` and `IsValid()` methods of the bodies holding a single value are generated from the bodyMethods.tmpl template.
+
+The units are identified by their symbols, e.g. `°C`, `hPa` or `L/min`, that have constants in this package.
+The `Convert()` and `ConvertVariance()` functions convert the values between the units of the same quantity,
+and the `In()` method of the bodies returns with the body converted to another unit:
+
+	body, err := sensors.PressureBody{Value: 1013.25, Unit: sensors.Hectopascal}.In(sensors.PSI)
 */
 package sensors
+
+//go:generate go run github.com/tombenke/axon-go-common/cmd/axon-msggen -f messages.yml
@@ -0,0 +1,42 @@
+package sensors
+
+// The conversion and validity helpers of the sensor bodies, that do not fit the `bodyMethods.tmpl` template
+// of the bodies holding a single value.
+
+// In returns with the body converted to the `unit` unit
+func (body AccelerationBody) In(unit string) (AccelerationBody, error) {
+	converted := body
+	for _, axis := range []*float64{&converted.X, &converted.Y, &converted.Z} {
+		value, err := Convert(*axis, body.Unit, unit)
+		if err != nil {
+			return body, err
+		}
+		*axis = value
+	}
+	converted.Unit = unit
+	return converted, nil
+}
+
+// IsValid returns true if the measured value has good quality
+func (body AccelerationBody) IsValid() bool {
+	return body.Quality == QualityGood
+}
+
+// In returns with the body, its altitude and accuracy converted to the `unit` unit
+func (body GPSBody) In(unit string) (GPSBody, error) {
+	altitude, err := Convert(body.Altitude, body.Unit, unit)
+	if err != nil {
+		return body, err
+	}
+	accuracy, err := Convert(body.Accuracy, body.Unit, unit)
+	if err != nil {
+		return body, err
+	}
+	body.Altitude, body.Accuracy, body.Unit = altitude, accuracy, unit
+	return body, nil
+}
+
+// IsValid returns true if the position fix has good quality, and its coordinates are in range
+func (body GPSBody) IsValid() bool {
+	return body.Quality == QualityGood && body.Latitude >= -90 && body.Latitude <= 90 && body.Longitude >= -180 && body.Longitude <= 180
+}
@@ -1,10 +1,18 @@
 package sensors
 
 import (
+	"bytes"
+	"encoding/gob"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
+	"github.com/fxamacker/cbor/v2"
 	"github.com/tombenke/axon-go-common/msgs"
+	"github.com/tombenke/axon-go-common/msgs/avro"
 	"github.com/tombenke/axon-go-common/msgs/common"
+	"github.com/tombenke/axon-go-common/msgs/ros1"
+	"github.com/vmihailenco/msgpack/v5"
+	"gopkg.in/yaml.v2"
 	"time"
 )
 
@@ -14,9 +22,11 @@ const (
 )
 
 func init() {
-	msgs.RegisterMessageType(TemperatureTypeName, []msgs.Representation{msgs.JSONRepresentation}, func() msgs.Message {
+	msgs.RegisterMessageType(TemperatureTypeName, []msgs.Representation{msgs.JSONRepresentation, msgs.YAMLRepresentation, msgs.GobRepresentation, msgs.ProtobufRepresentation, msgs.CSVRepresentation, msgs.CSVHeaderRepresentation, msgs.TextRepresentation, msgs.XMLRepresentation, msgs.AvroRepresentation, msgs.MsgpackRepresentation, msgs.CBORRepresentation, msgs.ROS1Representation}, func() msgs.Message {
 		return NewTemperatureMessage(float64(0))
 	})
+	msgs.SetMessageTypeDescription(TemperatureTypeName, "Temperature measured by the temperature sensors, with its variance")
+	msgs.SetMessageTypeROSType(TemperatureTypeName, "sensor_msgs/Temperature")
 }
 
 // Temperature represents the structure of the messages emitted by the Temperature sensors
@@ -39,17 +49,63 @@ func (msg *Temperature) Encode(representation msgs.Representation) (results []by
 		if err != nil {
 			panic(err)
 		}
+	case msgs.YAMLRepresentation:
+		results = msg.YAML()
+	case msgs.GobRepresentation:
+		results = msg.EncodeGob()
+	case msgs.ProtobufRepresentation:
+		results = msg.Protobuf()
+	case msgs.CSVRepresentation, msgs.CSVHeaderRepresentation:
+		results = msg.CSV(representation == msgs.CSVHeaderRepresentation)
+	case msgs.TextRepresentation:
+		results = msg.Text()
+	case msgs.XMLRepresentation:
+		results = msg.XML()
+	case msgs.AvroRepresentation:
+		results = msg.Avro()
+	case msgs.MsgpackRepresentation:
+		results = msg.Msgpack()
+	case msgs.CBORRepresentation:
+		results = msg.CBOR()
+	case msgs.ROS1Representation:
+		results = msg.ROS1()
 	default:
 		panic(fmt.Errorf("Encode error: unknown representational format '%s'", representation))
 	}
 	return results
 }
 
-// Decode parses the `content` using the selected `representation` format
+// Decode parses the `content` using the selected `representation` format.
+// The messages of older versions are upgraded to the current version of the message-type, if the `representation` is upgradable.
 func (msg *Temperature) Decode(representation msgs.Representation, content []byte) error {
+	content, err := msgs.Upgrade(TemperatureTypeName, representation, content)
+	if err != nil {
+		return err
+	}
+
 	switch representation {
 	case msgs.JSONRepresentation:
 		return json.Unmarshal(content, msg)
+	case msgs.YAMLRepresentation:
+		return msg.ParseYAML(content)
+	case msgs.GobRepresentation:
+		return msg.DecodeGob(content)
+	case msgs.ProtobufRepresentation:
+		return msg.ParseProtobuf(content)
+	case msgs.CSVRepresentation, msgs.CSVHeaderRepresentation:
+		return msg.ParseCSV(content)
+	case msgs.TextRepresentation:
+		return msg.ParseText(content)
+	case msgs.XMLRepresentation:
+		return msg.ParseXML(content)
+	case msgs.AvroRepresentation:
+		return msg.ParseAvro(content)
+	case msgs.MsgpackRepresentation:
+		return msg.ParseMsgpack(content)
+	case msgs.CBORRepresentation:
+		return msg.ParseCBOR(content)
+	case msgs.ROS1Representation:
+		return msg.ParseROS1(content)
 	default:
 		panic(fmt.Errorf("Decode error: unknown representational format '%s'", representation))
 	}
@@ -78,6 +134,201 @@ func (msg *Temperature) ParseJSON(jsonBytes []byte) error {
 	return json.Unmarshal(jsonBytes, msg)
 }
 
+// Msgpack returns with the `Temperature` message content in MessagePack representation format
+func (msg *Temperature) Msgpack() []byte {
+	msgpackBytes, err := msgpack.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return msgpackBytes
+}
+
+// ParseMsgpack parses the MessagePack representation of a `Temperature` messages from the `msgpackBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *Temperature) ParseMsgpack(msgpackBytes []byte) error {
+	var decoded Temperature
+	if err := msgpack.Unmarshal(msgpackBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// CBOR returns with the `Temperature` message content in CBOR representation format
+func (msg *Temperature) CBOR() []byte {
+	cborBytes, err := cbor.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return cborBytes
+}
+
+// ParseCBOR parses the CBOR representation of a `Temperature` messages from the `cborBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *Temperature) ParseCBOR(cborBytes []byte) error {
+	var decoded Temperature
+	if err := cbor.Unmarshal(cborBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// Avro returns with the `Temperature` message content in Avro representation format
+func (msg *Temperature) Avro() []byte {
+	avroBytes, err := avro.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return avroBytes
+}
+
+// ParseAvro parses the Avro representation of a `Temperature` messages from the `avroBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *Temperature) ParseAvro(avroBytes []byte) error {
+	var decoded Temperature
+	if err := avro.Unmarshal(avroBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// XML returns with the `Temperature` message content in XML representation format
+func (msg *Temperature) XML() []byte {
+	xmlBytes, err := xml.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return xmlBytes
+}
+
+// ParseXML parses the XML representation of a `Temperature` messages from the `xmlBytes` argument.
+// The message is replaced as a whole by the decoded one.
+func (msg *Temperature) ParseXML(xmlBytes []byte) error {
+	var decoded Temperature
+	if err := xml.Unmarshal(xmlBytes, &decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// CSV returns with the `Temperature` message content in CSV representation format, as a `timestamp,values...` record.
+// The record is preceded by a header row, if `withHeaderRow` is true.
+func (msg *Temperature) CSV(withHeaderRow bool) []byte {
+	return common.MarshalCSVMessage(msg.Header, msg.Body, withHeaderRow)
+}
+
+// ParseCSV parses the CSV representation of a `Temperature` messages from the `csvBytes` argument.
+// If the content has no header row that tells the time precision, the current precision of the message is kept.
+func (msg *Temperature) ParseCSV(csvBytes []byte) error {
+	decoded := Temperature{Header: msg.Header}
+	if err := common.UnmarshalCSVMessage(csvBytes, &decoded.Header, &decoded.Body); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// Text returns with the body of the `Temperature` message in plain text representation format
+func (msg *Temperature) Text() []byte {
+	return []byte(msg.Body.Text())
+}
+
+// ParseText parses the plain text representation of a `Temperature` messages from the `textBytes` argument.
+// The plain text holds no timestamp, so the header gets the current time in the current precision of the message,
+// or in the default precision if the message has no precision.
+func (msg *Temperature) ParseText(textBytes []byte) error {
+	var decoded Temperature
+	if err := decoded.Body.ParseText(string(textBytes)); err != nil {
+		return err
+	}
+	precision := msg.Header.TimePrecision
+	if precision == "" {
+		precision = common.DefaultTimePrecision
+	}
+	decoded.Header = common.NewHeaderAt(common.NowAsUnixWithPrecision(precision), precision)
+	*msg = decoded
+	return nil
+}
+
+// ROS1 returns with the `Temperature` message content in the ROS1 serialization format of the `sensor_msgs/Temperature` ROS message
+func (msg *Temperature) ROS1() []byte {
+	e := ros1.NewEncoder()
+	e.Header(msg.Header, "")
+	e.Float64(msg.Body.Data)
+	e.Float64(msg.Body.Variance)
+	return e.Bytes()
+}
+
+// ParseROS1 parses the ROS1 serialization of a `sensor_msgs/Temperature` ROS message from the `ros1Bytes` argument.
+// The timestamp is converted into the current precision of the message, or into the default precision if the message has no precision.
+// The frame id of the ROS message is dropped. The message is replaced as a whole by the decoded one.
+func (msg *Temperature) ParseROS1(ros1Bytes []byte) error {
+	var decoded Temperature
+	d := ros1.NewDecoder(ros1Bytes)
+	decoded.Header, _ = d.Header(msg.Header.TimePrecision)
+	decoded.Body.Data = d.Float64()
+	decoded.Body.Variance = d.Float64()
+	if err := d.Close(); err != nil {
+		return err
+	}
+	decoded.Header.Version = msgs.GetMessageTypeVersion(TemperatureTypeName)
+	*msg = decoded
+	return nil
+}
+
+// Protobuf returns with the `Temperature` message content in protobuf representation format
+func (msg *Temperature) Protobuf() []byte {
+	return common.MarshalProtobufMessage(msg.Header, msg.Body)
+}
+
+// ParseProtobuf parses the protobuf representation of a `Temperature` messages from the `protobufBytes` argument.
+// Protobuf omits the zero values, so the message is replaced as a whole by the decoded one.
+func (msg *Temperature) ParseProtobuf(protobufBytes []byte) error {
+	var decoded Temperature
+	if err := common.UnmarshalProtobufMessage(protobufBytes, &decoded.Header, &decoded.Body); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
+// YAML returns with the `Temperature` message content in YAML representation format
+func (msg *Temperature) YAML() []byte {
+	yamlBytes, err := yaml.Marshal(*msg)
+	if err != nil {
+		panic(err)
+	}
+	return yamlBytes
+}
+
+// ParseYAML parses the YAML representation of a `Temperature` messages from the `yamlBytes` argument.
+func (msg *Temperature) ParseYAML(yamlBytes []byte) error {
+	return yaml.Unmarshal(yamlBytes, msg)
+}
+
+// EncodeGob returns with the `Temperature` message content in Gob representation format
+func (msg *Temperature) EncodeGob() []byte {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(*msg); err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
+// DecodeGob parses the Gob representation of a `Temperature` messages from the `gobBytes` argument.
+// Gob omits the zero values, so the message is replaced as a whole by the decoded one.
+func (msg *Temperature) DecodeGob(gobBytes []byte) error {
+	var decoded Temperature
+	if err := gob.NewDecoder(bytes.NewReader(gobBytes)).Decode(&decoded); err != nil {
+		return err
+	}
+	*msg = decoded
+	return nil
+}
+
 // NewTemperatureMessage returns with a new `Temperature` message. The header will contain the current time in `Nanoseconds` precision.
 func NewTemperatureMessage(data float64) msgs.Message {
 	return NewTemperatureMessageAt(data, time.Now().UnixNano(), "ns")
@@ -87,6 +338,7 @@ func NewTemperatureMessage(data float64) msgs.Message {
 func NewTemperatureMessageAt(data float64, at int64, withPrecision common.TimePrecision) msgs.Message {
 	var msg Temperature
 	msg.Header = common.NewHeaderAt(at, withPrecision)
+	msg.Header.Version = msgs.GetMessageTypeVersion(TemperatureTypeName)
 	msg.Body.Data = data
 	return &msg
 }
@@ -34,6 +34,129 @@ func TestTemperatureMessageCodec(t *testing.T) {
 	assert.Equal(t, m, &n)
 }
 
+func TestTemperatureMessageMsgpackCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewTemperatureMessageAt(42., at, prec)
+	var n Temperature
+	err := n.Decode(msgs.MsgpackRepresentation, m.Encode(msgs.MsgpackRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestTemperatureMessageCBORCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewTemperatureMessageAt(42., at, prec)
+	var n Temperature
+	err := n.Decode(msgs.CBORRepresentation, m.Encode(msgs.CBORRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestTemperatureMessageAvroCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewTemperatureMessageAt(42., at, prec)
+	var n Temperature
+	err := n.Decode(msgs.AvroRepresentation, m.Encode(msgs.AvroRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestTemperatureAvroSchema(t *testing.T) {
+	s, err := msgs.GetAvroSchema(TemperatureTypeName)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"name":"sensors.Temperature","type":"record","fields":[{"name":"Header","type":{"name":"common.Header","type":"record","fields":[{"name":"TimePrecision","type":"string"},{"name":"Timestamp","type":"long"},{"name":"Version","type":"long"}]}},{"name":"Body","type":{"name":"common.Float64VarBody","type":"record","fields":[{"name":"Data","type":"double"},{"name":"Variance","type":"double"}]}}]}`, s.String())
+	assert.Contains(t, msgs.GetAvroSchemas(), TemperatureTypeName)
+
+	// The receivers reject the messages of other types
+	m := NewTemperatureMessageAt(21.5, 1608732048980, common.TimePrecision("ms"))
+	var h Humidity
+	err = h.Decode(msgs.AvroRepresentation, m.Encode(msgs.AvroRepresentation))
+	assert.NotNil(t, err)
+
+	_, err = msgs.GetAvroSchema("sensors/Unknown")
+	assert.Equal(t, "the 'sensors/Unknown' message type has not been registered", err.Error())
+}
+
+func TestTemperatureDescriptor(t *testing.T) {
+	d, isPresent := msgs.GetDescriptor(TemperatureTypeName)
+	assert.True(t, isPresent)
+	assert.Equal(t, "Temperature measured by the temperature sensors, with its variance", d.Description)
+	assert.Equal(t, []msgs.FieldDescriptor{
+		{Name: "Header", Type: "common.Header", Fields: []msgs.FieldDescriptor{
+			{Name: "TimePrecision", Type: "common.TimePrecision"},
+			{Name: "Timestamp", Type: "int64"},
+			{Name: "Version", Type: "int"},
+		}},
+		{Name: "Body", Type: "common.Float64VarBody", Fields: []msgs.FieldDescriptor{
+			{Name: "Data", Type: "float64"},
+			{Name: "Variance", Type: "float64"},
+		}},
+	}, d.Fields())
+}
+
+func TestTemperatureMessageXMLCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewTemperatureMessageAt(42., at, prec)
+	var n Temperature
+	err := n.Decode(msgs.XMLRepresentation, m.Encode(msgs.XMLRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestTemperatureMessageCSVCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewTemperatureMessageAt(42., at, prec)
+	var n Temperature
+	err := n.Decode(msgs.CSVRepresentation, m.Encode(msgs.CSVRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestTemperatureMessageCSVHeaderCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewTemperatureMessageAt(42., at, prec)
+	var n Temperature
+	err := n.Decode(msgs.CSVHeaderRepresentation, m.Encode(msgs.CSVHeaderRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestTemperatureMessageProtobufCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewTemperatureMessageAt(42., at, prec)
+	var n Temperature
+	err := n.Decode(msgs.ProtobufRepresentation, m.Encode(msgs.ProtobufRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestTemperatureMessageYAMLCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewTemperatureMessageAt(42., at, prec)
+	var n Temperature
+	err := n.Decode(msgs.YAMLRepresentation, m.Encode(msgs.YAMLRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
+func TestTemperatureMessageGobCodec(t *testing.T) {
+	at := int64(1608732048980057025)
+	prec := common.TimePrecision("ns")
+	m := NewTemperatureMessageAt(42., at, prec)
+	var n Temperature
+	err := n.Decode(msgs.GobRepresentation, m.Encode(msgs.GobRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, m, &n)
+}
+
 func TestTemperatureMessageCodecPanic(t *testing.T) {
 	at := int64(1608732048980057025)
 	prec := common.TimePrecision("ns")
@@ -58,3 +181,13 @@ func TestTemperatureMessageCodecPanic(t *testing.T) {
 		assert.Nil(t, err)
 	}()
 }
+
+func TestTemperatureMessageTextCodec(t *testing.T) {
+	m := NewTemperatureMessageAt(42., int64(1608732048980057025), common.TimePrecision("ms"))
+	n := Temperature{Header: common.NewHeaderAt(0, common.TimePrecision("ms"))}
+	err := n.Decode(msgs.TextRepresentation, m.Encode(msgs.TextRepresentation))
+	assert.Nil(t, err)
+	assert.Equal(t, common.Float64VarBody{Data: 42.}, n.Body)
+	assert.Equal(t, common.TimePrecision("ms"), n.Header.TimePrecision)
+	assert.NotEqual(t, int64(0), n.Header.Timestamp)
+}
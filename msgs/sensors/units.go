@@ -0,0 +1,235 @@
+package sensors
+
+import (
+	"fmt"
+	"sort"
+)
+
+// The quality flags of the measurements
+const (
+	// QualityGood means the measured value is valid
+	QualityGood = "good"
+
+	// QualityUncertain means the measured value may be inaccurate, e.g. the sensor is warming up or out of calibration
+	QualityUncertain = "uncertain"
+
+	// QualityBad means the measured value is invalid, e.g. the sensor is faulty or disconnected
+	QualityBad = "bad"
+)
+
+// IsValidQuality returns true if the `quality` is one of the known quality flags
+func IsValidQuality(quality string) bool {
+	return quality == QualityGood || quality == QualityUncertain || quality == QualityBad
+}
+
+// Quantity is the name of the physical quantity a unit measures
+type Quantity string
+
+// The physical quantities of the sensor messages
+const (
+	TemperatureQuantity  Quantity = "temperature"
+	PressureQuantity     Quantity = "pressure"
+	IlluminanceQuantity  Quantity = "illuminance"
+	VoltageQuantity      Quantity = "voltage"
+	CurrentQuantity      Quantity = "current"
+	PowerQuantity        Quantity = "power"
+	EnergyQuantity       Quantity = "energy"
+	FlowRateQuantity     Quantity = "flowRate"
+	LengthQuantity       Quantity = "length"
+	RatioQuantity        Quantity = "ratio"
+	AccelerationQuantity Quantity = "acceleration"
+)
+
+// The symbols of the units used in the `Unit` field of the sensor messages
+const (
+	Celsius    = "°C"
+	Fahrenheit = "°F"
+	Kelvin     = "K"
+
+	Pascal              = "Pa"
+	Hectopascal         = "hPa"
+	Kilopascal          = "kPa"
+	Bar                 = "bar"
+	Millibar            = "mbar"
+	PSI                 = "psi"
+	Atmosphere          = "atm"
+	MillimeterOfMercury = "mmHg"
+
+	Lux        = "lx"
+	FootCandle = "fc"
+
+	Volt      = "V"
+	Millivolt = "mV"
+	Kilovolt  = "kV"
+
+	Ampere      = "A"
+	Milliampere = "mA"
+
+	Watt       = "W"
+	Kilowatt   = "kW"
+	Megawatt   = "MW"
+	Horsepower = "hp"
+
+	Joule        = "J"
+	Kilojoule    = "kJ"
+	Megajoule    = "MJ"
+	WattHour     = "Wh"
+	KilowattHour = "kWh"
+	Calorie      = "cal"
+	Kilocalorie  = "kcal"
+
+	CubicMeterPerSecond = "m³/s"
+	CubicMeterPerHour   = "m³/h"
+	LiterPerSecond      = "L/s"
+	LiterPerMinute      = "L/min"
+	LiterPerHour        = "L/h"
+	GallonPerMinute     = "gal/min"
+
+	Meter      = "m"
+	Kilometer  = "km"
+	Centimeter = "cm"
+	Millimeter = "mm"
+	Inch       = "in"
+	Foot       = "ft"
+	Yard       = "yd"
+	Mile       = "mi"
+
+	Percent = "%"
+	Ratio   = "1"
+
+	MeterPerSecondSquared = "m/s²"
+	FootPerSecondSquared  = "ft/s²"
+	StandardGravity       = "g"
+)
+
+// unitDefinition tells how a unit relates to the base unit of its quantity:
+// `base = value * scale + offset`
+type unitDefinition struct {
+	quantity Quantity
+	scale    float64
+	offset   float64
+}
+
+// units holds the definitions of the known units
+var units = map[string]unitDefinition{
+	Kelvin:     {TemperatureQuantity, 1, 0},
+	Celsius:    {TemperatureQuantity, 1, 273.15},
+	Fahrenheit: {TemperatureQuantity, 5. / 9., 273.15 - 32.*5./9.},
+
+	Pascal:              {PressureQuantity, 1, 0},
+	Hectopascal:         {PressureQuantity, 1e2, 0},
+	Kilopascal:          {PressureQuantity, 1e3, 0},
+	Bar:                 {PressureQuantity, 1e5, 0},
+	Millibar:            {PressureQuantity, 1e2, 0},
+	PSI:                 {PressureQuantity, 6894.757293168361, 0},
+	Atmosphere:          {PressureQuantity, 101325, 0},
+	MillimeterOfMercury: {PressureQuantity, 133.322387415, 0},
+
+	Lux:        {IlluminanceQuantity, 1, 0},
+	FootCandle: {IlluminanceQuantity, 10.763910416709722, 0},
+
+	Volt:      {VoltageQuantity, 1, 0},
+	Millivolt: {VoltageQuantity, 1e-3, 0},
+	Kilovolt:  {VoltageQuantity, 1e3, 0},
+
+	Ampere:      {CurrentQuantity, 1, 0},
+	Milliampere: {CurrentQuantity, 1e-3, 0},
+
+	Watt:       {PowerQuantity, 1, 0},
+	Kilowatt:   {PowerQuantity, 1e3, 0},
+	Megawatt:   {PowerQuantity, 1e6, 0},
+	Horsepower: {PowerQuantity, 745.6998715822702, 0},
+
+	Joule:        {EnergyQuantity, 1, 0},
+	Kilojoule:    {EnergyQuantity, 1e3, 0},
+	Megajoule:    {EnergyQuantity, 1e6, 0},
+	WattHour:     {EnergyQuantity, 3600, 0},
+	KilowattHour: {EnergyQuantity, 3.6e6, 0},
+	Calorie:      {EnergyQuantity, 4.184, 0},
+	Kilocalorie:  {EnergyQuantity, 4184, 0},
+
+	CubicMeterPerSecond: {FlowRateQuantity, 1, 0},
+	CubicMeterPerHour:   {FlowRateQuantity, 1. / 3600., 0},
+	LiterPerSecond:      {FlowRateQuantity, 1e-3, 0},
+	LiterPerMinute:      {FlowRateQuantity, 1e-3 / 60., 0},
+	LiterPerHour:        {FlowRateQuantity, 1e-3 / 3600., 0},
+	GallonPerMinute:     {FlowRateQuantity, 3.785411784e-3 / 60., 0},
+
+	Meter:      {LengthQuantity, 1, 0},
+	Kilometer:  {LengthQuantity, 1e3, 0},
+	Centimeter: {LengthQuantity, 1e-2, 0},
+	Millimeter: {LengthQuantity, 1e-3, 0},
+	Inch:       {LengthQuantity, 0.0254, 0},
+	Foot:       {LengthQuantity, 0.3048, 0},
+	Yard:       {LengthQuantity, 0.9144, 0},
+	Mile:       {LengthQuantity, 1609.344, 0},
+
+	Ratio:   {RatioQuantity, 1, 0},
+	Percent: {RatioQuantity, 1e-2, 0},
+
+	MeterPerSecondSquared: {AccelerationQuantity, 1, 0},
+	FootPerSecondSquared:  {AccelerationQuantity, 0.3048, 0},
+	StandardGravity:       {AccelerationQuantity, 9.80665, 0},
+}
+
+// QuantityOf returns with the quantity the `unit` measures, and true if the unit is known
+func QuantityOf(unit string) (Quantity, bool) {
+	def, ok := units[unit]
+	return def.quantity, ok
+}
+
+// UnitsOf returns with the sorted list of the known units of the `quantity`
+func UnitsOf(quantity Quantity) []string {
+	list := []string{}
+	for unit, def := range units {
+		if def.quantity == quantity {
+			list = append(list, unit)
+		}
+	}
+	sort.Strings(list)
+	return list
+}
+
+// conversion returns with the definitions of the `from` and `to` units,
+// or with error if any of them is unknown, or they measure different quantities
+func conversion(from, to string) (unitDefinition, unitDefinition, error) {
+	fromDef, ok := units[from]
+	if !ok {
+		return fromDef, unitDefinition{}, fmt.Errorf("unknown unit '%s'", from)
+	}
+	toDef, ok := units[to]
+	if !ok {
+		return fromDef, toDef, fmt.Errorf("unknown unit '%s'", to)
+	}
+	if fromDef.quantity != toDef.quantity {
+		return fromDef, toDef, fmt.Errorf("cannot convert '%s' (%s) to '%s' (%s)", from, fromDef.quantity, to, toDef.quantity)
+	}
+	return fromDef, toDef, nil
+}
+
+// Convert converts the `value` given in the `from` unit to the `to` unit, e.g. `Convert(20, Celsius, Fahrenheit)`.
+// It returns error if any of the units is unknown, or they measure different quantities.
+func Convert(value float64, from, to string) (float64, error) {
+	if from == to {
+		return value, nil
+	}
+	fromDef, toDef, err := conversion(from, to)
+	if err != nil {
+		return value, err
+	}
+	return (value*fromDef.scale + fromDef.offset - toDef.offset) / toDef.scale, nil
+}
+
+// ConvertVariance converts the `variance` of a value given in the `from` unit to the `to` unit.
+// The offsets of the units do not change the variance, only the ratio of their scales does.
+func ConvertVariance(variance float64, from, to string) (float64, error) {
+	if from == to {
+		return variance, nil
+	}
+	fromDef, toDef, err := conversion(from, to)
+	if err != nil {
+		return variance, err
+	}
+	ratio := fromDef.scale / toDef.scale
+	return variance * ratio * ratio, nil
+}
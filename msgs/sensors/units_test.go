@@ -0,0 +1,135 @@
+package sensors
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/tombenke/axon-go-common/msgs"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestConvert(t *testing.T) {
+	type testCase struct {
+		value    float64
+		from, to string
+		expected float64
+	}
+	cases := []testCase{
+		{20, Celsius, Fahrenheit, 68},
+		{68, Fahrenheit, Celsius, 20},
+		{0, Celsius, Kelvin, 273.15},
+		{0, Kelvin, Fahrenheit, -459.67},
+		{-40, Fahrenheit, Celsius, -40},
+		{1, Bar, Pascal, 1e5},
+		{1013.25, Hectopascal, Atmosphere, 1},
+		{1, PSI, Kilopascal, 6.894757293168361},
+		{1, MillimeterOfMercury, Pascal, 133.322387415},
+		{1, FootCandle, Lux, 10.763910416709722},
+		{230, Volt, Kilovolt, 0.23},
+		{1500, Milliampere, Ampere, 1.5},
+		{1, Horsepower, Watt, 745.6998715822702},
+		{1, KilowattHour, Megajoule, 3.6},
+		{1, Kilocalorie, Joule, 4184},
+		{60, LiterPerMinute, LiterPerSecond, 1},
+		{3.6, CubicMeterPerHour, LiterPerSecond, 1},
+		{1, Foot, Inch, 12},
+		{1, Mile, Kilometer, 1.609344},
+		{50, Percent, Ratio, 0.5},
+		{1, StandardGravity, MeterPerSecondSquared, 9.80665},
+		{42, Meter, Meter, 42},
+	}
+	for _, c := range cases {
+		converted, err := Convert(c.value, c.from, c.to)
+		assert.Nil(t, err)
+		assert.InDelta(t, c.expected, converted, 1e-9, "%g %s -> %s", c.value, c.from, c.to)
+	}
+}
+
+func TestConvertErrors(t *testing.T) {
+	_, err := Convert(1, "furlong", Meter)
+	assert.EqualError(t, err, "unknown unit 'furlong'")
+	_, err = Convert(1, Meter, "furlong")
+	assert.EqualError(t, err, "unknown unit 'furlong'")
+	_, err = Convert(1, Meter, Celsius)
+	assert.EqualError(t, err, "cannot convert 'm' (length) to '°C' (temperature)")
+	_, err = ConvertVariance(1, Bar, Volt)
+	assert.EqualError(t, err, "cannot convert 'bar' (pressure) to 'V' (voltage)")
+}
+
+func TestConvertVariance(t *testing.T) {
+	variance, err := ConvertVariance(1, Celsius, Fahrenheit)
+	assert.Nil(t, err)
+	assert.InDelta(t, 3.24, variance, 1e-9)
+
+	variance, err = ConvertVariance(4, Kilopascal, Pascal)
+	assert.Nil(t, err)
+	assert.InDelta(t, 4e6, variance, 1e-3)
+}
+
+func TestQuantityOfAndUnitsOf(t *testing.T) {
+	quantity, ok := QuantityOf(Millibar)
+	assert.True(t, ok)
+	assert.Equal(t, PressureQuantity, quantity)
+	_, ok = QuantityOf("furlong")
+	assert.False(t, ok)
+
+	assert.Equal(t, []string{Kelvin, Celsius, Fahrenheit}, UnitsOf(TemperatureQuantity))
+	assert.Equal(t, []string{}, UnitsOf(Quantity("unknown")))
+	for unit := range units {
+		quantity, _ := QuantityOf(unit)
+		assert.Contains(t, UnitsOf(quantity), unit)
+	}
+}
+
+func TestIsValidQuality(t *testing.T) {
+	for _, quality := range []string{QualityGood, QualityUncertain, QualityBad} {
+		assert.True(t, IsValidQuality(quality))
+	}
+	assert.False(t, IsValidQuality(""))
+	assert.False(t, IsValidQuality("excellent"))
+}
+
+func TestBodyIn(t *testing.T) {
+	pressure, err := PressureBody{Value: 1013.25, Variance: 1, Unit: Hectopascal, SensorID: "p1", Quality: QualityGood}.In(Pascal)
+	assert.Nil(t, err)
+	assert.InDelta(t, 101325, pressure.Value, 1e-9)
+	assert.InDelta(t, 1e4, pressure.Variance, 1e-9)
+	assert.Equal(t, Pascal, pressure.Unit)
+	assert.Equal(t, "p1", pressure.SensorID)
+	assert.True(t, pressure.IsValid())
+
+	level := LevelBody{Value: 50, Unit: Percent, Quality: QualityUncertain}
+	converted, err := level.In(Meter)
+	assert.NotNil(t, err)
+	assert.Equal(t, level, converted)
+	assert.False(t, level.IsValid())
+
+	acceleration, err := AccelerationBody{X: 1, Y: -1, Z: 0.5, Unit: StandardGravity}.In(MeterPerSecondSquared)
+	assert.Nil(t, err)
+	assert.InDeltaSlice(t, []float64{9.80665, -9.80665, 4.903325}, []float64{acceleration.X, acceleration.Y, acceleration.Z}, 1e-9)
+	assert.Equal(t, MeterPerSecondSquared, acceleration.Unit)
+
+	gps, err := GPSBody{Latitude: 47.5, Longitude: 19, Altitude: 1000, Accuracy: 10, Unit: Foot, Quality: QualityGood}.In(Meter)
+	assert.Nil(t, err)
+	assert.InDelta(t, 304.8, gps.Altitude, 1e-9)
+	assert.InDelta(t, 3.048, gps.Accuracy, 1e-9)
+	assert.Equal(t, 47.5, gps.Latitude)
+	assert.True(t, gps.IsValid())
+	gps.Latitude = 91
+	assert.False(t, gps.IsValid())
+}
+
+func TestSensorBodiesHaveQuantityMethods(t *testing.T) {
+	for _, messageType := range msgs.GetMessageTypes() {
+		if !strings.HasPrefix(messageType, "sensors/") {
+			continue
+		}
+		body := reflect.Indirect(reflect.ValueOf(msgs.GetDefaultMessageByType(messageType))).FieldByName("Body").Type()
+		if _, ok := body.FieldByName("Quality"); !ok {
+			continue
+		}
+		_, hasIn := body.MethodByName("In")
+		_, hasIsValid := body.MethodByName("IsValid")
+		assert.True(t, hasIn && hasIsValid, "the body of the '%s' message-type has no In() and IsValid() methods", messageType)
+	}
+}
@@ -0,0 +1,112 @@
+package schema
+
+import (
+	"reflect"
+	"strings"
+)
+
+// tagKind selects the struct tag, and the naming convention of the properties, the schema is generated by
+type tagKind string
+
+const (
+	// yamlTags generates the property names the way `gopkg.in/yaml.v2` does
+	yamlTags tagKind = "yaml"
+
+	// jsonTags generates the property names the way `encoding/json` does
+	jsonTags tagKind = "json"
+)
+
+// bytesType is the type of the byte arrays, that are represented as base64 encoded strings in JSON format
+var bytesType = reflect.TypeOf([]byte{})
+
+// fromType generates the schema of the `t` type using the `kind` struct tags to name the properties
+func fromType(t reflect.Type, kind tagKind) Schema {
+	switch t.Kind() {
+	case reflect.Ptr:
+		return fromType(t.Elem(), kind)
+	case reflect.Bool:
+		return Schema{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return Schema{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return Schema{"type": "number"}
+	case reflect.String:
+		return Schema{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		if t == bytesType && kind == jsonTags {
+			return Schema{"type": "string", "contentEncoding": "base64"}
+		}
+		return Schema{"type": "array", "items": fromType(t.Elem(), kind)}
+	case reflect.Map:
+		return Schema{"type": "object", "additionalProperties": fromType(t.Elem(), kind)}
+	case reflect.Struct:
+		properties := Schema{}
+		addProperties(properties, t, kind)
+		return Schema{"type": "object", "properties": properties}
+	}
+
+	// Interfaces may hold any kind of value
+	return Schema{}
+}
+
+// addProperties adds the schemas of the exported fields of the `t` struct type to the `properties`
+func addProperties(properties Schema, t reflect.Type, kind tagKind) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			// Unexported field
+			continue
+		}
+
+		name, inline, skip := fieldName(field, kind)
+		if skip {
+			continue
+		}
+
+		if inline {
+			fieldType := field.Type
+			if fieldType.Kind() == reflect.Ptr {
+				fieldType = fieldType.Elem()
+			}
+			addProperties(properties, fieldType, kind)
+			continue
+		}
+
+		properties[name] = fromType(field.Type, kind)
+	}
+}
+
+// fieldName determines the name of the property that represents the `field` using the `kind` struct tags.
+// It also tells if the properties of the field has to be inlined into the parent struct,
+// or the field has to be skipped.
+func fieldName(field reflect.StructField, kind tagKind) (name string, inline bool, skip bool) {
+	tag := field.Tag.Get(string(kind))
+	if tag == "-" {
+		return "", false, true
+	}
+
+	parts := strings.Split(tag, ",")
+	name = parts[0]
+	for _, flag := range parts[1:] {
+		if flag == "inline" {
+			inline = true
+		}
+	}
+
+	switch kind {
+	case yamlTags:
+		if name == "" {
+			name = strings.ToLower(field.Name)
+		}
+	case jsonTags:
+		if name == "" {
+			if field.Anonymous && field.Type.Kind() == reflect.Struct {
+				inline = true
+			}
+			name = field.Name
+		}
+	}
+
+	return name, inline, false
+}
@@ -0,0 +1,80 @@
+// Package schema provides the JSON Schema documents of the node configuration and of the registered message-types.
+// The schemas can be used by editors and deployment tools for completion and validation
+// of the `config.yml` files and of the messages in JSON representation format.
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/tombenke/axon-go-common/config"
+	"github.com/tombenke/axon-go-common/msgs"
+	"reflect"
+)
+
+// Version is the JSON Schema specification version the generated schemas conform to
+const Version = "http://json-schema.org/draft-07/schema#"
+
+// Schema is a JSON Schema document
+type Schema map[string]interface{}
+
+// JSON returns with the schema in indented JSON format
+func (s Schema) JSON() []byte {
+	jsonBytes, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		panic(err)
+	}
+	return jsonBytes
+}
+
+// Node returns with the JSON Schema of the `config.Node` structure,
+// including the ports, the orchestration and the messenger configuration.
+func Node() Schema {
+	schema := fromType(reflect.TypeOf(config.Node{}), yamlTags)
+	schema["title"] = "config.Node"
+
+	properties := schema["properties"].(Schema)
+	properties["logLevel"] = Schema{"type": "string", "enum": []string{"panic", "fatal", "error", "warning", "info", "debug", "trace"}}
+	properties["logFormat"] = Schema{"type": "string", "enum": []string{"json", "text"}}
+
+	return schema
+}
+
+// ConfigFile returns with the JSON Schema of the `config.yml` file, that holds the node configuration
+// under the `node` property.
+func ConfigFile() Schema {
+	return Schema{
+		"$schema":    Version,
+		"title":      "axon node config file",
+		"type":       "object",
+		"properties": Schema{"node": Node()},
+	}
+}
+
+// MessageType returns with the JSON Schema of the JSON representation of the `typeName` message-type.
+// It returns with error if the message-type is not registered, or does not implement the JSON representation.
+func MessageType(typeName string) (Schema, error) {
+	if !msgs.IsMessageTypeRegistered(typeName) {
+		return nil, fmt.Errorf("the '%s' message type has not been registered", typeName)
+	}
+
+	if !msgs.DoesMessageTypeImplementsRepresentation(typeName, msgs.JSONRepresentation) {
+		return nil, fmt.Errorf("'%s' message-type does not implement codec for '%s' representation format", typeName, msgs.JSONRepresentation)
+	}
+
+	schema := fromType(reflect.TypeOf(msgs.GetDefaultMessageByType(typeName)), jsonTags)
+	schema["$schema"] = Version
+	schema["title"] = typeName
+	return schema, nil
+}
+
+// MessageTypes returns with the JSON Schemas of all the registered message-types
+// that implement the JSON representation, indexed by the name of the message-types.
+func MessageTypes() map[string]Schema {
+	schemas := make(map[string]Schema)
+	for _, typeName := range msgs.GetMessageTypes() {
+		if schema, err := MessageType(typeName); err == nil {
+			schemas[typeName] = schema
+		}
+	}
+	return schemas
+}
@@ -0,0 +1,109 @@
+package schema
+
+import (
+	"github.com/stretchr/testify/assert"
+	_ "github.com/tombenke/axon-go-common/msgs/base"
+	_ "github.com/tombenke/axon-go-common/msgs/geometry"
+	_ "github.com/tombenke/axon-go-common/msgs/orchestra"
+	_ "github.com/tombenke/axon-go-common/msgs/sensors"
+	"testing"
+)
+
+func TestNode(t *testing.T) {
+	s := Node()
+	properties := s["properties"].(Schema)
+
+	assert.Equal(t, Schema{"type": "string"}, properties["name"])
+	assert.Nil(t, properties["type"])
+
+	messenger := properties["messenger"].(Schema)["properties"].(Schema)
+	assert.Equal(t, Schema{"urls": Schema{"type": "string"}, "credentials": Schema{"type": "string"}, "clusterID": Schema{"type": "string"}}, messenger)
+
+	ports := properties["ports"].(Schema)["properties"].(Schema)
+	inputs := ports["inputs"].(Schema)
+	assert.Equal(t, "array", inputs["type"])
+	assert.Equal(t, Schema{
+		"name":           Schema{"type": "string"},
+		"type":           Schema{"type": "string"},
+		"representation": Schema{"type": "string"},
+		"channel":        Schema{"type": "string"},
+		"default":        Schema{"type": "string"},
+		"deadLetter":     Schema{"type": "string"},
+		"buffer": Schema{"type": "object", "properties": Schema{
+			"policy":    Schema{"type": "string"},
+			"size":      Schema{"type": "integer"},
+			"window":    Schema{"type": "string"},
+			"aggregate": Schema{"type": "string"},
+		}},
+		"maxAge":   Schema{"type": "string"},
+		"onStale":  Schema{"type": "string"},
+		"required": Schema{"type": "boolean"},
+	}, inputs["items"].(Schema)["properties"])
+
+	outputs := ports["outputs"].(Schema)
+	assert.Equal(t, "array", outputs["type"])
+	assert.Equal(t, Schema{
+		"name":           Schema{"type": "string"},
+		"type":           Schema{"type": "string"},
+		"representation": Schema{"type": "string"},
+		"channel":        Schema{"type": "string"},
+		"channels": Schema{"type": "array", "items": Schema{"type": "object", "properties": Schema{
+			"channel":        Schema{"type": "string"},
+			"representation": Schema{"type": "string"},
+		}}},
+		"emit":     Schema{"type": "string"},
+		"deadband": Schema{"type": "number"},
+		"rate": Schema{"type": "object", "properties": Schema{
+			"interval": Schema{"type": "string"},
+			"policy":   Schema{"type": "string"},
+		}},
+		"batch": Schema{"type": "object", "properties": Schema{
+			"size":     Schema{"type": "integer"},
+			"interval": Schema{"type": "string"},
+		}},
+	}, outputs["items"].(Schema)["properties"])
+
+	channels := properties["orchestration"].(Schema)["properties"].(Schema)["channels"].(Schema)["properties"].(Schema)
+	assert.Equal(t, Schema{"type": "string"}, channels["receiveAndProcess"])
+}
+
+func TestConfigFile(t *testing.T) {
+	s := ConfigFile()
+	assert.Equal(t, Version, s["$schema"])
+	assert.Equal(t, Node(), s["properties"].(Schema)["node"])
+	assert.Contains(t, string(s.JSON()), `"$schema": "http://json-schema.org/draft-07/schema#"`)
+}
+
+func TestMessageType(t *testing.T) {
+	s, err := MessageType("sensors/Temperature")
+	assert.Nil(t, err)
+	assert.Equal(t, Schema{
+		"$schema": Version,
+		"title":   "sensors/Temperature",
+		"type":    "object",
+		"properties": Schema{
+			"Header": Schema{"type": "object", "properties": Schema{
+				"TimePrecision": Schema{"type": "string"},
+				"Timestamp":     Schema{"type": "integer"},
+				"Version":       Schema{"type": "integer"},
+			}},
+			"Body": Schema{"type": "object", "properties": Schema{
+				"Data":     Schema{"type": "number"},
+				"Variance": Schema{"type": "number"},
+			}},
+		},
+	}, s)
+
+	_, err = MessageType("base/Unknown")
+	assert.Equal(t, "the 'base/Unknown' message type has not been registered", err.Error())
+
+	_, err = MessageType("base/Bytes")
+	assert.NotNil(t, err)
+}
+
+func TestMessageTypes(t *testing.T) {
+	schemas := MessageTypes()
+	assert.Contains(t, schemas, "base/Float64")
+	assert.Contains(t, schemas, "orchestra/StatusReport")
+	assert.NotContains(t, schemas, "base/Bytes")
+}